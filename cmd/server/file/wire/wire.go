@@ -18,6 +18,7 @@ import (
 	rpcpkg "github.com/Wenrh2004/lark-lite-server/pkg/application/register/rpc"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/job"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/task"
 	domainpkg "github.com/Wenrh2004/lark-lite-server/pkg/domain"
 	repopkg "github.com/Wenrh2004/lark-lite-server/pkg/infrastruct/repository"
 	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
@@ -28,6 +29,7 @@ import (
 var infrastructureSet = wire.NewSet(
 	repopkg.NewDB,
 	repopkg.NewRedis,
+	repopkg.NewCache,
 	repository.NewTransaction,
 	repository.NewRepository,
 	repository.NewFileRepository,
@@ -50,6 +52,7 @@ var applicationSet = wire.NewSet(
 	rpcpkg.NewRegister,
 	application.NewRPCApplication,
 	application.NewJobApplication,
+	application.NewTaskApplication,
 )
 
 // build App
@@ -58,12 +61,13 @@ func newApp(
 	rpcServer *rpc.Server,
 	conf *viper.Viper,
 	jobServer *job.Server,
-	// task *server.Task,
+	taskServer *task.Server,
 ) *app.App {
 	return app.NewApp(
 		// app.WithServer(httpServer),
 		app.WithServer(rpcServer),
 		app.WithServer(jobServer),
+		app.WithServer(taskServer),
 		app.WithName(conf.GetString("app.name")),
 	)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/register/rpc"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/job"
 	rpc2 "github.com/Wenrh2004/lark-lite-server/pkg/application/server/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/task"
 	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
 	"github.com/Wenrh2004/lark-lite-server/pkg/infrastruct/repository"
 	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
@@ -40,26 +41,30 @@ func NewWire(viperViper *viper.Viper, logger *log.Logger) (*app.App, func(), err
 	repositoryRepository := repository2.NewRepository(logger, db, client, ossService)
 	transaction := repository2.NewTransaction(repositoryRepository)
 	domainService := domain.NewService(logger, sidSid, jwtJWT, transaction)
-	fileRepository := repository2.NewFileRepository(client, ossService)
+	producerProducer, cleanup := producer.NewProducer(viperViper)
+	v := repository.NewCache(viperViper, client)
+	fileRepository := repository2.NewFileRepository(viperViper, logger, client, producerProducer, ossService, v)
 	fileService := domain2.NewFileService(domainService, fileRepository)
 	adapterFileService := adapter2.NewFileService(service, fileService)
 	server := application.NewRPCApplication(logger, registry, adapterFileService)
 	fileJob := adapter2.NewFileJob(service, fileService)
 	jobServer := application.NewJobApplication(viperViper, logger, fileJob)
-	appApp := newApp(server, viperViper, jobServer)
+	taskServer := application.NewTaskApplication(viperViper, logger, fileJob)
+	appApp := newApp(server, viperViper, jobServer, taskServer)
 	return appApp, func() {
+		cleanup()
 	}, nil
 }
 
 // wire.go:
 
-var infrastructureSet = wire.NewSet(repository.NewDB, repository.NewRedis, repository2.NewTransaction, repository2.NewRepository, repository2.NewFileRepository, producer.NewProducer, oss.NewService)
+var infrastructureSet = wire.NewSet(repository.NewDB, repository.NewRedis, repository.NewCache, repository2.NewTransaction, repository2.NewRepository, repository2.NewFileRepository, producer.NewProducer, oss.NewService)
 
 var domainSet = wire.NewSet(domain.NewService, domain2.NewFileService)
 
 var adapterSet = wire.NewSet(adapter.NewService, adapter2.NewFileService, adapter2.NewFileJob)
 
-var applicationSet = wire.NewSet(rpc.NewRegister, application.NewRPCApplication, application.NewJobApplication)
+var applicationSet = wire.NewSet(rpc.NewRegister, application.NewRPCApplication, application.NewJobApplication, application.NewTaskApplication)
 
 // build App
 func newApp(
@@ -67,7 +72,7 @@ func newApp(
 	rpcServer *rpc2.Server,
 	conf *viper.Viper,
 	jobServer *job.Server,
-
+	taskServer *task.Server,
 ) *app.App {
-	return app.NewApp(app.WithServer(rpcServer), app.WithServer(jobServer), app.WithName(conf.GetString("app.name")))
+	return app.NewApp(app.WithServer(rpcServer), app.WithServer(jobServer), app.WithServer(taskServer), app.WithName(conf.GetString("app.name")))
 }
@@ -11,36 +11,73 @@ import (
 	"github.com/Wenrh2004/lark-lite-server/internal/user/application"
 	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
 	"github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/repository"
+	"github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/third/file"
 	adapterpkg "github.com/Wenrh2004/lark-lite-server/pkg/adapter"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/app"
+	rpcpkg "github.com/Wenrh2004/lark-lite-server/pkg/application/register/rpc"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/task"
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
 	domainpkg "github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/hasher"
 	repopkg "github.com/Wenrh2004/lark-lite-server/pkg/infrastruct/repository"
 	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
 	"github.com/Wenrh2004/lark-lite-server/pkg/log"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mail"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mfa"
+	"github.com/Wenrh2004/lark-lite-server/pkg/oauth"
 	"github.com/Wenrh2004/lark-lite-server/pkg/sid"
+	"github.com/Wenrh2004/lark-lite-server/pkg/sms"
 )
 
 var infrastructureSet = wire.NewSet(
 	repopkg.NewDB,
 	repopkg.NewRedis,
+	repopkg.NewCache,
 	repository.NewTransaction,
 	repository.NewRepository,
 	repository.NewUserRepository,
+	repository.NewSessionRepository,
+	repository.NewCodeRepository,
+	repository.NewIdentityRepository,
+	repository.NewOAuthStateRepository,
+	repository.NewMFARepository,
+	repository.NewAccessTokenRepository,
+	repository.NewDataJobRepository,
+	repository.NewPreferenceRepository,
+	repository.NewAuditRepository,
+	wire.Bind(new(domain.AuditRepository), new(*repository.AuditRepository)),
+	rpcpkg.NewResolver,
+	file.NewFileServiceClient,
+	file.NewFileClient,
 )
 
 var domainSet = wire.NewSet(
 	domainpkg.NewService,
 	domain.NewUserService,
+	domain.NewVerificationService,
+	domain.NewPasswordResetService,
+	domain.NewOAuthService,
+	domain.NewMFAService,
+	domain.NewAuthorizationService,
+	domain.NewAccessTokenService,
+	domain.NewAdminService,
+	domain.NewPrivacyService,
+	domain.NewAuditService,
+	domain.NewSessionService,
+	domain.NewPreferenceService,
 )
 
 var adapterSet = wire.NewSet(
 	adapterpkg.NewService,
 	adapter.NewUserServiceImpl,
+	adapter.NewUserJob,
+	adapter.NewAuthenticator,
 )
 
 var applicationSet = wire.NewSet(
 	application.NewUserRPCApplication,
+	application.NewUserTaskApplication,
 )
 
 // build App
@@ -49,12 +86,16 @@ func newApp(
 	rpcServer *rpc.Server,
 	conf *viper.Viper,
 	// jobServer *job.Server,
-	// task *server.Task,
+	taskServer *task.Server,
+	auditRepository *repository.AuditRepository,
 ) *app.App {
 	return app.NewApp(
 		// app.WithServer(httpServer),
 		app.WithServer(rpcServer),
 		// app.WithServer(jobServer),
+		app.WithServer(taskServer),
+		// 审计日志在 RPC 服务停止后写入剩余缓冲
+		app.WithServer(auditRepository),
 		app.WithName(conf.GetString("app.name")),
 	)
 }
@@ -66,7 +107,14 @@ func NewWire(*viper.Viper, *log.Logger) (*app.App, func(), error) {
 		adapterSet,
 		applicationSet,
 		jwt.NewJwt,
+		jwt.NewRevocation,
 		sid.NewSid,
+		hasher.NewHasher,
+		mail.NewEmailSender,
+		sms.NewSender,
+		oauth.NewProviders,
+		mfa.NewTOTP,
+		authz.NewEnforcer,
 		newApp,
 	))
 }
@@ -11,14 +11,23 @@ import (
 	"github.com/Wenrh2004/lark-lite-server/internal/user/application"
 	domain2 "github.com/Wenrh2004/lark-lite-server/internal/user/domain"
 	repository2 "github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/repository"
+	"github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/third/file"
 	"github.com/Wenrh2004/lark-lite-server/pkg/adapter"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/app"
-	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/register/rpc"
+	rpc2 "github.com/Wenrh2004/lark-lite-server/pkg/application/server/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/task"
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
 	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/hasher"
 	"github.com/Wenrh2004/lark-lite-server/pkg/infrastruct/repository"
 	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
 	"github.com/Wenrh2004/lark-lite-server/pkg/log"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mail"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mfa"
+	"github.com/Wenrh2004/lark-lite-server/pkg/oauth"
 	"github.com/Wenrh2004/lark-lite-server/pkg/sid"
+	"github.com/Wenrh2004/lark-lite-server/pkg/sms"
 	"github.com/google/wire"
 	"github.com/spf13/viper"
 )
@@ -34,31 +43,67 @@ func NewWire(viperViper *viper.Viper, logger *log.Logger) (*app.App, func(), err
 	repositoryRepository := repository2.NewRepository(logger, db, client)
 	transaction := repository2.NewTransaction(repositoryRepository)
 	domainService := domain.NewService(logger, sidSid, jwtJWT, transaction)
-	userRepository := repository2.NewUserRepository(repositoryRepository)
-	userService := domain2.NewUserService(domainService, userRepository)
-	userServiceImpl := adapter2.NewUserServiceImpl(service, userService)
-	server := application.NewUserRPCApplication(viperViper, logger, userServiceImpl)
-	appApp := newApp(server, viperViper)
+	v := repository.NewCache(viperViper, client)
+	userRepository := repository2.NewUserRepository(viperViper, repositoryRepository, v)
+	sessionRepository := repository2.NewSessionRepository(repositoryRepository)
+	revocation := jwt.NewRevocation(client, jwtJWT)
+	hasherHasher := hasher.NewHasher(viperViper)
+	codeRepository := repository2.NewCodeRepository(repositoryRepository)
+	sender := sms.NewSender(viperViper, logger)
+	mailSender := mail.NewEmailSender(viperViper)
+	mfaRepository := repository2.NewMFARepository(repositoryRepository)
+	totp := mfa.NewTOTP(viperViper)
+	enforcer := authz.NewEnforcer(viperViper, db, logger)
+	resolver := rpc.NewResolver(viperViper)
+	fileserviceClient := file.NewFileServiceClient(viperViper, resolver)
+	fileClient := file.NewFileClient(fileserviceClient)
+	auditRepository := repository2.NewAuditRepository(viperViper, repositoryRepository)
+	auditService := domain2.NewAuditService(domainService, auditRepository)
+	userService := domain2.NewUserService(domainService, userRepository, sessionRepository, revocation, hasherHasher, codeRepository, sender, mailSender, mfaRepository, totp, enforcer, fileClient, auditService)
+	verificationService := domain2.NewVerificationService(domainService, userRepository, codeRepository, mailSender)
+	passwordResetService := domain2.NewPasswordResetService(domainService, userRepository, codeRepository, sessionRepository, revocation, hasherHasher, mailSender, auditService)
+	identityRepository := repository2.NewIdentityRepository(repositoryRepository)
+	oAuthStateRepository := repository2.NewOAuthStateRepository(repositoryRepository)
+	providers := oauth.NewProviders(viperViper)
+	oAuthService := domain2.NewOAuthService(domainService, userRepository, identityRepository, oAuthStateRepository, userService, providers, auditService)
+	mfaService := domain2.NewMFAService(domainService, userRepository, mfaRepository, totp, auditService)
+	authorizationService := domain2.NewAuthorizationService(domainService, userRepository, enforcer, userService, auditService)
+	accessTokenRepository := repository2.NewAccessTokenRepository(repositoryRepository)
+	accessTokenService := domain2.NewAccessTokenService(domainService, userRepository, accessTokenRepository)
+	dataJobRepository := repository2.NewDataJobRepository(repositoryRepository)
+	adminService := domain2.NewAdminService(domainService, userRepository, dataJobRepository, userService, auditService)
+	preferenceRepository := repository2.NewPreferenceRepository(viperViper, repositoryRepository, v)
+	privacyService := domain2.NewPrivacyService(domainService, userRepository, identityRepository, accessTokenRepository, sessionRepository, preferenceRepository, dataJobRepository, fileClient, enforcer, userService, auditRepository)
+	sessionService := domain2.NewSessionService(domainService, sessionRepository, revocation, auditService)
+	preferenceService := domain2.NewPreferenceService(domainService, userRepository, preferenceRepository)
+	userServiceImpl := adapter2.NewUserServiceImpl(service, userService, verificationService, passwordResetService, oAuthService, mfaService, authorizationService, accessTokenService, adminService, privacyService, auditService, sessionService, preferenceService)
+	authenticator := adapter2.NewAuthenticator(jwtJWT, revocation, accessTokenService)
+	server := application.NewUserRPCApplication(viperViper, logger, userServiceImpl, enforcer, authenticator)
+	userJob := adapter2.NewUserJob(service, privacyService)
+	taskServer := application.NewUserTaskApplication(viperViper, logger, userJob)
+	appApp := newApp(server, viperViper, taskServer, auditRepository)
 	return appApp, func() {
 	}, nil
 }
 
 // wire.go:
 
-var infrastructureSet = wire.NewSet(repository.NewDB, repository.NewRedis, repository2.NewTransaction, repository2.NewRepository, repository2.NewUserRepository)
+var infrastructureSet = wire.NewSet(repository.NewDB, repository.NewRedis, repository.NewCache, repository2.NewTransaction, repository2.NewRepository, repository2.NewUserRepository, repository2.NewSessionRepository, repository2.NewCodeRepository, repository2.NewIdentityRepository, repository2.NewOAuthStateRepository, repository2.NewMFARepository, repository2.NewAccessTokenRepository, repository2.NewDataJobRepository, repository2.NewPreferenceRepository, repository2.NewAuditRepository, wire.Bind(new(domain2.AuditRepository), new(*repository2.AuditRepository)), rpc.NewResolver, file.NewFileServiceClient, file.NewFileClient)
 
-var domainSet = wire.NewSet(domain.NewService, domain2.NewUserService)
+var domainSet = wire.NewSet(domain.NewService, domain2.NewUserService, domain2.NewVerificationService, domain2.NewPasswordResetService, domain2.NewOAuthService, domain2.NewMFAService, domain2.NewAuthorizationService, domain2.NewAccessTokenService, domain2.NewAdminService, domain2.NewPrivacyService, domain2.NewAuditService, domain2.NewSessionService, domain2.NewPreferenceService)
 
-var adapterSet = wire.NewSet(adapter.NewService, adapter2.NewUserServiceImpl)
+var adapterSet = wire.NewSet(adapter.NewService, adapter2.NewUserServiceImpl, adapter2.NewUserJob, adapter2.NewAuthenticator)
 
-var applicationSet = wire.NewSet(application.NewUserRPCApplication)
+var applicationSet = wire.NewSet(application.NewUserRPCApplication, application.NewUserTaskApplication)
 
 // build App
 func newApp(
 
-	rpcServer *rpc.Server,
+	rpcServer *rpc2.Server,
 	conf *viper.Viper,
 
+	taskServer *task.Server,
+	auditRepository *repository2.AuditRepository,
 ) *app.App {
-	return app.NewApp(app.WithServer(rpcServer), app.WithName(conf.GetString("app.name")))
+	return app.NewApp(app.WithServer(rpcServer), app.WithServer(taskServer), app.WithServer(auditRepository), app.WithName(conf.GetString("app.name")))
 }
@@ -0,0 +1,85 @@
+//go:build wireinject
+// +build wireinject
+
+package wire
+
+import (
+	"github.com/google/wire"
+	"github.com/spf13/viper"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/adapter"
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/application"
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/infrastructure/repository"
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/infrastructure/third/mail"
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/infrastructure/third/user"
+	adapterpkg "github.com/Wenrh2004/lark-lite-server/pkg/adapter"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/app"
+	rpcpkg "github.com/Wenrh2004/lark-lite-server/pkg/application/register/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+	domainpkg "github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	repopkg "github.com/Wenrh2004/lark-lite-server/pkg/infrastruct/repository"
+	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
+	"github.com/Wenrh2004/lark-lite-server/pkg/log"
+	mailpkg "github.com/Wenrh2004/lark-lite-server/pkg/mail"
+	"github.com/Wenrh2004/lark-lite-server/pkg/sid"
+)
+
+var infrastructureSet = wire.NewSet(
+	repopkg.NewDB,
+	repopkg.NewRedis,
+	repopkg.NewCache,
+	repository.NewTransaction,
+	repository.NewRepository,
+	repository.NewWorkspaceRepository,
+	repository.NewMemberRepository,
+	repository.NewInvitationRepository,
+	mail.NewNotifier,
+	rpcpkg.NewResolver,
+	user.NewUserServiceClient,
+	user.NewUserClient,
+)
+
+var domainSet = wire.NewSet(
+	domainpkg.NewService,
+	domain.NewWorkspaceService,
+	domain.NewMemberService,
+	domain.NewInvitationService,
+)
+
+var adapterSet = wire.NewSet(
+	adapterpkg.NewService,
+	adapter.NewWorkspaceServiceImpl,
+)
+
+var applicationSet = wire.NewSet(
+	rpcpkg.NewRegister,
+	application.NewWorkspaceRPCApplication,
+)
+
+// build App
+func newApp(
+	rpcServer *rpc.Server,
+	conf *viper.Viper,
+) *app.App {
+	return app.NewApp(
+		app.WithServer(rpcServer),
+		app.WithName(conf.GetString("app.name")),
+	)
+}
+
+func NewWire(*viper.Viper, *log.Logger) (*app.App, func(), error) {
+	panic(wire.Build(
+		infrastructureSet,
+		domainSet,
+		adapterSet,
+		applicationSet,
+		jwt.NewJwt,
+		jwt.NewRevocation,
+		authz.NewJWTAuthenticator,
+		sid.NewSid,
+		mailpkg.NewEmailSender,
+		newApp,
+	))
+}
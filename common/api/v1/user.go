@@ -1,15 +1,32 @@
 package v1
 
+import (
+	"encoding/json"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
+
 type UserAuthRequest struct {
 	Username string `json:"username" vd:"$len($)>0&&$len($)<20"`
 	Password string `json:"password" vd:"$len($)>0&&$len($)<20"`
 }
 
 type UserAuthResponseBody struct {
-	UserId      string                      `json:"user_id"`
-	Username    string                      `json:"username"`
-	Nickname    string                      `json:"nickname"`
-	AvatarUrl   string                      `json:"avatar_url"`
+	UserId           string `json:"user_id"`
+	Username         string `json:"username"`
+	Nickname         string `json:"nickname"`
+	AvatarUrl        string `json:"avatar_url"`
+	Email            string `json:"email,omitempty"`
+	EmailVerified    bool   `json:"email_verified"`
+	Phone            string `json:"phone,omitempty"`
+	PhoneVerified    bool   `json:"phone_verified"`
+	BackgroundUrl    string `json:"background_url,omitempty"`
+	Signature        string `json:"signature,omitempty"`
+	Gender           int32  `json:"gender,omitempty"`
+	AvatarFileID     string `json:"avatar_file_id,omitempty"`
+	BackgroundFileID string `json:"background_file_id,omitempty"`
+	// UpdatedAt 资料最近更新时间，部分更新时回传用于并发控制
+	UpdatedAt   int64                       `json:"updated_at,omitempty"`
 	Certificate UserCertificateResponseBody `json:"certificate"`
 }
 
@@ -27,3 +44,262 @@ type RefreshResponse struct {
 	Resp Response
 	Data UserCertificateResponseBody `json:"data"`
 }
+
+type SendEmailCodeRequest struct {
+	// Email 为空时发送到当前邮箱
+	Email string `json:"email"`
+}
+
+type VerifyEmailRequest struct {
+	Email string `json:"email" vd:"$len($)>0"`
+	Code  string `json:"code" vd:"$len($)>0"`
+}
+
+type RequestPasswordResetRequest struct {
+	Email string `json:"email" vd:"$len($)>0"`
+}
+
+type ResetPasswordRequest struct {
+	Email    string `json:"email" vd:"$len($)>0"`
+	Code     string `json:"code" vd:"$len($)>0"`
+	Password string `json:"password" vd:"$len($)>0&&$len($)<=64"`
+}
+
+type SendPhoneCodeRequest struct {
+	Phone string `json:"phone" vd:"$len($)>0&&$len($)<32"`
+}
+
+type PhoneLoginRequest struct {
+	Phone string `json:"phone" vd:"$len($)>0&&$len($)<32"`
+	Code  string `json:"code" vd:"$len($)>0"`
+}
+
+type OAuthAuthorizeResponseBody struct {
+	URL string `json:"url"`
+}
+
+type OAuthLinkResponseBody struct {
+	Linked bool `json:"linked"`
+}
+
+type IdentityResponseBody struct {
+	Provider  string `json:"provider"`
+	Email     string `json:"email,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// MFAChallengeResponseBody 用户启用二次验证时登录接口返回的挑战令牌
+type MFAChallengeResponseBody struct {
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type VerifyMFARequest struct {
+	MFAToken string `json:"mfa_token" vd:"$len($)>0"`
+	// Code TOTP 验证码或恢复码
+	Code string `json:"code" vd:"$len($)>0&&$len($)<32"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" vd:"$len($)>0&&$len($)<32"`
+}
+
+type EnrollTOTPResponseBody struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type RecoveryCodesResponseBody struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type ListPoliciesRequest struct {
+	Subject string `query:"subject"`
+	Domain  string `query:"domain"`
+	Object  string `query:"object"`
+	Action  string `query:"action"`
+}
+
+type PolicyRequest struct {
+	// Subject 角色 role:<name> 或用户 user:<id>
+	Subject string `json:"subject" vd:"$len($)>0&&$len($)<=100"`
+	// Domain * 表示全部域
+	Domain string `json:"domain" vd:"$len($)>0&&$len($)<=100"`
+	// Object 路由（如 /v1/user/admin/*）或 RPC 方法（如 /UserService/AddPolicy）
+	Object string `json:"object" vd:"$len($)>0&&$len($)<=100"`
+	// Action HTTP 方法、CALL 或 *
+	Action string `json:"action" vd:"$len($)>0&&$len($)<=100"`
+}
+
+type PolicyResponseBody struct {
+	Subject string `json:"subject"`
+	Domain  string `json:"domain"`
+	Object  string `json:"object"`
+	Action  string `json:"action"`
+}
+
+type AssignRoleRequest struct {
+	Role string `json:"role" vd:"$len($)>0&&$len($)<=64"`
+	// Domain 为空时为全局角色
+	Domain string `json:"domain"`
+}
+
+type UserRolesResponseBody struct {
+	Roles []string `json:"roles"`
+}
+
+type CreateAccessTokenRequest struct {
+	Name   string   `json:"name" vd:"$len($)>0&&$len($)<=64"`
+	Scopes []string `json:"scopes" vd:"$len($)>0"`
+	// ExpiresIn 有效期秒数，为 0 表示永不过期
+	ExpiresIn int64 `json:"expires_in" vd:"$>=0"`
+}
+
+type AccessTokenResponseBody struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`
+	Scopes     []string `json:"scopes"`
+	CreatedAt  int64    `json:"created_at"`
+	ExpiresAt  int64    `json:"expires_at"`
+	LastUsedAt int64    `json:"last_used_at"`
+}
+
+type CreateAccessTokenResponseBody struct {
+	AccessTokenResponseBody
+	// Token 明文令牌只在创建时返回一次
+	Token string `json:"token"`
+}
+
+type UploadProfileImageResponseBody struct {
+	// FileID 更新资料时作为 avatar_file_id 或 background_file_id
+	FileID    string `json:"file_id"`
+	AccessURL string `json:"access_url"`
+}
+
+type DataJobResponseBody struct {
+	ID string `json:"id"`
+	// Type export 或 erasure
+	Type string `json:"type"`
+	// Status pending、running、succeeded 或 failed
+	Status     string `json:"status"`
+	Step       string `json:"step,omitempty"`
+	CreatedAt  int64  `json:"created_at"`
+	UpdatedAt  int64  `json:"updated_at"`
+	FinishedAt int64  `json:"finished_at,omitempty"`
+	// DownloadURL 导出文件的临时下载地址，仅导出任务成功后返回
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+type ListUsersRequest struct {
+	page.Page
+	Username    string `query:"username"`
+	Nickname    string `query:"nickname"`
+	Email       string `query:"email"`
+	Phone       string `query:"phone"`
+	CreatedFrom int64  `query:"created_from" vd:"$>=0"`
+	CreatedTo   int64  `query:"created_to" vd:"$>=0"`
+	Status      string `query:"status"`
+	SortBy      string `query:"sort_by"`
+	Order       string `query:"order" vd:"in($,'','asc','desc')"`
+}
+
+type UserSummaryResponseBody struct {
+	UserId        string `json:"user_id"`
+	Username      string `json:"username"`
+	Nickname      string `json:"nickname"`
+	AvatarUrl     string `json:"avatar_url"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified"`
+	Phone         string `json:"phone,omitempty"`
+	PhoneVerified bool   `json:"phone_verified"`
+	Status        string `json:"status"`
+	CreatedAt     int64  `json:"created_at"`
+	// SuspendedUntil 暂停截止时间，仅 suspended 状态有效
+	SuspendedUntil  int64  `json:"suspended_until,omitempty"`
+	StatusReason    string `json:"status_reason,omitempty"`
+	StatusChangedAt int64  `json:"status_changed_at,omitempty"`
+}
+
+type ListUsersResponseBody struct {
+	Users []UserSummaryResponseBody `json:"users"`
+	Total int64                     `json:"total"`
+}
+
+type ChangeUserStatusRequest struct {
+	Status string `json:"status" vd:"in($,'active','suspended','banned','deleted')"`
+	// SuspendedUntil 暂停截止时间，unix 秒，仅 status 为 suspended 时有效
+	SuspendedUntil int64  `json:"suspended_until" vd:"$>=0"`
+	Reason         string `json:"reason" vd:"$len($)>0&&$len($)<=255"`
+}
+
+type ListAuditLogsRequest struct {
+	page.Page
+	UserID      int64  `query:"user_id" vd:"$>=0"`
+	ActorID     int64  `query:"actor_id" vd:"$>=0"`
+	Action      string `query:"action"`
+	Outcome     string `query:"outcome"`
+	IP          string `query:"ip"`
+	CreatedFrom int64  `query:"created_from" vd:"$>=0"`
+	CreatedTo   int64  `query:"created_to" vd:"$>=0"`
+}
+
+type RecentActivityRequest struct {
+	Limit int `query:"limit" vd:"$>=0"`
+}
+
+type AuditLogResponseBody struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	ActorID   string `json:"actor_id"`
+	Action    string `json:"action"`
+	Outcome   string `json:"outcome"`
+	Reason    string `json:"reason,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+	RequestID string `json:"request_id"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+type ListAuditLogsResponseBody struct {
+	Logs  []AuditLogResponseBody `json:"logs"`
+	Total int64                  `json:"total"`
+}
+
+type RecentActivityResponseBody struct {
+	Logs []AuditLogResponseBody `json:"logs"`
+}
+
+type SessionResponseBody struct {
+	ID         string `json:"id"`
+	Device     string `json:"device"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	CreatedAt  int64  `json:"created_at"`
+	LastSeenAt int64  `json:"last_seen_at"`
+	Current    bool   `json:"current"`
+}
+
+type ListSessionsResponseBody struct {
+	Sessions []SessionResponseBody `json:"sessions"`
+}
+
+type GetPreferencesRequest struct {
+	// Keys 以逗号分隔的偏好项名称，为空时返回全部偏好项
+	Keys string `query:"keys"`
+}
+
+type SetPreferenceRequest struct {
+	// Value 偏好值，null 表示恢复默认值
+	Value json.RawMessage `json:"value"`
+}
+
+type BatchSetPreferencesRequest struct {
+	Values map[string]json.RawMessage `json:"values"`
+}
+
+type PreferencesResponseBody struct {
+	Values map[string]json.RawMessage `json:"values"`
+}
@@ -18,9 +18,12 @@ func HandlerSuccess(c *app.RequestContext, data interface{}) {
 	c.JSON(consts.StatusOK, resp)
 }
 
+// HandlerError 返回预定义错误或下游服务的业务错误 Error，其余错误统一返回 500
 func HandlerError(c *app.RequestContext, err error) {
 	resp := Response{Code: errorCodeMap[err], Message: err.Error()}
-	if _, ok := errorCodeMap[err]; !ok {
+	if e, ok := err.(Error); ok {
+		resp = Response{Code: e.Code, Message: e.Message}
+	} else if _, ok := errorCodeMap[err]; !ok {
 		resp = Response{Code: 500, Message: "unknown error"}
 	}
 	c.JSON(consts.StatusOK, resp)
@@ -0,0 +1,89 @@
+package v1
+
+import "github.com/Wenrh2004/lark-lite-server/pkg/page"
+
+type CreateWorkspaceRequest struct {
+	Name string `json:"name" vd:"$len($)>0&&$len($)<=64"`
+}
+
+type RenameWorkspaceRequest struct {
+	Name string `json:"name" vd:"$len($)>0&&$len($)<=64"`
+}
+
+type WorkspaceResponseBody struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	OwnerID string `json:"owner_id"`
+	// Role 当前用户在该工作空间中的角色：owner、admin、member、viewer
+	Role      string `json:"role"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+type ListWorkspacesResponseBody struct {
+	Workspaces []WorkspaceResponseBody `json:"workspaces"`
+}
+
+type ListMembersRequest struct {
+	page.Page
+}
+
+type MemberResponseBody struct {
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+	JoinedAt int64  `json:"joined_at"`
+}
+
+type ListMembersResponseBody struct {
+	Members []MemberResponseBody `json:"members"`
+	Total   int64                `json:"total"`
+}
+
+type ChangeMemberRoleRequest struct {
+	Role string `json:"role" vd:"in($,'admin','member','viewer')"`
+}
+
+type TransferOwnershipRequest struct {
+	NewOwnerID string `json:"new_owner_id" vd:"$len($)>0"`
+}
+
+type CreateInviteLinkRequest struct {
+	Role string `json:"role" vd:"in($,'admin','member','viewer')"`
+	// ExpiresIn 有效期，秒，为 0 时使用服务端默认值
+	ExpiresIn int64 `json:"expires_in" vd:"$>=0"`
+	// MaxUses 最大使用次数，0 表示不限
+	MaxUses int32 `json:"max_uses" vd:"$>=0"`
+}
+
+type InvitationResponseBody struct {
+	ID        string `json:"id"`
+	InviterID string `json:"inviter_id"`
+	Role      string `json:"role"`
+	// Email 邮件邀请的收件人，邀请链接为空
+	Email     string `json:"email,omitempty"`
+	MaxUses   int32  `json:"max_uses"`
+	Uses      int32  `json:"uses"`
+	ExpiresAt int64  `json:"expires_at"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+type CreateInviteLinkResponseBody struct {
+	Invitation InvitationResponseBody `json:"invitation"`
+	// Token 邀请令牌，只在创建时返回
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+type SendEmailInvitationsRequest struct {
+	Role string `json:"role" vd:"in($,'admin','member','viewer')"`
+	// Emails 收件邮箱，一次最多 20 个
+	Emails []string `json:"emails"`
+}
+
+type ListInvitationsResponseBody struct {
+	Invitations []InvitationResponseBody `json:"invitations"`
+}
+
+type AcceptInvitationRequest struct {
+	Token string `json:"token" vd:"$len($)>0"`
+}
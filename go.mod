@@ -4,7 +4,9 @@ go 1.24
 
 require (
 	github.com/apache/rocketmq-client-go/v2 v2.1.2
+	github.com/bytedance/gopkg v0.1.2
 	github.com/bytedance/sonic v1.13.3
+	github.com/casbin/casbin/v2 v2.105.0
 	github.com/cloudwego/hertz v0.10.0
 	github.com/cloudwego/kitex v0.14.0
 	github.com/cloudwego/prutal v0.1.2
@@ -15,11 +17,15 @@ require (
 	github.com/kitex-contrib/registry-nacos/v2 v2.0.0-20250312112926-3d89dd64eadf
 	github.com/minio/minio-go/v7 v7.0.94
 	github.com/nacos-group/nacos-sdk-go/v2 v2.3.2
+	github.com/nyaruka/phonenumbers v1.0.55
+	github.com/pquerna/otp v1.5.0
 	github.com/redis/go-redis/v9 v9.10.0
 	github.com/sony/sonyflake v1.2.1
 	github.com/spf13/viper v1.20.1
 	github.com/swaggo/files v1.0.1
 	go.uber.org/zap v1.27.0
+	golang.org/x/crypto v0.36.0
+	golang.org/x/oauth2 v0.30.0
 	golang.org/x/sync v0.15.0
 	gopkg.in/gomail.v2 v2.0.0-20160411212932-81ebce5c23df
 	gopkg.in/natefinch/lumberjack.v2 v2.2.1
@@ -56,9 +62,11 @@ require (
 	github.com/aliyun/aliyun-secretsmanager-client-go v1.1.5 // indirect
 	github.com/aliyun/credentials-go v1.4.3 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/bmatcuk/doublestar/v4 v4.6.1 // indirect
+	github.com/boombuler/barcode v1.0.1-0.20190219062509-6c824513bacc // indirect
 	github.com/buger/jsonparser v1.1.1 // indirect
-	github.com/bytedance/gopkg v0.1.2 // indirect
 	github.com/bytedance/sonic/loader v0.2.4 // indirect
+	github.com/casbin/govaluate v1.3.0 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/clbanning/mxj/v2 v2.5.5 // indirect
 	github.com/cloudwego/base64x v0.1.5 // indirect
@@ -113,7 +121,6 @@ require (
 	github.com/minio/md5-simd v1.1.2 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.2 // indirect
-	github.com/nyaruka/phonenumbers v1.0.55 // indirect
 	github.com/orcaman/concurrent-map v0.0.0-20210501183033-44dafcb38ecc // indirect
 	github.com/patrickmn/go-cache v2.1.0+incompatible // indirect
 	github.com/pelletier/go-toml/v2 v2.2.3 // indirect
@@ -143,7 +150,6 @@ require (
 	go.uber.org/atomic v1.9.0 // indirect
 	go.uber.org/multierr v1.10.0 // indirect
 	golang.org/x/arch v0.14.0 // indirect
-	golang.org/x/crypto v0.36.0 // indirect
 	golang.org/x/mod v0.18.0 // indirect
 	golang.org/x/net v0.38.0 // indirect
 	golang.org/x/sys v0.31.0 // indirect
@@ -26,7 +26,8 @@ func NewFileJob(srv *adapter.Service, fs domain.FileService) *FileJob {
 	}
 }
 
-func (f *FileJob) UploadFailed(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
+// UploadEvent 按事件类型异步校验上传内容或处理上传超时检查
+func (f *FileJob) UploadEvent(ctx context.Context, msgs ...*primitive.MessageExt) (consumer.ConsumeResult, error) {
 	for _, msg := range msgs {
 		var e event.UploadEvent
 		if err := sonic.Unmarshal(msg.Body, &e); err != nil {
@@ -35,6 +36,13 @@ func (f *FileJob) UploadFailed(ctx context.Context, msgs ...*primitive.MessageEx
 		file := &domain.File{
 			ID: e.FileID,
 		}
+		if e.Type == event.Verify {
+			if err := f.fs.VerifyUpload(ctx, file); err != nil {
+				f.srv.Logger.Error("[Adapter.FileJob.UploadEvent]verify upload failed", zap.Uint64("file_id", file.ID), zap.Error(err))
+				return consumer.ConsumeRetryLater, fmt.Errorf("[Adapter.FileJob.UploadEvent]file id:%d : %w", file.ID, err)
+			}
+			continue
+		}
 		if err := f.fs.UploadFailed(ctx, file); err != nil {
 			f.srv.Logger.Error("[Adapter.FileJob.UploadFailed]upload failed", zap.Uint64("file_id", file.ID), zap.Error(err))
 			return consumer.ConsumeRetryLater, fmt.Errorf("[Adapter.FileJob.UploadFailed]file id:%d : %w", file.ID, err)
@@ -42,3 +50,15 @@ func (f *FileJob) UploadFailed(ctx context.Context, msgs ...*primitive.MessageEx
 	}
 	return consumer.ConsumeSuccess, nil
 }
+
+// CollectGarbage 回收没有引用的文件以及长期未完成或失败的上传
+func (f *FileJob) CollectGarbage(ctx context.Context) error {
+	n, err := f.fs.CollectGarbage(ctx)
+	if err != nil {
+		return fmt.Errorf("[Adapter.FileJob.CollectGarbage]collect garbage: %w", err)
+	}
+	if n > 0 {
+		f.srv.Logger.Info("[Adapter.FileJob.CollectGarbage]files purged", zap.Int("count", n))
+	}
+	return nil
+}
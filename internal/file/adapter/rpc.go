@@ -2,7 +2,9 @@ package adapter
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Wenrh2004/lark-lite-server/internal/file/domain"
 	"github.com/Wenrh2004/lark-lite-server/kitex_gen/file"
@@ -23,28 +25,44 @@ func NewFileService(srv *adapter.Service, fs domain.FileService) *FileService {
 
 func (f *FileService) PrepareUpload(ctx context.Context, req *file.PrepareUploadReq) (res *file.PrepareUploadResp, err error) {
 	uploadURL, err := f.fs.GetPreUploadURL(ctx, &domain.File{
-		Domain: req.GetDomain(),
-		Name:   req.GetFileName(),
-		Size:   req.GetSize(),
-		Hash:   req.GetMd5(),
-		Type:   req.GetContentType(),
+		Domain:   req.GetDomain(),
+		Name:     req.GetFileName(),
+		Size:     req.GetSize(),
+		Hash:     req.GetMd5(),
+		Type:     req.GetContentType(),
+		UploadBy: req.GetUploadBy(),
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &file.PrepareUploadResp{
+	res = &file.PrepareUploadResp{
 		Exists:    uploadURL.Exists,
 		FileId:    uploadURL.ID,
 		UploadUrl: uploadURL.UploadURL,
 		AccessUrl: uploadURL.AccessURL,
-	}, nil
+	}
+	if c := uploadURL.Challenge; c != nil {
+		res.ChallengeId = c.ID
+		res.ChallengeOffset = c.Offset
+		res.ChallengeLength = c.Length
+	}
+	return res, nil
 }
 
 func (f *FileService) CompleteUpload(ctx context.Context, req *file.CompleteUploadReq) (res *file.CompleteUploadResp, err error) {
 	if err = f.fs.CompleteUpload(ctx, &domain.File{
-		ID:       req.FileId,
-		UploadBy: req.UploadBy,
+		ID:        req.FileId,
+		UploadBy:  req.UploadBy,
+		Challenge: &domain.UploadChallenge{ID: req.GetChallengeId()},
+		Proof:     req.GetProof(),
 	}); err != nil {
+		var integrityErr *domain.IntegrityError
+		if errors.As(err, &integrityErr) {
+			return &file.CompleteUploadResp{FailReason: integrityErr.Reason}, nil
+		}
+		if errors.Is(err, domain.ErrUploadVerifying) {
+			return &file.CompleteUploadResp{Verifying: true}, nil
+		}
 		return nil, fmt.Errorf("[Adapter.FileService.CompleteUpload] Completeupload failed: %w", err)
 	}
 	return &file.CompleteUploadResp{
@@ -52,7 +70,230 @@ func (f *FileService) CompleteUpload(ctx context.Context, req *file.CompleteUplo
 	}, nil
 }
 
+func (f *FileService) InitiateMultipartUpload(ctx context.Context, req *file.InitiateMultipartUploadReq) (res *file.InitiateMultipartUploadResp, err error) {
+	fi, upload, err := f.fs.InitiateMultipartUpload(ctx, &domain.File{
+		Domain:   req.GetDomain(),
+		Name:     req.GetFileName(),
+		Size:     req.GetSize(),
+		Hash:     req.GetMd5(),
+		Type:     req.GetContentType(),
+		UploadBy: req.GetUploadBy(),
+	}, req.GetPartSize())
+	if err != nil {
+		return nil, fmt.Errorf("[Adapter.FileService.InitiateMultipartUpload] initiate upload failed: %w", err)
+	}
+	res = &file.InitiateMultipartUploadResp{
+		Exists:    fi.Exists,
+		FileId:    fi.ID,
+		AccessUrl: fi.AccessURL,
+	}
+	if upload != nil {
+		res.PartSize = upload.PartSize
+		res.PartCount = int32(upload.PartCount)
+	}
+	if c := fi.Challenge; c != nil {
+		res.ChallengeId = c.ID
+		res.ChallengeOffset = c.Offset
+		res.ChallengeLength = c.Length
+	}
+	return res, nil
+}
+
+func (f *FileService) GetUploadPartURLs(ctx context.Context, req *file.GetUploadPartURLsReq) (res *file.GetUploadPartURLsResp, err error) {
+	numbers := make([]int, 0, len(req.GetPartNumbers()))
+	for _, n := range req.GetPartNumbers() {
+		numbers = append(numbers, int(n))
+	}
+	urls, expiresAt, err := f.fs.GetUploadPartURLs(ctx, req.GetUploadBy(), req.GetFileId(), numbers)
+	if err != nil {
+		return nil, fmt.Errorf("[Adapter.FileService.GetUploadPartURLs] get part urls failed: %w", err)
+	}
+	res = &file.GetUploadPartURLsResp{
+		Parts:     make([]*file.UploadPartURL, 0, len(urls)),
+		ExpiresAt: expiresAt.Unix(),
+	}
+	for _, u := range urls {
+		res.Parts = append(res.Parts, &file.UploadPartURL{PartNumber: int32(u.Number), UploadUrl: u.UploadURL})
+	}
+	return res, nil
+}
+
+func (f *FileService) ListUploadedParts(ctx context.Context, req *file.ListUploadedPartsReq) (res *file.ListUploadedPartsResp, err error) {
+	upload, parts, err := f.fs.ListUploadedParts(ctx, req.GetUploadBy(), req.GetFileId())
+	if err != nil {
+		return nil, fmt.Errorf("[Adapter.FileService.ListUploadedParts] list parts failed: %w", err)
+	}
+	res = &file.ListUploadedPartsResp{
+		PartSize:  upload.PartSize,
+		PartCount: int32(upload.PartCount),
+		Parts:     make([]*file.UploadedPart, 0, len(parts)),
+	}
+	for _, p := range parts {
+		res.Parts = append(res.Parts, &file.UploadedPart{PartNumber: int32(p.Number), Etag: p.ETag, Size: p.Size})
+	}
+	return res, nil
+}
+
+func (f *FileService) CompleteMultipartUpload(ctx context.Context, req *file.CompleteMultipartUploadReq) (res *file.CompleteUploadResp, err error) {
+	if err = f.fs.CompleteMultipartUpload(ctx, &domain.File{
+		ID:       req.GetFileId(),
+		UploadBy: req.GetUploadBy(),
+	}); err != nil {
+		var integrityErr *domain.IntegrityError
+		if errors.As(err, &integrityErr) {
+			return &file.CompleteUploadResp{FailReason: integrityErr.Reason}, nil
+		}
+		if errors.Is(err, domain.ErrUploadVerifying) {
+			return &file.CompleteUploadResp{Verifying: true}, nil
+		}
+		return nil, fmt.Errorf("[Adapter.FileService.CompleteMultipartUpload] complete upload failed: %w", err)
+	}
+	return &file.CompleteUploadResp{
+		Success: true,
+	}, nil
+}
+
+func (f *FileService) AbortMultipartUpload(ctx context.Context, req *file.AbortMultipartUploadReq) (res *file.AbortMultipartUploadResp, err error) {
+	if err = f.fs.AbortMultipartUpload(ctx, req.GetUploadBy(), req.GetFileId()); err != nil {
+		return nil, fmt.Errorf("[Adapter.FileService.AbortMultipartUpload] abort upload failed: %w", err)
+	}
+	return &file.AbortMultipartUploadResp{
+		Success: true,
+	}, nil
+}
+
 func (f *FileService) GetFileStatus(ctx context.Context, req *file.GetFileStatusReq) (res *file.GetFileStatusResp, err error) {
-	// TODO implement me
-	panic("implement me")
+	fi, err := f.fs.GetFile(ctx, req.GetUserId(), &domain.File{ID: req.GetFileId()})
+	if err != nil {
+		if errors.Is(err, domain.ErrFileNotFound) {
+			return &file.GetFileStatusResp{Status: file.GetFileStatusResp_NOT_FOUND, FileId: req.GetFileId()}, nil
+		}
+		return nil, fmt.Errorf("[Adapter.FileService.GetFileStatus] get file failed: %w", err)
+	}
+	return toFileStatusResp(fi), nil
+}
+
+func (f *FileService) BatchGetFileStatus(ctx context.Context, req *file.BatchGetFileStatusReq) (res *file.BatchGetFileStatusResp, err error) {
+	files, err := f.fs.GetFiles(ctx, req.GetUserId(), req.GetFileIds())
+	if err != nil {
+		return nil, fmt.Errorf("[Adapter.FileService.BatchGetFileStatus] get files failed: %w", err)
+	}
+	res = &file.BatchGetFileStatusResp{Files: make([]*file.GetFileStatusResp, 0, len(files))}
+	for i, fi := range files {
+		if fi == nil {
+			res.Files = append(res.Files, &file.GetFileStatusResp{
+				Status: file.GetFileStatusResp_NOT_FOUND,
+				FileId: req.GetFileIds()[i],
+			})
+			continue
+		}
+		res.Files = append(res.Files, toFileStatusResp(fi))
+	}
+	return res, nil
+}
+
+func toFileStatusResp(fi *domain.File) *file.GetFileStatusResp {
+	res := &file.GetFileStatusResp{
+		Status:      toFileStatus(fi.Status),
+		AccessUrl:   fi.AccessURL,
+		Size:        fi.Size,
+		ContentType: fi.Type,
+		Domain:      fi.Domain,
+		FileId:      fi.ID,
+		FileName:    fi.Name,
+		Hash:        fi.Hash,
+		FailReason:  fi.FailReason,
+	}
+	if !fi.CreatedAt.IsZero() {
+		res.CreatedAt = fi.CreatedAt.Unix()
+	}
+	if !fi.UpdatedAt.IsZero() {
+		res.UpdatedAt = fi.UpdatedAt.Unix()
+	}
+	return res
+}
+
+func toFileStatus(status int) file.GetFileStatusResp_Status {
+	switch status {
+	case domain.FileStatusSuccess:
+		return file.GetFileStatusResp_UPLOADED
+	case domain.FileStatusFailed:
+		return file.GetFileStatusResp_FAILED
+	default:
+		return file.GetFileStatusResp_PENDING
+	}
+}
+
+func (f *FileService) GetDownloadURL(ctx context.Context, req *file.GetDownloadURLReq) (res *file.GetDownloadURLResp, err error) {
+	fi, err := f.fs.GetDownloadURL(ctx, req.GetUserId(), req.GetFileId(),
+		time.Duration(req.GetExpiresIn())*time.Second, req.GetFileName())
+	switch {
+	case err == nil:
+		return &file.GetDownloadURLResp{
+			Status:      file.GetDownloadURLResp_OK,
+			DownloadUrl: fi.DownloadURL,
+			ExpiresAt:   fi.ExpiresAt.Unix(),
+		}, nil
+	case errors.Is(err, domain.ErrFileNotFound):
+		return &file.GetDownloadURLResp{Status: file.GetDownloadURLResp_NOT_FOUND}, nil
+	case errors.Is(err, domain.ErrFileForbidden):
+		return &file.GetDownloadURLResp{Status: file.GetDownloadURLResp_FORBIDDEN}, nil
+	case errors.Is(err, domain.ErrFileNotReady):
+		return &file.GetDownloadURLResp{Status: file.GetDownloadURLResp_NOT_UPLOADED}, nil
+	default:
+		return nil, fmt.Errorf("[Adapter.FileService.GetDownloadURL] get download url failed: %w", err)
+	}
+}
+
+func (f *FileService) ListUserFiles(ctx context.Context, req *file.ListUserFilesReq) (res *file.ListUserFilesResp, err error) {
+	files, err := f.fs.ListUserFiles(ctx, req.GetUserId(), req.GetFileIds(), time.Duration(req.GetUrlExpiresIn())*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("[Adapter.FileService.ListUserFiles] list user files failed: %w", err)
+	}
+	res = &file.ListUserFilesResp{Files: make([]*file.FileInfo, 0, len(files))}
+	for _, fi := range files {
+		res.Files = append(res.Files, &file.FileInfo{
+			FileId:      fi.ID,
+			Domain:      fi.Domain,
+			FileName:    fi.Name,
+			Size:        fi.Size,
+			ContentType: fi.Type,
+			Md5:         fi.Hash,
+			CreatedAt:   fi.CreatedAt.Unix(),
+			DownloadUrl: fi.DownloadURL,
+			AccessUrl:   fi.AccessURL,
+		})
+	}
+	return res, nil
+}
+
+func (f *FileService) DetachUserFiles(ctx context.Context, req *file.DetachUserFilesReq) (res *file.DetachUserFilesResp, err error) {
+	n, err := f.fs.DetachUserFiles(ctx, req.GetUserId())
+	if err != nil {
+		return nil, fmt.Errorf("[Adapter.FileService.DetachUserFiles] detach user files failed: %w", err)
+	}
+	return &file.DetachUserFilesResp{Detached: n}, nil
+}
+
+func (f *FileService) DeleteFile(ctx context.Context, req *file.DeleteFileReq) (res *file.DeleteFileResp, err error) {
+	if err = f.fs.DeleteFile(ctx, req.GetUserId(), req.GetFileId()); err != nil {
+		if errors.Is(err, domain.ErrFileNotFound) {
+			return &file.DeleteFileResp{Status: file.DeleteFileResp_NOT_FOUND}, nil
+		}
+		if errors.Is(err, domain.ErrFilePinned) {
+			return &file.DeleteFileResp{Status: file.DeleteFileResp_PINNED}, nil
+		}
+		return nil, fmt.Errorf("[Adapter.FileService.DeleteFile] delete file failed: %w", err)
+	}
+	return &file.DeleteFileResp{Status: file.DeleteFileResp_OK}, nil
+}
+
+func (f *FileService) PinFile(ctx context.Context, req *file.PinFileReq) (res *file.PinFileResp, err error) {
+	if err = f.fs.PinFile(ctx, req.GetUserId(), req.GetFileId(), req.GetPinned()); err != nil {
+		if errors.Is(err, domain.ErrFileNotFound) {
+			return &file.PinFileResp{Status: file.PinFileResp_NOT_FOUND}, nil
+		}
+		return nil, fmt.Errorf("[Adapter.FileService.PinFile] pin file failed: %w", err)
+	}
+	return &file.PinFileResp{Status: file.PinFileResp_OK}, nil
 }
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"time"
+
 	"github.com/apache/rocketmq-client-go/v2/consumer"
 	kitexregistry "github.com/cloudwego/kitex/pkg/registry"
 	"github.com/cloudwego/kitex/server"
@@ -10,6 +12,7 @@ import (
 	"github.com/Wenrh2004/lark-lite-server/kitex_gen/file/fileservice"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/job"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/task"
 	"github.com/Wenrh2004/lark-lite-server/pkg/log"
 )
 
@@ -20,8 +23,17 @@ func NewRPCApplication(logger *log.Logger, r kitexregistry.Registry, srv *adapte
 
 func NewJobApplication(conf *viper.Viper, logger *log.Logger, fs *adapter.FileJob) *job.Server {
 	j := job.NewJob(conf, logger)
-	if err := j.Subscribe(conf.GetString("app.mq.topic"), consumer.MessageSelector{}, fs.UploadFailed); err != nil {
+	if err := j.Subscribe(conf.GetString("app.mq.topic"), consumer.MessageSelector{}, fs.UploadEvent); err != nil {
 		panic(err)
 	}
 	return j
 }
+
+// NewTaskApplication 定时回收没有引用的文件
+func NewTaskApplication(conf *viper.Viper, logger *log.Logger, fs *adapter.FileJob) *task.Server {
+	interval := conf.GetDuration("app.task.file_gc.interval")
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return task.NewServer(logger, interval, fs.CollectGarbage)
+}
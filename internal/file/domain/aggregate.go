@@ -25,8 +25,54 @@ type File struct {
 	AccessURL string
 	ExpiresAt time.Time
 	UploadBy  uint64
+	Status    int
+	// FailReason 上传完成校验失败的原因
+	FailReason string
+	// DownloadURL 临时下载地址，只在查询时生成
+	DownloadURL string
+	// Challenge 秒传时的持有证明挑战，完成上传时只需要 ID
+	Challenge *UploadChallenge
+	// Proof 完成秒传时挑战范围内容的 SHA-256 十六进制值
+	Proof     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 func (f *File) GetFileKey() string {
 	return strings.Join([]string{strconv.FormatUint(f.ID, 10), f.Name}, ":")
 }
+
+// UploadChallenge 秒传的持有证明挑战，上传者需要提交对象 [Offset, Offset+Length) 范围内容的 SHA-256
+type UploadChallenge struct {
+	ID     string
+	Offset int64
+	Length int64
+}
+
+// MultipartUpload 分片上传会话，保存在 Redis 中，最后一个分片可以小于 PartSize
+type MultipartUpload struct {
+	FileID    uint64
+	Domain    string
+	UploadID  string
+	PartSize  int64
+	PartCount int
+	// UploadBy 创建上传的用户，分片操作只接受该用户
+	UploadBy uint64
+	// Completed 存储中已合并分片，重试完成上传时无需再次合并
+	Completed bool
+	// UpdatedAt 最近一次获取分片地址或查询分片的时间，用于判断上传是否中断
+	UpdatedAt time.Time
+}
+
+// UploadedPart 已上传的分片
+type UploadedPart struct {
+	Number int
+	ETag   string
+	Size   int64
+}
+
+// PartURL 分片的上传地址
+type PartURL struct {
+	Number    int
+	UploadURL string
+}
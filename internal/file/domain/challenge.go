@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// challengeLength 持有证明挑战的内容长度，小于该大小的文件挑战全部内容
+	challengeLength  = 64 << 10
+	challengeExpires = 10 * time.Minute
+)
+
+// newChallenge 为大小为 size 的文件生成随机范围的持有证明挑战
+func newChallenge(size int64) (*UploadChallenge, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	c := &UploadChallenge{ID: hex.EncodeToString(b), Length: min(size, challengeLength)}
+	if size > c.Length {
+		n, err := rand.Int(rand.Reader, big.NewInt(size-c.Length+1))
+		if err != nil {
+			return nil, err
+		}
+		c.Offset = n.Int64()
+	}
+	return c, nil
+}
+
+// issueChallenge 为秒传的文件生成并保存持有证明挑战，秒传只返回文件 ID，
+// 上传者需要在 CompleteUpload 时证明持有文件内容，避免只凭公开的哈希关联他人的文件
+func (f *fileService) issueChallenge(ctx context.Context, file *File) error {
+	c, err := newChallenge(file.Size)
+	if err != nil {
+		return fmt.Errorf("gen challenge: %w", err)
+	}
+	if err := f.repo.SaveUploadChallenge(ctx, file.ID, c, challengeExpires); err != nil {
+		return fmt.Errorf("save challenge: %w", err)
+	}
+	file.Challenge = c
+	return nil
+}
+
+// verifyPossession 校验上传者对挑战范围内容的证明，挑战只能使用一次
+func (f *fileService) verifyPossession(ctx context.Context, current, file *File) error {
+	if file.Challenge == nil || file.Challenge.ID == "" || file.Proof == "" {
+		return ErrInvalidProof
+	}
+	c, err := f.repo.TakeUploadChallenge(ctx, file.ID, file.Challenge.ID)
+	if err != nil {
+		if errors.Is(err, ErrChallengeNotFound) {
+			return ErrInvalidProof
+		}
+		return fmt.Errorf("take challenge: %w", err)
+	}
+	digest, err := f.repo.DigestObjectRange(ctx, current, c.Offset, c.Length)
+	if err != nil {
+		return fmt.Errorf("digest object: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(digest), []byte(strings.ToLower(file.Proof))) != 1 {
+		return ErrInvalidProof
+	}
+	return nil
+}
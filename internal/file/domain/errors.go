@@ -0,0 +1,44 @@
+package domain
+
+import "errors"
+
+var (
+	ErrFileNotFound   = errors.New("file not found")
+	ErrTooManyFileIDs = errors.New("too many file ids")
+	ErrFileForbidden  = errors.New("file access forbidden")
+	ErrFileNotReady   = errors.New("file not uploaded")
+	ErrInvalidName    = errors.New("invalid file name")
+	// ErrFilePinned 用户与文件的关联被引用（如用户头像），解除引用前不能删除
+	ErrFilePinned = errors.New("file is pinned")
+	// ErrFileStatusChanged 文件状态已被并发修改，不再处于状态变更要求的原状态
+	ErrFileStatusChanged = errors.New("file status changed concurrently")
+	// ErrIntegrityMismatch 上传的内容与声明的大小或哈希不一致，文件已标记为上传失败
+	ErrIntegrityMismatch = errors.New("file integrity check failed")
+	// ErrUploadVerifying 上传内容需要异步校验，文件仍在等待上传，校验完成后完成上传或标记为上传失败
+	ErrUploadVerifying = errors.New("upload verification in progress")
+	// ErrInvalidProof 秒传时没有提交持有证明、挑战已过期或证明与文件内容不一致
+	ErrInvalidProof      = errors.New("invalid proof of possession")
+	ErrChallengeNotFound = errors.New("upload challenge not found")
+
+	ErrInvalidFileSize   = errors.New("file size must be positive")
+	ErrInvalidPartSize   = errors.New("part size must be between 5 MiB and 5 GiB")
+	ErrInvalidPartNumber = errors.New("invalid part number")
+	ErrTooManyParts      = errors.New("too many part numbers")
+	ErrUploadNotFound    = errors.New("upload not found")
+	ErrPartsIncomplete   = errors.New("multipart upload has missing parts")
+	// ErrMultipartUpload 分片上传的文件只能通过 CompleteMultipartUpload 完成
+	ErrMultipartUpload = errors.New("file is uploaded in parts, complete it with CompleteMultipartUpload")
+)
+
+// IntegrityError 上传完成校验失败，Reason 为记录到文件上的失败原因
+type IntegrityError struct {
+	Reason string
+}
+
+func (e *IntegrityError) Error() string {
+	return ErrIntegrityMismatch.Error() + ": " + e.Reason
+}
+
+func (e *IntegrityError) Unwrap() error {
+	return ErrIntegrityMismatch
+}
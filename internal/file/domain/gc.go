@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// gcBatchSize 每次回收每种文件的最大数量，未回收完的文件留到下次执行
+	gcBatchSize = 100
+	// unreferencedRetention 引用归零后保留的时间，期间秒传仍可以复用文件
+	unreferencedRetention = time.Hour
+	// failedRetention 上传失败的文件保留的时间，便于查询失败原因
+	failedRetention = 24 * time.Hour
+	// pendingRetention 超过该时间仍未完成的上传视为中断，需要大于分片上传会话的保存时间
+	pendingRetention = 7 * 24 * time.Hour
+)
+
+// gcRules 回收的文件状态及其保留时间
+var gcRules = []struct {
+	status    int
+	retention time.Duration
+}{
+	{FileStatusSuccess, unreferencedRetention},
+	{FileStatusFailed, failedRetention},
+	{FileStatusPending, pendingRetention},
+}
+
+func (f *fileService) DeleteFile(ctx context.Context, userID, fileID uint64) error {
+	var found bool
+	if err := f.srv.Tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		found, err = f.repo.DeleteFileUser(ctx, fileID, userID)
+		return err
+	}); err != nil {
+		return fmt.Errorf("[Domain.FileService.DeleteFile]delete file user: %w", err)
+	}
+	if !found {
+		return ErrFileNotFound
+	}
+	return nil
+}
+
+func (f *fileService) PinFile(ctx context.Context, userID, fileID uint64, pinned bool) error {
+	found, err := f.repo.PinFileUser(ctx, fileID, userID, pinned)
+	if err != nil {
+		return fmt.Errorf("[Domain.FileService.PinFile]pin file user: %w", err)
+	}
+	if !found {
+		return ErrFileNotFound
+	}
+	return nil
+}
+
+func (f *fileService) CollectGarbage(ctx context.Context) (int, error) {
+	now := time.Now()
+	purged := 0
+	for _, rule := range gcRules {
+		files, err := f.repo.ListUnreferencedFiles(ctx, rule.status, now.Add(-rule.retention), gcBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("[Domain.FileService.CollectGarbage]list files: %w", err)
+		}
+		for _, file := range files {
+			ok, err := f.purge(ctx, file)
+			if err != nil {
+				// 单个文件回收失败不影响其他文件，下次执行时重试
+				f.srv.Logger.WithContext(ctx).Error("[Domain.FileService.CollectGarbage]purge file failed",
+					zap.Uint64("file_id", file.ID), zap.Error(err))
+				continue
+			}
+			if ok {
+				purged++
+			}
+		}
+	}
+	return purged, nil
+}
+
+// purge 先删除文件记录再删除对象，删除记录后秒传与关联都不会再引用该文件
+func (f *fileService) purge(ctx context.Context, file *File) (bool, error) {
+	if file.Status == FileStatusPending {
+		upload, err := f.repo.GetMultipartUpload(ctx, file.ID)
+		switch {
+		case err == nil:
+			if time.Since(upload.UpdatedAt) < multipartIdleTimeout {
+				return false, nil
+			}
+			if err := f.repo.AbortMultipartUpload(ctx, upload); err != nil {
+				return false, fmt.Errorf("abort multipart upload: %w", err)
+			}
+		case !errors.Is(err, ErrUploadNotFound):
+			return false, fmt.Errorf("get multipart upload: %w", err)
+		}
+	}
+	var purged bool
+	if err := f.srv.Tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		purged, err = f.repo.PurgeFile(ctx, file)
+		return err
+	}); err != nil {
+		return false, fmt.Errorf("purge file: %w", err)
+	}
+	if !purged {
+		return false, nil
+	}
+	if err := f.repo.RemoveFileObject(ctx, file); err != nil {
+		return false, fmt.Errorf("remove object: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultPartSize = 16 << 20
+	minPartSize     = 5 << 20
+	maxPartSize     = 5 << 30
+	// maxPartCount 对象存储允许的最大分片数
+	maxPartCount = 10000
+	// maxPartURLsPerRequest 单次获取分片上传地址的最大数量
+	maxPartURLsPerRequest = 100
+	partURLExpires        = time.Hour
+	// multipartIdleTimeout 分片上传超过该时间没有活动时视为中断，由上传超时检查中止
+	multipartIdleTimeout = 24 * time.Hour
+)
+
+// planPartSize 计算分片大小，分片数超过 maxPartCount 时按 MiB 向上取整增大分片大小
+func planPartSize(size, partSize int64) (int64, int, error) {
+	if size <= 0 {
+		return 0, 0, ErrInvalidFileSize
+	}
+	if partSize == 0 {
+		partSize = defaultPartSize
+	}
+	if partSize < minPartSize || partSize > maxPartSize {
+		return 0, 0, ErrInvalidPartSize
+	}
+	if (size+partSize-1)/partSize > maxPartCount {
+		partSize = (size + maxPartCount - 1) / maxPartCount
+		partSize = (partSize + 1<<20 - 1) &^ (1<<20 - 1)
+		if partSize > maxPartSize {
+			return 0, 0, ErrInvalidFileSize
+		}
+	}
+	return partSize, int((size + partSize - 1) / partSize), nil
+}
+
+func (f *fileService) InitiateMultipartUpload(ctx context.Context, file *File, partSize int64) (*File, *MultipartUpload, error) {
+	partSize, partCount, err := planPartSize(file.Size, partSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	id, err := f.srv.Sid.GenUint64()
+	if err != nil {
+		return nil, nil, fmt.Errorf("[Domain.FileService.InitiateMultipartUpload]gen file id: %w", err)
+	}
+	file.ID = id
+	upload := &MultipartUpload{
+		PartSize:  partSize,
+		PartCount: partCount,
+		UploadBy:  file.UploadBy,
+		UpdatedAt: time.Now(),
+	}
+	res, err := f.repo.InitiateMultipartUpload(ctx, file, upload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[Domain.FileService.InitiateMultipartUpload]initiate upload: %w", err)
+	}
+	if res.Exists {
+		if err := f.issueChallenge(ctx, res); err != nil {
+			return nil, nil, fmt.Errorf("[Domain.FileService.InitiateMultipartUpload]%w", err)
+		}
+		return res, nil, nil
+	}
+	return res, upload, nil
+}
+
+// getMultipartUpload 查询 uploadBy 创建的分片上传会话，其他用户的会话视为不存在
+func (f *fileService) getMultipartUpload(ctx context.Context, uploadBy, fileID uint64) (*MultipartUpload, error) {
+	upload, err := f.repo.GetMultipartUpload(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.UploadBy != uploadBy {
+		return nil, ErrUploadNotFound
+	}
+	return upload, nil
+}
+
+func (f *fileService) GetUploadPartURLs(ctx context.Context, uploadBy, fileID uint64, partNumbers []int) ([]*PartURL, time.Time, error) {
+	if len(partNumbers) == 0 || len(partNumbers) > maxPartURLsPerRequest {
+		return nil, time.Time{}, ErrTooManyParts
+	}
+	upload, err := f.getMultipartUpload(ctx, uploadBy, fileID)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("[Domain.FileService.GetUploadPartURLs]get upload: %w", err)
+	}
+	if upload.Completed {
+		return nil, time.Time{}, ErrUploadNotFound
+	}
+	for _, n := range partNumbers {
+		if n < 1 || n > upload.PartCount {
+			return nil, time.Time{}, ErrInvalidPartNumber
+		}
+	}
+	now := time.Now()
+	urls := make([]*PartURL, 0, len(partNumbers))
+	for _, n := range partNumbers {
+		u, err := f.repo.PresignUploadPart(ctx, upload, n, partURLExpires)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("[Domain.FileService.GetUploadPartURLs]presign part %d: %w", n, err)
+		}
+		urls = append(urls, &PartURL{Number: n, UploadURL: u})
+	}
+	if err := f.repo.TouchMultipartUpload(ctx, fileID, now); err != nil {
+		return nil, time.Time{}, fmt.Errorf("[Domain.FileService.GetUploadPartURLs]touch upload: %w", err)
+	}
+	return urls, now.Add(partURLExpires), nil
+}
+
+func (f *fileService) ListUploadedParts(ctx context.Context, uploadBy, fileID uint64) (*MultipartUpload, []*UploadedPart, error) {
+	upload, err := f.getMultipartUpload(ctx, uploadBy, fileID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[Domain.FileService.ListUploadedParts]get upload: %w", err)
+	}
+	if upload.Completed {
+		return nil, nil, ErrUploadNotFound
+	}
+	parts, err := f.repo.ListUploadedParts(ctx, upload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[Domain.FileService.ListUploadedParts]list parts: %w", err)
+	}
+	if err := f.repo.TouchMultipartUpload(ctx, fileID, time.Now()); err != nil {
+		return nil, nil, fmt.Errorf("[Domain.FileService.ListUploadedParts]touch upload: %w", err)
+	}
+	return upload, parts, nil
+}
+
+// CompleteMultipartUpload 合并分片后会话标记为已合并，完成上传失败时重试直接完成上传；
+// 完成上传后会话被删除，已上传完成的文件重试时由 CompleteUpload 按已关联处理
+func (f *fileService) CompleteMultipartUpload(ctx context.Context, file *File) error {
+	upload, err := f.getMultipartUpload(ctx, file.UploadBy, file.ID)
+	if errors.Is(err, ErrUploadNotFound) {
+		current, err := f.repo.GetFile(ctx, &File{ID: file.ID})
+		if err == nil && current.Status == FileStatusSuccess {
+			return f.completeUpload(ctx, file, current)
+		}
+		return ErrUploadNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("[Domain.FileService.CompleteMultipartUpload]get upload: %w", err)
+	}
+	if !upload.Completed {
+		parts, err := f.repo.ListUploadedParts(ctx, upload)
+		if err != nil {
+			return fmt.Errorf("[Domain.FileService.CompleteMultipartUpload]list parts: %w", err)
+		}
+		// 存储按分片序号返回分片，全部上传时序号恰好为 1..PartCount
+		if len(parts) != upload.PartCount {
+			return ErrPartsIncomplete
+		}
+		for i, p := range parts {
+			if p.Number != i+1 {
+				return ErrPartsIncomplete
+			}
+		}
+		if err := f.repo.CompleteMultipartUpload(ctx, upload, parts); err != nil {
+			return fmt.Errorf("[Domain.FileService.CompleteMultipartUpload]complete upload: %w", err)
+		}
+	}
+	current, err := f.repo.GetFile(ctx, &File{ID: file.ID})
+	if err != nil {
+		return fmt.Errorf("[Domain.FileService.CompleteMultipartUpload]get file: %w", err)
+	}
+	return f.completeUpload(ctx, file, current)
+}
+
+func (f *fileService) AbortMultipartUpload(ctx context.Context, uploadBy, fileID uint64) error {
+	upload, err := f.getMultipartUpload(ctx, uploadBy, fileID)
+	if err != nil {
+		return fmt.Errorf("[Domain.FileService.AbortMultipartUpload]get upload: %w", err)
+	}
+	if err := f.repo.AbortMultipartUpload(ctx, upload); err != nil {
+		return fmt.Errorf("[Domain.FileService.AbortMultipartUpload]abort upload: %w", err)
+	}
+	if err := f.repo.SetFileStatus(ctx, fileID, FileStatusPending, FileStatusFailed); err != nil {
+		return fmt.Errorf("[Domain.FileService.AbortMultipartUpload]set status: %w", err)
+	}
+	return nil
+}
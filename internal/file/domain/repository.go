@@ -1,11 +1,79 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type FileRepository interface {
+	// PreUpload 同一业务域存在哈希相同的已上传文件时返回该文件且 Exists 为 true，否则创建待上传的文件并记录上传者
 	PreUpload(ctx context.Context, file *File) (*File, error)
+	// SaveUploadChallenge 保存文件的持有证明挑战，expires 后失效
+	SaveUploadChallenge(ctx context.Context, fileID uint64, challenge *UploadChallenge, expires time.Duration) error
+	// TakeUploadChallenge 取出并删除文件的持有证明挑战，不存在或已过期时返回 ErrChallengeNotFound
+	TakeUploadChallenge(ctx context.Context, fileID uint64, id string) (*UploadChallenge, error)
+	// DigestObjectRange 计算文件在存储中 [offset, offset+length) 范围内容的 SHA-256 十六进制值
+	DigestObjectRange(ctx context.Context, file *File, offset, length int64) (string, error)
 	CompleteUpload(ctx context.Context, file *File) error
+	// VerifyUpload 按存储中的对象校验文件的大小与哈希，返回校验失败的原因，校验通过时为空，
+	// 对象尚未上传时返回 ErrFileNotReady。full 为 false 时不读取较大对象的全部内容，
+	// 只能读取内容校验时返回 ErrUploadVerifying
+	VerifyUpload(ctx context.Context, fileID uint64, full bool) (string, error)
+	// ScheduleVerification 记录上传者并发送异步校验消息，已在等待校验时不做处理
+	ScheduleVerification(ctx context.Context, fileID, uploadBy uint64) error
+	// GetScheduledVerification 查询等待异步校验的上传者，不存在时返回 ErrUploadNotFound
+	GetScheduledVerification(ctx context.Context, fileID uint64) (uint64, error)
+	// FailUpload 将文件标记为上传失败并记录原因，清除上传状态
+	FailUpload(ctx context.Context, fileID uint64, reason string) error
+	// CreateFileByUploadIDMapping 关联文件与上传者并增加引用计数，已关联时不做处理，
+	// 文件已被回收时返回 ErrFileNotFound
 	CreateFileByUploadIDMapping(ctx context.Context, file *File) error
-	SetFileStatus(ctx context.Context, fileId uint64, status int) error
+	// DeleteFileUser 删除用户与文件的关联并减少引用计数，返回是否存在关联，关联被引用时返回 ErrFilePinned
+	DeleteFileUser(ctx context.Context, fileID, userID uint64) (bool, error)
+	// PinFileUser 设置用户与文件的关联是否被引用，返回是否存在关联
+	PinFileUser(ctx context.Context, fileID, userID uint64, pinned bool) (bool, error)
+	// ListUnreferencedFiles 查询指定状态、没有引用且 before 之前更新的文件
+	ListUnreferencedFiles(ctx context.Context, status int, before time.Time, limit int) ([]*File, error)
+	// PurgeFile 文件仍没有引用且状态未变化时删除文件记录，返回是否删除
+	PurgeFile(ctx context.Context, file *File) (bool, error)
+	// RemoveFileObject 删除文件在存储中的对象及上传状态
+	RemoveFileObject(ctx context.Context, file *File) error
+	// SetFileStatus 将 from 状态的文件改为 to，已是 to 状态时视为成功，已被改为其他状态时返回 ErrFileStatusChanged
+	SetFileStatus(ctx context.Context, fileId uint64, from, to int) error
+	// GetFile 查询文件，结果会被缓存，文件状态变化时失效，不存在时返回 ErrFileNotFound
 	GetFile(ctx context.Context, file *File) (*File, error)
+	// GetFiles 批量查询文件，不存在的文件不会出现在结果中
+	GetFiles(ctx context.Context, ids []uint64) ([]*File, error)
+	// ListUserFiles 查询用户关联的已上传文件，fileIDs 非空时只返回其中的文件
+	ListUserFiles(ctx context.Context, userID uint64, fileIDs []uint64) ([]*File, error)
+	// DetachUserFiles 删除用户的全部文件关联并减少引用计数，返回删除的关联数
+	DetachUserFiles(ctx context.Context, userID uint64) (int64, error)
+	// HasFileUser 查询用户与文件是否存在关联
+	HasFileUser(ctx context.Context, fileID, userID uint64) (bool, error)
+	// ListUserFileIDs 查询 fileIDs 中与用户存在关联的文件 ID
+	ListUserFileIDs(ctx context.Context, userID uint64, fileIDs []uint64) ([]uint64, error)
+	// IsPublicDomain 查询业务域是否公开，公开业务域的文件任何人都可以下载
+	IsPublicDomain(domain string) bool
+	// PresignDownload 生成文件的临时下载地址，filename 非空时指定下载文件名
+	PresignDownload(ctx context.Context, file *File, expires time.Duration, filename string) (string, error)
+	// IsUploadPending 查询文件是否仍在等待上传，即 FILE:<id> 标记是否存在
+	IsUploadPending(ctx context.Context, fileID uint64) (bool, error)
+	// GetPendingUploader 查询等待上传的文件的上传者，不存在待上传标记时返回 ErrUploadNotFound
+	GetPendingUploader(ctx context.Context, fileID uint64) (uint64, error)
+	// DeferUploadExpiry 重新发送上传超时检查消息
+	DeferUploadExpiry(ctx context.Context, fileID uint64) error
+	// InitiateMultipartUpload 与 PreUpload 相同支持秒传，否则创建待上传的文件并在存储中创建分片上传，
+	// 填充 upload 的 FileID、Domain、UploadID 与 UploadBy
+	InitiateMultipartUpload(ctx context.Context, file *File, upload *MultipartUpload) (*File, error)
+	// GetMultipartUpload 查询分片上传会话，不存在或已结束时返回 ErrUploadNotFound
+	GetMultipartUpload(ctx context.Context, fileID uint64) (*MultipartUpload, error)
+	// TouchMultipartUpload 更新分片上传会话的活动时间并延长会话的有效期
+	TouchMultipartUpload(ctx context.Context, fileID uint64, at time.Time) error
+	PresignUploadPart(ctx context.Context, upload *MultipartUpload, partNumber int, expires time.Duration) (string, error)
+	// ListUploadedParts 从存储中查询已上传的分片
+	ListUploadedParts(ctx context.Context, upload *MultipartUpload) ([]*UploadedPart, error)
+	// CompleteMultipartUpload 在存储中合并分片，成功后将会话标记为已合并
+	CompleteMultipartUpload(ctx context.Context, upload *MultipartUpload, parts []*UploadedPart) error
+	// AbortMultipartUpload 中止存储中的分片上传并删除会话与待上传标记
+	AbortMultipartUpload(ctx context.Context, upload *MultipartUpload) error
 }
@@ -2,56 +2,377 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
 )
 
 type FileService interface {
+	// GetPreUploadURL 生成上传地址，同一业务域存在相同文件时秒传，返回的 File.Exists 为 true，
+	// 并带有需要在 CompleteUpload 时证明的 File.Challenge
 	GetPreUploadURL(ctx context.Context, file *File) (*File, error)
 	CompleteUpload(ctx context.Context, file *File) error
+	// VerifyUpload 异步校验 CompleteUpload 返回 ErrUploadVerifying 的上传
+	VerifyUpload(ctx context.Context, file *File) error
 	UploadFailed(ctx context.Context, file *File) error
-	GetFile(ctx context.Context, file *File) (*File, error)
+	// GetFile 查询文件，哈希只返回给关联文件的用户，访问地址只返回给关联文件的用户或公开业务域的文件
+	GetFile(ctx context.Context, userID uint64, file *File) (*File, error)
+	// GetFiles 批量查询文件，按 ids 的顺序返回，不存在的文件对应位置为 nil，返回的信息与 GetFile 相同
+	GetFiles(ctx context.Context, userID uint64, ids []uint64) ([]*File, error)
+	// GetDownloadURL 校验用户可以访问文件后生成临时下载地址，expires 为 0 时使用默认有效期，
+	// filename 为空时使用上传时的文件名，结果的 DownloadURL 与 ExpiresAt 为下载地址及其过期时间
+	GetDownloadURL(ctx context.Context, userID, fileID uint64, expires time.Duration, filename string) (*File, error)
+	// ListUserFiles 查询用户关联的已上传文件并生成临时下载地址，expires 为 0 时使用默认有效期
+	ListUserFiles(ctx context.Context, userID uint64, fileIDs []uint64, expires time.Duration) ([]*File, error)
+	DetachUserFiles(ctx context.Context, userID uint64) (int64, error)
+	// DeleteFile 删除用户与文件的关联，最后一个关联删除后文件由 CollectGarbage 回收，
+	// 用户没有关联该文件时返回 ErrFileNotFound，关联被引用时返回 ErrFilePinned
+	DeleteFile(ctx context.Context, userID, fileID uint64) error
+	// PinFile 设置用户与文件的关联是否被引用，用户没有关联该文件时返回 ErrFileNotFound
+	PinFile(ctx context.Context, userID, fileID uint64, pinned bool) error
+	// CollectGarbage 回收没有引用的文件以及长期未完成或失败的上传，返回回收的文件数
+	CollectGarbage(ctx context.Context) (int, error)
+	// InitiateMultipartUpload 为 file.UploadBy 创建分片上传，partSize 为 0 时使用默认分片大小，
+	// 分片数超过上限时自动增大分片大小，文件已存在时与 GetPreUploadURL 相同秒传，会话为 nil。
+	// 后续的分片操作只接受创建上传的用户，其他用户返回 ErrUploadNotFound
+	InitiateMultipartUpload(ctx context.Context, file *File, partSize int64) (*File, *MultipartUpload, error)
+	// GetUploadPartURLs 生成分片的上传地址，返回地址的过期时间
+	GetUploadPartURLs(ctx context.Context, uploadBy, fileID uint64, partNumbers []int) ([]*PartURL, time.Time, error)
+	// ListUploadedParts 查询已上传的分片，用于断点续传
+	ListUploadedParts(ctx context.Context, uploadBy, fileID uint64) (*MultipartUpload, []*UploadedPart, error)
+	// CompleteMultipartUpload 全部分片上传后合并分片并完成上传，合并后完成失败时可以重试
+	CompleteMultipartUpload(ctx context.Context, file *File) error
+	// AbortMultipartUpload 中止分片上传，文件标记为上传失败
+	AbortMultipartUpload(ctx context.Context, uploadBy, fileID uint64) error
 }
 
+const (
+	defaultDownloadExpires = time.Hour
+	maxDownloadExpires     = 7 * 24 * time.Hour
+	// maxBatchFileIDs 批量查询文件的最大数量
+	maxBatchFileIDs = 100
+	// 单个文件下载地址只短期有效，避免地址外泄后长期可用
+	defaultFileDownloadExpires = 5 * time.Minute
+	maxFileDownloadExpires     = time.Hour
+	maxFileNameLength          = 255
+)
+
 type fileService struct {
 	srv  *domain.Service
 	repo FileRepository
 }
 
 func (f *fileService) GetPreUploadURL(ctx context.Context, file *File) (*File, error) {
+	id, err := f.srv.Sid.GenUint64()
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.FileService.GetPreUploadURL]gen file id: %w", err)
+	}
+	file.ID = id
 	uploadInfo, err := f.repo.PreUpload(ctx, file)
 	if err != nil {
 		return nil, fmt.Errorf("[Domain.FileService.GetPreUploadURL]pre upload: %w", err)
 	}
+	if uploadInfo.Exists {
+		if err := f.issueChallenge(ctx, uploadInfo); err != nil {
+			return nil, fmt.Errorf("[Domain.FileService.GetPreUploadURL]%w", err)
+		}
+	}
 	return uploadInfo, nil
 }
 
+// CompleteUpload 完成单次 PUT 上传，只接受 GetPreUploadURL 时记录的上传者，其他用户返回 ErrUploadNotFound，
+// 分片上传的文件返回 ErrMultipartUpload。秒传的文件已上传完成，任何用户提交持有证明后都可以关联
 func (f *fileService) CompleteUpload(ctx context.Context, file *File) error {
-	if err := f.srv.Tx.Transaction(ctx, func(ctx context.Context) error {
-		if err := f.repo.CompleteUpload(ctx, file); err != nil {
-			return fmt.Errorf("[Domain.FileService.CompleteUpload]complete upload failed: %w", err)
+	current, err := f.repo.GetFile(ctx, &File{ID: file.ID})
+	if err != nil {
+		return fmt.Errorf("[Domain.FileService.CompleteUpload]get file: %w", err)
+	}
+	if current.Status != FileStatusSuccess {
+		_, err := f.repo.GetMultipartUpload(ctx, file.ID)
+		if err == nil {
+			return ErrMultipartUpload
+		}
+		if !errors.Is(err, ErrUploadNotFound) {
+			return fmt.Errorf("[Domain.FileService.CompleteUpload]get multipart upload: %w", err)
+		}
+		uploadBy, err := f.repo.GetPendingUploader(ctx, file.ID)
+		if err != nil {
+			if errors.Is(err, ErrUploadNotFound) {
+				return ErrUploadNotFound
+			}
+			return fmt.Errorf("[Domain.FileService.CompleteUpload]get uploader: %w", err)
+		}
+		if uploadBy != file.UploadBy {
+			return ErrUploadNotFound
+		}
+	}
+	return f.completeUpload(ctx, file, current)
+}
+
+// completeUpload 校验上传内容与声明的大小及哈希一致后完成上传，不一致时文件标记为上传失败，
+// 秒传依赖哈希可信。需要读取整个对象才能校验时交给任务异步校验并返回 ErrUploadVerifying。
+// 秒传的文件已上传完成，上传者提交持有证明后才关联，已关联时直接返回
+func (f *fileService) completeUpload(ctx context.Context, file, current *File) error {
+	uploaded := current.Status == FileStatusSuccess
+	if uploaded {
+		linked, err := f.repo.HasFileUser(ctx, file.ID, file.UploadBy)
+		if err != nil {
+			return fmt.Errorf("[Domain.FileService.CompleteUpload]check file user: %w", err)
+		}
+		if linked {
+			return nil
+		}
+		if err := f.verifyPossession(ctx, current, file); err != nil {
+			return fmt.Errorf("[Domain.FileService.CompleteUpload]verify possession: %w", err)
+		}
+	} else {
+		reason, err := f.repo.VerifyUpload(ctx, file.ID, false)
+		if errors.Is(err, ErrUploadVerifying) {
+			if err := f.repo.ScheduleVerification(ctx, file.ID, file.UploadBy); err != nil {
+				return fmt.Errorf("[Domain.FileService.CompleteUpload]schedule verification: %w", err)
+			}
+			return ErrUploadVerifying
+		}
+		if err != nil {
+			return fmt.Errorf("[Domain.FileService.CompleteUpload]verify upload: %w", err)
+		}
+		if reason != "" {
+			if err := f.repo.FailUpload(ctx, file.ID, reason); err != nil {
+				return fmt.Errorf("[Domain.FileService.CompleteUpload]fail upload: %w", err)
+			}
+			return &IntegrityError{Reason: reason}
+		}
+	}
+	if err := f.finishUpload(ctx, file, uploaded); err != nil {
+		return fmt.Errorf("[Domain.FileService.CompleteUpload]%w", err)
+	}
+	return nil
+}
+
+// finishUpload 在事务中将校验通过的文件标记为上传完成并关联上传者，uploaded 为 true 时只关联上传者
+func (f *fileService) finishUpload(ctx context.Context, file *File, uploaded bool) error {
+	return f.srv.Tx.Transaction(ctx, func(ctx context.Context) error {
+		if !uploaded {
+			if err := f.repo.CompleteUpload(ctx, file); err != nil {
+				return fmt.Errorf("complete upload failed: %w", err)
+			}
 		}
 		if err := f.repo.CreateFileByUploadIDMapping(ctx, file); err != nil {
-			return fmt.Errorf("[Domain.FileService.CompleteUpload]create mapping failed: %w", err)
+			return fmt.Errorf("create mapping failed: %w", err)
+		}
+		return nil
+	})
+}
+
+// VerifyUpload 读取整个对象校验 CompleteUpload 交给任务的上传，通过后完成上传并关联上传者，
+// 不一致时文件标记为上传失败，上传已完成或已失败时不做处理
+func (f *fileService) VerifyUpload(ctx context.Context, file *File) error {
+	uploadBy, err := f.repo.GetScheduledVerification(ctx, file.ID)
+	if errors.Is(err, ErrUploadNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[Domain.FileService.VerifyUpload]get verification: %w", err)
+	}
+	reason, err := f.repo.VerifyUpload(ctx, file.ID, true)
+	if err != nil {
+		return fmt.Errorf("[Domain.FileService.VerifyUpload]verify upload: %w", err)
+	}
+	if reason != "" {
+		if err := f.repo.FailUpload(ctx, file.ID, reason); err != nil {
+			return fmt.Errorf("[Domain.FileService.VerifyUpload]fail upload: %w", err)
 		}
 		return nil
-	}); err != nil {
-		return err
+	}
+	if err := f.finishUpload(ctx, &File{ID: file.ID, UploadBy: uploadBy}, false); err != nil {
+		return fmt.Errorf("[Domain.FileService.VerifyUpload]%w", err)
 	}
 	return nil
 }
 
+// UploadFailed 处理上传超时检查，已完成的上传不做处理，仍在进行的分片上传与等待异步校验的上传推迟检查，
+// 中断超过 multipartIdleTimeout 的分片上传会被中止
 func (f *fileService) UploadFailed(ctx context.Context, file *File) error {
-	if err := f.repo.SetFileStatus(ctx, file.ID, FileStatusFailed); err != nil {
+	pending, err := f.repo.IsUploadPending(ctx, file.ID)
+	if err != nil {
+		return fmt.Errorf("[Domain.FileService.UploadFailed]check pending: %w", err)
+	}
+	if !pending {
+		return nil
+	}
+	_, err = f.repo.GetScheduledVerification(ctx, file.ID)
+	switch {
+	case err == nil:
+		if err := f.repo.DeferUploadExpiry(ctx, file.ID); err != nil {
+			return fmt.Errorf("[Domain.FileService.UploadFailed]defer expiry: %w", err)
+		}
+		return nil
+	case !errors.Is(err, ErrUploadNotFound):
+		return fmt.Errorf("[Domain.FileService.UploadFailed]get verification: %w", err)
+	}
+	upload, err := f.repo.GetMultipartUpload(ctx, file.ID)
+	switch {
+	case err == nil:
+		if time.Since(upload.UpdatedAt) < multipartIdleTimeout {
+			if err := f.repo.DeferUploadExpiry(ctx, file.ID); err != nil {
+				return fmt.Errorf("[Domain.FileService.UploadFailed]defer expiry: %w", err)
+			}
+			return nil
+		}
+		if err := f.repo.AbortMultipartUpload(ctx, upload); err != nil {
+			return fmt.Errorf("[Domain.FileService.UploadFailed]abort multipart upload: %w", err)
+		}
+	case !errors.Is(err, ErrUploadNotFound):
+		return fmt.Errorf("[Domain.FileService.UploadFailed]get multipart upload: %w", err)
+	}
+	// 上传已在超时检查期间完成时不再标记为失败
+	if err := f.repo.SetFileStatus(ctx, file.ID, FileStatusPending, FileStatusFailed); err != nil && !errors.Is(err, ErrFileStatusChanged) {
 		return fmt.Errorf("[Domain.FileService.UploadFailed]upload failed: %w", err)
 	}
 	return nil
 }
 
-func (f *fileService) GetFile(ctx context.Context, file *File) (*File, error) {
-	// TODO implement me
-	panic("implement me")
+func (f *fileService) GetFile(ctx context.Context, userID uint64, file *File) (*File, error) {
+	res, err := f.repo.GetFile(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.FileService.GetFile]get file %d: %w", file.ID, err)
+	}
+	linked := false
+	if userID != 0 {
+		if linked, err = f.repo.HasFileUser(ctx, file.ID, userID); err != nil {
+			return nil, fmt.Errorf("[Domain.FileService.GetFile]check file user: %w", err)
+		}
+	}
+	f.redact(res, linked)
+	return res, nil
+}
+
+func (f *fileService) GetFiles(ctx context.Context, userID uint64, ids []uint64) ([]*File, error) {
+	if len(ids) > maxBatchFileIDs {
+		return nil, ErrTooManyFileIDs
+	}
+	files, err := f.repo.GetFiles(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.FileService.GetFiles]get files: %w", err)
+	}
+	linked := make(map[uint64]struct{})
+	if userID != 0 && len(ids) > 0 {
+		userFileIDs, err := f.repo.ListUserFileIDs(ctx, userID, ids)
+		if err != nil {
+			return nil, fmt.Errorf("[Domain.FileService.GetFiles]list user files: %w", err)
+		}
+		for _, id := range userFileIDs {
+			linked[id] = struct{}{}
+		}
+	}
+	byID := make(map[uint64]*File, len(files))
+	for _, file := range files {
+		_, ok := linked[file.ID]
+		f.redact(file, ok)
+		byID[file.ID] = file
+	}
+	res := make([]*File, len(ids))
+	for i, id := range ids {
+		res[i] = byID[id]
+	}
+	return res, nil
+}
+
+func (f *fileService) GetDownloadURL(ctx context.Context, userID, fileID uint64, expires time.Duration, filename string) (*File, error) {
+	if expires <= 0 {
+		expires = defaultFileDownloadExpires
+	}
+	if expires > maxFileDownloadExpires {
+		expires = maxFileDownloadExpires
+	}
+	file, err := f.repo.GetFile(ctx, &File{ID: fileID})
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.FileService.GetDownloadURL]get file %d: %w", fileID, err)
+	}
+	if filename == "" {
+		filename = file.Name
+	}
+	if err := validateFileName(filename); err != nil {
+		return nil, err
+	}
+	if !f.repo.IsPublicDomain(file.Domain) {
+		ok, err := f.repo.HasFileUser(ctx, fileID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("[Domain.FileService.GetDownloadURL]check file user: %w", err)
+		}
+		if !ok {
+			return nil, ErrFileForbidden
+		}
+	}
+	if file.Status != FileStatusSuccess {
+		return nil, ErrFileNotReady
+	}
+	now := time.Now()
+	if file.DownloadURL, err = f.repo.PresignDownload(ctx, file, expires, filename); err != nil {
+		return nil, fmt.Errorf("[Domain.FileService.GetDownloadURL]presign file %d: %w", fileID, err)
+	}
+	file.ExpiresAt = now.Add(expires)
+	return file, nil
+}
+
+// redact 清除调用方无权查看的信息，哈希可以用于秒传，只返回给关联文件的用户，
+// 访问地址只返回给关联文件的用户或公开业务域的文件
+func (f *fileService) redact(file *File, linked bool) {
+	if linked {
+		return
+	}
+	file.Hash = ""
+	if !f.repo.IsPublicDomain(file.Domain) {
+		file.AccessURL = ""
+	}
+}
+
+// validateFileName 校验下载文件名，不允许路径分隔符与控制字符
+func validateFileName(name string) error {
+	if len(name) > maxFileNameLength || !utf8.ValidString(name) {
+		return ErrInvalidName
+	}
+	for _, r := range name {
+		if r == '/' || r == '\\' || unicode.IsControl(r) {
+			return ErrInvalidName
+		}
+	}
+	return nil
+}
+
+func (f *fileService) ListUserFiles(ctx context.Context, userID uint64, fileIDs []uint64, expires time.Duration) ([]*File, error) {
+	if expires <= 0 {
+		expires = defaultDownloadExpires
+	}
+	if expires > maxDownloadExpires {
+		expires = maxDownloadExpires
+	}
+	files, err := f.repo.ListUserFiles(ctx, userID, fileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.FileService.ListUserFiles]list user files: %w", err)
+	}
+	for _, file := range files {
+		if file.DownloadURL, err = f.repo.PresignDownload(ctx, file, expires, ""); err != nil {
+			return nil, fmt.Errorf("[Domain.FileService.ListUserFiles]presign file %d: %w", file.ID, err)
+		}
+	}
+	return files, nil
+}
+
+func (f *fileService) DetachUserFiles(ctx context.Context, userID uint64) (int64, error) {
+	var n int64
+	if err := f.srv.Tx.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		n, err = f.repo.DetachUserFiles(ctx, userID)
+		return err
+	}); err != nil {
+		return 0, fmt.Errorf("[Domain.FileService.DetachUserFiles]detach user files: %w", err)
+	}
+	return n, nil
 }
 
 func NewFileService(srv *domain.Service, repo FileRepository) FileService {
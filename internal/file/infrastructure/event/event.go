@@ -3,6 +3,8 @@ package event
 const (
 	Success = iota + 1
 	Failed
+	// Verify 上传内容需要读取整个对象校验，由任务异步校验
+	Verify
 )
 
 type UploadEvent struct {
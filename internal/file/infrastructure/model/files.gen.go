@@ -22,6 +22,7 @@ type File struct {
 	FileType  string         `gorm:"column:file_type;type:varchar(32);not null;comment:文件类型" json:"file_type"`                 // 文件类型
 	FileHash  string         `gorm:"column:file_hash;type:varchar(64);not null;comment:文件哈希值，Sharding Key" json:"file_hash"`   // 文件哈希值，Sharding Key
 	Status    byte           `gorm:"column:status;type:tinyint;not null;comment:状态 0-待上传 1-上传中 2-上传完成 3-上传失败" json:"status"`   // 状态 0-待上传 1-上传中 2-上传完成 3-上传失败
+	RefCount  uint32         `gorm:"column:ref_count;type:int unsigned;not null;comment:引用计数，即关联的用户数" json:"ref_count"`        // 引用计数，即关联的用户数
 	ExtJSON   *[]byte        `gorm:"column:ext_json;type:json;comment:扩展信息，JSON格式" json:"ext_json"`                            // 扩展信息，JSON格式
 	CreatedAt *time.Time     `gorm:"column:created_at;type:datetime;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
 	UpdatedAt *time.Time     `gorm:"column:updated_at;type:datetime;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
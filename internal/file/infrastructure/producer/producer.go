@@ -66,3 +66,23 @@ func (p *Producer) SendExpiryMessage(ctx context.Context, fileID uint64) error {
 
 	return nil
 }
+
+// SendVerifyMessage 发送异步校验上传内容的消息，立即投递
+func (p *Producer) SendVerifyMessage(ctx context.Context, fileID uint64) error {
+	bytes, err := sonic.Marshal(&event.UploadEvent{
+		Type:   event.Verify,
+		FileID: fileID,
+	})
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Producer.SendVerifyMessage]marshal verify event: %w", err)
+	}
+	msg := &primitive.Message{
+		Topic: p.topic,
+		Body:  bytes,
+	}
+	msg.WithTag("VERIFY_UPLOAD")
+	if _, err := p.client.SendSync(ctx, msg); err != nil {
+		return fmt.Errorf("[Infrastructure.Producer.SendVerifyMessage]failed to send message to %s: %w", p.topic, err)
+	}
+	return nil
+}
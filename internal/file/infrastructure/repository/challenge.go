@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/file/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/third/oss"
+)
+
+// challengeKey 秒传的持有证明挑战，值为 <offset>:<length>
+func challengeKey(fileID uint64, id string) string {
+	return fmt.Sprintf("FILE:%d:CHALLENGE:%s", fileID, id)
+}
+
+func (f *FileRepository) SaveUploadChallenge(ctx context.Context, fileID uint64, challenge *domain.UploadChallenge, expires time.Duration) error {
+	value := strconv.FormatInt(challenge.Offset, 10) + ":" + strconv.FormatInt(challenge.Length, 10)
+	if err := f.rdb.Set(ctx, challengeKey(fileID, challenge.ID), value, expires).Err(); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.SaveUploadChallenge]save challenge failed: %w", err)
+	}
+	return nil
+}
+
+func (f *FileRepository) TakeUploadChallenge(ctx context.Context, fileID uint64, id string) (*domain.UploadChallenge, error) {
+	value, err := f.rdb.GetDel(ctx, challengeKey(fileID, id)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, domain.ErrChallengeNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.TakeUploadChallenge]get challenge failed: %w", err)
+	}
+	offset, length, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil, domain.ErrChallengeNotFound
+	}
+	c := &domain.UploadChallenge{ID: id}
+	if c.Offset, err = strconv.ParseInt(offset, 10, 64); err != nil {
+		return nil, domain.ErrChallengeNotFound
+	}
+	if c.Length, err = strconv.ParseInt(length, 10, 64); err != nil {
+		return nil, domain.ErrChallengeNotFound
+	}
+	return c, nil
+}
+
+func (f *FileRepository) DigestObjectRange(ctx context.Context, file *domain.File, offset, length int64) (string, error) {
+	h := sha256.New()
+	if length > 0 {
+		reader, err := f.oss.GetObjectRange(ctx, &oss.Object{
+			Bucket: file.Domain,
+			Key:    strconv.FormatUint(file.ID, 10),
+		}, offset, length)
+		if err != nil {
+			return "", fmt.Errorf("[Infrastructure.FileRepository.DigestObjectRange]get object %d failed: %w", file.ID, err)
+		}
+		defer reader.Close()
+		n, err := io.Copy(h, reader)
+		if err != nil {
+			return "", fmt.Errorf("[Infrastructure.FileRepository.DigestObjectRange]read object %d failed: %w", file.ID, err)
+		}
+		if n != length {
+			return "", fmt.Errorf("[Infrastructure.FileRepository.DigestObjectRange]object %d is shorter than the challenge range", file.ID)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
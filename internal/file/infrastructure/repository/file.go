@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/bytedance/sonic"
 	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 
 	"github.com/Wenrh2004/lark-lite-server/internal/file/domain"
@@ -15,13 +18,46 @@ import (
 	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/producer"
 	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/repository/query"
 	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/third/oss"
+	"github.com/Wenrh2004/lark-lite-server/pkg/cache"
+	"github.com/Wenrh2004/lark-lite-server/pkg/cache/client"
+	"github.com/Wenrh2004/lark-lite-server/pkg/log"
 )
 
+const (
+	fileCacheExpire = 10 * time.Minute
+	// fileNotFoundCacheExpire 不存在的文件只短暂缓存，用于吸收按 ID 探测的流量
+	fileNotFoundCacheExpire = 30 * time.Second
+)
+
+// fileCacheEntry 文件缓存条目，File 为空表示该 ID 的文件不存在
+type fileCacheEntry struct {
+	File *model.File `json:"file,omitempty"`
+}
+
 type FileRepository struct {
-	db  *query.Query
-	rdb *redis.Client
-	p   *producer.Producer
-	oss oss.Service
+	db     *query.Query
+	rdb    *redis.Client
+	p      *producer.Producer
+	oss    oss.Service
+	cache  cache.MultiCache[*fileCacheEntry]
+	logger *log.Logger
+	// publicDomains 文件无需校验访问权限的业务域，来自 app.file.public_domains
+	publicDomains map[string]struct{}
+}
+
+func fileCacheKey(id uint64) string {
+	return fmt.Sprintf("file:%d", id)
+}
+
+// invalidate 删除文件缓存，文件行的每次写入都需要调用，失败只记录日志，由缓存过期兜底。
+// 在事务中调用时推迟到事务提交后删除，避免并发读取在提交前把旧数据重新写入缓存
+func (f *FileRepository) invalidate(ctx context.Context, id uint64) {
+	afterCommit(ctx, func() {
+		if err := f.cache.Del(ctx, fileCacheKey(id)); err != nil {
+			f.logger.WithContext(ctx).Warn("[Infrastructure.FileRepository]failed to invalidate file cache",
+				zap.Uint64("file_id", id), zap.Error(err))
+		}
+	})
 }
 
 func (f *FileRepository) GetPreUploadURL(ctx context.Context, file *domain.File) (*domain.File, error) {
@@ -49,11 +85,18 @@ func (f *FileRepository) GetPreUploadURL(ctx context.Context, file *domain.File)
 	}); err != nil {
 		return nil, fmt.Errorf("[Infrastructure.FileRepository.GetPreUploadURL]create file failed: %w", err)
 	}
-	return &domain.File{}, nil
+	f.invalidate(ctx, file.ID)
+	if err := f.PendingUpload(ctx, file.ID, file.UploadBy); err != nil {
+		return nil, err
+	}
+	file.UploadURL = uploadResp.UploadURL
+	file.ExpiresAt = uploadResp.ExpiresAt
+	return file, nil
 }
 
-func (f *FileRepository) PendingUpload(ctx context.Context, fileId uint64) error {
-	if err := f.rdb.Set(ctx, fmt.Sprintf("FILE:%d", fileId), fileId, 0).Err(); err != nil {
+// PendingUpload 写入待上传标记 FILE:<id>，值为上传者 ID，并发送上传超时检查消息
+func (f *FileRepository) PendingUpload(ctx context.Context, fileId, uploadBy uint64) error {
+	if err := f.rdb.Set(ctx, pendingKey(fileId), uploadBy, 0).Err(); err != nil {
 		return fmt.Errorf("[Infrastructure.FileRepository.PendingUpload]set file cache failed: %w", err)
 	}
 	if err := f.p.SendExpiryMessage(ctx, fileId); err != nil {
@@ -62,86 +105,321 @@ func (f *FileRepository) PendingUpload(ctx context.Context, fileId uint64) error
 	return nil
 }
 
+// PreUpload 只在同一业务域内按哈希秒传已上传完成的文件，秒传不返回访问地址，
+// 由上传者完成持有证明后再查询
 func (f *FileRepository) PreUpload(ctx context.Context, file *domain.File) (*domain.File, error) {
-	fileInfo, err := f.db.WithContext(ctx).File.Where(query.File.FileHash.Eq(file.Hash)).First()
-	if err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			return f.GetPreUploadURL(ctx, file)
-		}
-		return nil, fmt.Errorf("[Infrastructure.FileRepository.PreUpload]query file %v failed: %w", file.ID, err)
-	}
-	switch fileInfo.Status {
-	case 0:
-		return &domain.File{
-			ID:        fileInfo.ID,
-			Exists:    false,
-			AccessURL: fileInfo.FilePath,
-		}, nil
-	case 1:
-		return &domain.File{
-			ID:        fileInfo.ID,
-			Exists:    true,
-			AccessURL: fileInfo.FilePath,
-		}, nil
-	case 2:
+	// 未声明哈希的文件无法确认内容相同，不参与秒传
+	if file.Hash == "" {
 		return f.GetPreUploadURL(ctx, file)
-	default:
-		return nil, errors.New("[Infrastructure.FileRepository.PreUpload]file status error")
 	}
+	existing, err := f.findUploaded(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.PreUpload]%w", err)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+	return f.GetPreUploadURL(ctx, file)
 }
 
-func (f *FileRepository) CompleteUpload(ctx context.Context, file *domain.File) error {
-	exists, err := f.oss.CheckFileExists(ctx, file.Domain, file.Name)
+// findUploaded 查询同一业务域内哈希与大小相同且已上传完成的文件，不存在时返回 nil
+func (f *FileRepository) findUploaded(ctx context.Context, file *domain.File) (*domain.File, error) {
+	fq := f.db.File
+	fileInfo, err := fq.WithContext(ctx).
+		Where(fq.Domain.Eq(file.Domain), fq.FileHash.Eq(file.Hash), fq.FileSize.Eq(uint64(file.Size)),
+			fq.Status.Eq(statusValue(domain.FileStatusSuccess))).
+		First()
 	if err != nil {
-		return fmt.Errorf("[Infrastructure.FileRepository.CompleteUpload]check file exists failed: %w", err)
-	}
-	if !exists {
-		return fmt.Errorf("[Infrastructure.FileRepository.CompleteUpload]file %d not exists: %w", file.ID, err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("query file %s failed: %w", file.Hash, err)
 	}
-	if err = f.SetFileStatus(ctx, file.ID, domain.FileStatusSuccess); err != nil {
+	return &domain.File{
+		ID:     fileInfo.ID,
+		Domain: fileInfo.Domain,
+		Size:   int64(fileInfo.FileSize),
+		Exists: true,
+	}, nil
+}
+
+func (f *FileRepository) CompleteUpload(ctx context.Context, file *domain.File) error {
+	if err := f.SetFileStatus(ctx, file.ID, domain.FileStatusPending, domain.FileStatusSuccess); err != nil {
 		return err
 	}
-	if err := f.rdb.Del(ctx, fmt.Sprintf("FILE:%d", file.ID)).Err(); err != nil {
-		return fmt.Errorf("[Infrastructure.FileRepository.CompleteUpload]delete file cache failed: %w", err)
+	if err := f.deleteUploadState(ctx, file.ID); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.CompleteUpload]%w", err)
 	}
 
 	return nil
 }
 
+// CreateFileByUploadIDMapping 关联文件与用户并增加引用计数，已关联时不做处理
 func (f *FileRepository) CreateFileByUploadIDMapping(ctx context.Context, file *domain.File) error {
-	if err := f.db.WithContext(ctx).FileUser.Create(&model.FileUser{
+	q := f.tx(ctx)
+	fu := q.FileUser
+	count, err := fu.WithContext(ctx).Where(fu.FileID.Eq(file.ID), fu.UserID.Eq(file.UploadBy)).Count()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.CompleteUpload]query file user mapping failed: %w", err)
+	}
+	if count > 0 {
+		return nil
+	}
+	if err := fu.WithContext(ctx).Create(&model.FileUser{
 		FileID: file.ID,
 		UserID: file.UploadBy,
 	}); err != nil {
 		return fmt.Errorf("[Infrastructure.FileRepository.CompleteUpload]create file user mapping failed: %w", err)
 	}
-
+	fq := q.File
+	info, err := fq.WithContext(ctx).Where(fq.ID.Eq(file.ID)).
+		UpdateSimple(fq.RefCount.Add(1), fq.UpdatedAt.Value(time.Now()))
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.CompleteUpload]increase file %d references failed: %w", file.ID, err)
+	}
+	f.invalidate(ctx, file.ID)
+	if info.RowsAffected == 0 {
+		// 文件已被回收
+		return domain.ErrFileNotFound
+	}
 	return nil
 }
 
-func (f *FileRepository) SetFileStatus(ctx context.Context, fileId uint64, status int) error {
-	resultInfo, err := f.db.WithContext(ctx).File.Where(query.File.ID.Eq(fileId)).Update(query.File.Status, status)
+// SetFileStatus 只在文件仍为 from 状态时改为 to，文件已是 to 状态时视为成功，
+// 文件不存在时返回 ErrFileNotFound，已被改为其他状态时返回 ErrFileStatusChanged
+func (f *FileRepository) SetFileStatus(ctx context.Context, fileId uint64, from, to int) error {
+	fq := f.tx(ctx).File
+	resultInfo, err := fq.WithContext(ctx).Where(fq.ID.Eq(fileId), fq.Status.Eq(statusValue(from))).Update(fq.Status, to)
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.SetFileStatus]update status %d failed: %w", to, err)
+	}
+	f.invalidate(ctx, fileId)
+	if resultInfo.RowsAffected > 0 {
+		return nil
+	}
+	current, err := fq.WithContext(ctx).Select(fq.Status).Where(fq.ID.Eq(fileId)).First()
 	if err != nil {
-		return fmt.Errorf("[Infrastructure.FileRepository.SetFileStatus]update status %d failed: %w", status, err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ErrFileNotFound
+		}
+		return fmt.Errorf("[Infrastructure.FileRepository.SetFileStatus]query file %d failed: %w", fileId, err)
 	}
-	if resultInfo.RowsAffected == 0 {
-		return fmt.Errorf("[Infrastructure.FileRepository.SetFileStatus]file %d not found: %w", fileId, err)
+	if int(current.Status) != to {
+		return domain.ErrFileStatusChanged
 	}
 	return nil
 }
 
+// GetFile 先读缓存，未命中时回源数据库并写入缓存，同一 ID 的并发回源只会查询一次
 func (f *FileRepository) GetFile(ctx context.Context, file *domain.File) (*domain.File, error) {
-	// TODO implement me
-	panic("implement me")
+	key := fileCacheKey(file.ID)
+	entry, err := f.cache.GetAndSingleSet(ctx, key, fileCacheExpire, func() (*fileCacheEntry, error) {
+		m, err := f.db.WithContext(ctx).File.Where(query.File.ID.Eq(file.ID)).First()
+		if err != nil {
+			return nil, err
+		}
+		return &fileCacheEntry{File: m}, nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			f.cacheMissing(ctx, file.ID)
+			return nil, domain.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.GetFile]query file %d failed: %w", file.ID, err)
+	}
+	if entry == nil || entry.File == nil {
+		return nil, domain.ErrFileNotFound
+	}
+	return toDomainFile(entry.File), nil
+}
+
+// GetFiles 先逐个读缓存，未命中的文件一次查询数据库后回填缓存
+func (f *FileRepository) GetFiles(ctx context.Context, ids []uint64) ([]*domain.File, error) {
+	files := make([]*domain.File, 0, len(ids))
+	missed := make([]uint64, 0, len(ids))
+	seen := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		entry, err := f.cache.Get(ctx, fileCacheKey(id))
+		if err != nil || entry == nil {
+			missed = append(missed, id)
+			continue
+		}
+		if entry.File != nil {
+			files = append(files, toDomainFile(entry.File))
+		}
+	}
+	if len(missed) == 0 {
+		return files, nil
+	}
+	res, err := f.db.WithContext(ctx).File.Where(query.File.ID.In(missed...)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.GetFiles]query files failed: %w", err)
+	}
+	found := make(map[uint64]struct{}, len(res))
+	for _, m := range res {
+		found[m.ID] = struct{}{}
+		if err := f.cache.Set(ctx, fileCacheKey(m.ID), &fileCacheEntry{File: m}, fileCacheExpire); err != nil {
+			f.logger.WithContext(ctx).Warn("[Infrastructure.FileRepository.GetFiles]failed to cache file",
+				zap.Uint64("file_id", m.ID), zap.Error(err))
+		}
+		files = append(files, toDomainFile(m))
+	}
+	for _, id := range missed {
+		if _, ok := found[id]; !ok {
+			f.cacheMissing(ctx, id)
+		}
+	}
+	return files, nil
+}
+
+// cacheMissing 缓存文件不存在的结果
+func (f *FileRepository) cacheMissing(ctx context.Context, id uint64) {
+	if err := f.cache.Set(ctx, fileCacheKey(id), &fileCacheEntry{}, fileNotFoundCacheExpire); err != nil {
+		f.logger.WithContext(ctx).Warn("[Infrastructure.FileRepository]failed to cache missing file",
+			zap.Uint64("file_id", id), zap.Error(err))
+	}
+}
+
+func (f *FileRepository) ListUserFiles(ctx context.Context, userID uint64, fileIDs []uint64) ([]*domain.File, error) {
+	fu := f.db.FileUser
+	do := fu.WithContext(ctx).Distinct(fu.FileID).Where(fu.UserID.Eq(userID))
+	if len(fileIDs) > 0 {
+		do = do.Where(fu.FileID.In(fileIDs...))
+	}
+	var ids []uint64
+	if err := do.Pluck(fu.FileID, &ids); err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.ListUserFiles]query user %d mappings failed: %w", userID, err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	fq := f.db.File
+	res, err := fq.WithContext(ctx).
+		Where(fq.ID.In(ids...)).
+		Order(fq.ID).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.ListUserFiles]query files failed: %w", err)
+	}
+	files := make([]*domain.File, 0, len(res))
+	for _, m := range res {
+		if m.Status != domain.FileStatusSuccess {
+			continue
+		}
+		files = append(files, toDomainFile(m))
+	}
+	return files, nil
+}
+
+func (f *FileRepository) HasFileUser(ctx context.Context, fileID, userID uint64) (bool, error) {
+	fu := f.db.FileUser
+	count, err := fu.WithContext(ctx).Where(fu.FileID.Eq(fileID), fu.UserID.Eq(userID)).Count()
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.FileRepository.HasFileUser]query file %d mapping failed: %w", fileID, err)
+	}
+	return count > 0, nil
+}
+
+func (f *FileRepository) ListUserFileIDs(ctx context.Context, userID uint64, fileIDs []uint64) ([]uint64, error) {
+	fu := f.db.FileUser
+	var ids []uint64
+	if err := fu.WithContext(ctx).Distinct(fu.FileID).
+		Where(fu.UserID.Eq(userID), fu.FileID.In(fileIDs...)).
+		Pluck(fu.FileID, &ids); err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.ListUserFileIDs]query user %d mappings failed: %w", userID, err)
+	}
+	return ids, nil
+}
+
+func (f *FileRepository) IsPublicDomain(domain string) bool {
+	_, ok := f.publicDomains[domain]
+	return ok
+}
+
+// DetachUserFiles 删除用户的全部文件关联并减少对应文件的引用计数，需要在事务中调用
+func (f *FileRepository) DetachUserFiles(ctx context.Context, userID uint64) (int64, error) {
+	q := f.tx(ctx)
+	fu := q.FileUser
+	var ids []uint64
+	if err := fu.WithContext(ctx).Where(fu.UserID.Eq(userID)).Pluck(fu.FileID, &ids); err != nil {
+		return 0, fmt.Errorf("[Infrastructure.FileRepository.DetachUserFiles]query user %d mappings failed: %w", userID, err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	info, err := fu.WithContext(ctx).Where(fu.UserID.Eq(userID)).Delete()
+	if err != nil {
+		return 0, fmt.Errorf("[Infrastructure.FileRepository.DetachUserFiles]delete user %d mappings failed: %w", userID, err)
+	}
+	refs := make(map[uint64]uint32, len(ids))
+	for _, id := range ids {
+		refs[id]++
+	}
+	for id, n := range refs {
+		if err := f.release(ctx, q, id, n); err != nil {
+			return 0, fmt.Errorf("[Infrastructure.FileRepository.DetachUserFiles]%w", err)
+		}
+	}
+	return info.RowsAffected, nil
+}
+
+func (f *FileRepository) PresignDownload(ctx context.Context, file *domain.File, expires time.Duration, filename string) (string, error) {
+	url, err := f.oss.PresignedDownloadURL(ctx, &oss.Object{
+		Bucket: file.Domain,
+		Key:    strconv.FormatUint(file.ID, 10),
+	}, expires, filename)
+	if err != nil {
+		return "", fmt.Errorf("[Infrastructure.FileRepository.PresignDownload]presign file %d failed: %w", file.ID, err)
+	}
+	return url, nil
+}
+
+func toDomainFile(m *model.File) *domain.File {
+	file := &domain.File{
+		ID:        m.ID,
+		Domain:    m.Domain,
+		Name:      m.FileName,
+		Size:      int64(m.FileSize),
+		Hash:      m.FileHash,
+		Type:      m.FileType,
+		AccessURL: m.FilePath,
+		Status:    int(m.Status),
+	}
+	if file.Status == domain.FileStatusFailed {
+		file.FailReason = failReason(m)
+	}
+	if m.CreatedAt != nil {
+		file.CreatedAt = *m.CreatedAt
+	}
+	if m.UpdatedAt != nil {
+		file.UpdatedAt = *m.UpdatedAt
+	}
+	return file
 }
 
 func NewFileRepository(
+	conf *viper.Viper,
+	logger *log.Logger,
 	rdb *redis.Client,
+	p *producer.Producer,
 	oss oss.Service,
+	caches []client.Cache,
 ) domain.FileRepository {
+	publicDomains := make(map[string]struct{})
+	for _, d := range conf.GetStringSlice("app.file.public_domains") {
+		publicDomains[d] = struct{}{}
+	}
 	return &FileRepository{
-		db:  query.Q,
-		rdb: rdb,
-		oss: oss,
+		db:            query.Q,
+		rdb:           rdb,
+		p:             p,
+		oss:           oss,
+		cache:         cache.NewMultiCache[*fileCacheEntry](conf, caches),
+		logger:        logger,
+		publicDomains: publicDomains,
 	}
 }
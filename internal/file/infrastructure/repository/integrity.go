@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/file/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/model"
+	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/repository/query"
+	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/third/oss"
+)
+
+// verifyStreamLimit 不超过该大小的对象读取内容计算哈希，更大的对象优先使用存储返回的 ETag 或校验和，
+// 两者都不可用时只在异步校验中读取内容
+const verifyStreamLimit = 64 << 20
+
+// verifyExpire 异步校验记录的保存时间
+const verifyExpire = 24 * time.Hour
+
+// verifyKey 等待异步校验的上传，值为上传者 ID
+func verifyKey(fileID uint64) string {
+	return fmt.Sprintf("FILE:%d:VERIFY", fileID)
+}
+
+// failReasonKey 上传失败原因在扩展信息中的字段
+const failReasonKey = "fail_reason"
+
+func (f *FileRepository) VerifyUpload(ctx context.Context, fileID uint64, full bool) (string, error) {
+	fileInfo, err := f.db.WithContext(ctx).File.Where(query.File.ID.Eq(fileID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", domain.ErrFileNotFound
+		}
+		return "", fmt.Errorf("[Infrastructure.FileRepository.VerifyUpload]query file %d failed: %w", fileID, err)
+	}
+	object := &oss.Object{
+		Bucket: fileInfo.Domain,
+		Key:    strconv.FormatUint(fileInfo.ID, 10),
+	}
+	info, err := f.oss.StatObject(ctx, object)
+	if err != nil {
+		if errors.Is(err, oss.ErrObjectNotFound) {
+			return "", domain.ErrFileNotReady
+		}
+		return "", fmt.Errorf("[Infrastructure.FileRepository.VerifyUpload]stat object %d failed: %w", fileID, err)
+	}
+	if info.Size != int64(fileInfo.FileSize) {
+		return fmt.Sprintf("size mismatch: uploaded %d bytes, declared %d", info.Size, fileInfo.FileSize), nil
+	}
+	expected := strings.ToLower(fileInfo.FileHash)
+	var newHash func() hash.Hash
+	switch len(expected) {
+	case 0:
+		// 未声明哈希的文件不参与秒传，只校验大小
+		return "", nil
+	case md5.Size * 2:
+		newHash = md5.New
+	case sha256.Size * 2:
+		newHash = sha256.New
+	default:
+		return fmt.Sprintf("unsupported hash %q, expected MD5 or SHA-256 hex", fileInfo.FileHash), nil
+	}
+	if info.Size > verifyStreamLimit {
+		if actual, ok := storedDigest(info, len(expected)); ok {
+			if actual != expected {
+				return fmt.Sprintf("hash mismatch: uploaded %s, declared %s", actual, expected), nil
+			}
+			return "", nil
+		}
+		// 分片上传的对象没有整体的 ETag 与校验和，只能读取内容计算，不在请求中读取整个对象
+		if !full {
+			return "", domain.ErrUploadVerifying
+		}
+	}
+	actual, err := f.digestObject(ctx, object, newHash())
+	if err != nil {
+		return "", fmt.Errorf("[Infrastructure.FileRepository.VerifyUpload]digest object %d failed: %w", fileID, err)
+	}
+	if actual != expected {
+		return fmt.Sprintf("hash mismatch: uploaded %s, declared %s", actual, expected), nil
+	}
+	return "", nil
+}
+
+func (f *FileRepository) ScheduleVerification(ctx context.Context, fileID, uploadBy uint64) error {
+	ok, err := f.rdb.SetNX(ctx, verifyKey(fileID), uploadBy, verifyExpire).Result()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.ScheduleVerification]save verification failed: %w", err)
+	}
+	if !ok {
+		// 已在等待校验，重复完成上传时不重复发送
+		return nil
+	}
+	if err := f.p.SendVerifyMessage(ctx, fileID); err != nil {
+		_ = f.rdb.Del(ctx, verifyKey(fileID)).Err()
+		return fmt.Errorf("[Infrastructure.FileRepository.ScheduleVerification]%w", err)
+	}
+	return nil
+}
+
+func (f *FileRepository) GetScheduledVerification(ctx context.Context, fileID uint64) (uint64, error) {
+	uploadBy, err := f.rdb.Get(ctx, verifyKey(fileID)).Uint64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, domain.ErrUploadNotFound
+		}
+		return 0, fmt.Errorf("[Infrastructure.FileRepository.GetScheduledVerification]get verification failed: %w", err)
+	}
+	return uploadBy, nil
+}
+
+func (f *FileRepository) FailUpload(ctx context.Context, fileID uint64, reason string) error {
+	fileInfo, err := f.db.WithContext(ctx).File.Where(query.File.ID.Eq(fileID)).First()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.FailUpload]query file %d failed: %w", fileID, err)
+	}
+	ext, err := withFailReason(fileInfo.ExtJSON, reason)
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.FailUpload]marshal extension failed: %w", err)
+	}
+	if _, err := f.db.WithContext(ctx).File.Where(query.File.ID.Eq(fileID)).Updates(map[string]interface{}{
+		"status":   domain.FileStatusFailed,
+		"ext_json": ext,
+	}); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.FailUpload]update file %d failed: %w", fileID, err)
+	}
+	f.invalidate(ctx, fileID)
+	if err := f.deleteUploadState(ctx, fileID); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.FailUpload]%w", err)
+	}
+	return nil
+}
+
+func (f *FileRepository) digestObject(ctx context.Context, object *oss.Object, h hash.Hash) (string, error) {
+	reader, err := f.oss.GetObject(ctx, object)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	if _, err := io.Copy(h, reader); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// storedDigest 从存储返回的信息中取出整个对象的哈希，单次上传的对象 ETag 为 MD5，
+// 携带校验和上传的对象返回 SHA-256，分片上传的对象两者都带有 -<分片数> 后缀，不能使用
+func storedDigest(info *oss.ObjectInfo, hexLen int) (string, bool) {
+	switch hexLen {
+	case md5.Size * 2:
+		if len(info.ETag) == hexLen && !strings.Contains(info.ETag, "-") {
+			return strings.ToLower(info.ETag), true
+		}
+	case sha256.Size * 2:
+		if info.ChecksumSHA256 == "" || strings.Contains(info.ChecksumSHA256, "-") {
+			return "", false
+		}
+		sum, err := base64.StdEncoding.DecodeString(info.ChecksumSHA256)
+		if err == nil && len(sum) == sha256.Size {
+			return hex.EncodeToString(sum), true
+		}
+	}
+	return "", false
+}
+
+// withFailReason 在扩展信息中记录失败原因，扩展信息不是 JSON 对象时原值保存在 ext 字段中
+func withFailReason(ext *[]byte, reason string) ([]byte, error) {
+	values := make(map[string]interface{})
+	if ext != nil && len(*ext) > 0 {
+		if err := sonic.Unmarshal(*ext, &values); err != nil {
+			var raw interface{}
+			if err := sonic.Unmarshal(*ext, &raw); err == nil && raw != nil && raw != "" {
+				values["ext"] = raw
+			}
+		}
+	}
+	if values == nil {
+		// 扩展信息为 null 时 Unmarshal 会将 values 置为 nil
+		values = make(map[string]interface{})
+	}
+	values[failReasonKey] = reason
+	return sonic.Marshal(values)
+}
+
+// failReason 读取扩展信息中记录的上传失败原因
+func failReason(m *model.File) string {
+	if m.ExtJSON == nil || len(*m.ExtJSON) == 0 {
+		return ""
+	}
+	var values map[string]interface{}
+	if err := sonic.Unmarshal(*m.ExtJSON, &values); err != nil {
+		return ""
+	}
+	reason, _ := values[failReasonKey].(string)
+	return reason
+}
@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/file/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/model"
+	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/third/oss"
+)
+
+// multipartExpire 分片上传会话在 Redis 中的保存时间，每次活动时延长，
+// 需要大于上传超时检查中止中断上传的时间
+const multipartExpire = 7 * 24 * time.Hour
+
+func pendingKey(fileID uint64) string {
+	return fmt.Sprintf("FILE:%d", fileID)
+}
+
+// multipartKey 分片上传会话，与待上传标记 FILE:<id> 相邻保存
+func multipartKey(fileID uint64) string {
+	return fmt.Sprintf("FILE:%d:MULTIPART", fileID)
+}
+
+func multipartObject(upload *domain.MultipartUpload) *oss.Object {
+	return &oss.Object{
+		Bucket: upload.Domain,
+		Key:    strconv.FormatUint(upload.FileID, 10),
+	}
+}
+
+func (f *FileRepository) IsUploadPending(ctx context.Context, fileID uint64) (bool, error) {
+	n, err := f.rdb.Exists(ctx, pendingKey(fileID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.FileRepository.IsUploadPending]check file cache failed: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (f *FileRepository) GetPendingUploader(ctx context.Context, fileID uint64) (uint64, error) {
+	value, err := f.rdb.Get(ctx, pendingKey(fileID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, domain.ErrUploadNotFound
+		}
+		return 0, fmt.Errorf("[Infrastructure.FileRepository.GetPendingUploader]get file cache failed: %w", err)
+	}
+	uploadBy, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("[Infrastructure.FileRepository.GetPendingUploader]invalid uploader %q: %w", value, err)
+	}
+	return uploadBy, nil
+}
+
+func (f *FileRepository) DeferUploadExpiry(ctx context.Context, fileID uint64) error {
+	if err := f.p.SendExpiryMessage(ctx, fileID); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.DeferUploadExpiry]send expiry message failed: %w", err)
+	}
+	return nil
+}
+
+func (f *FileRepository) InitiateMultipartUpload(ctx context.Context, file *domain.File, upload *domain.MultipartUpload) (*domain.File, error) {
+	// 与 PreUpload 相同在同一业务域内按哈希秒传，只复用已上传完成的文件
+	if file.Hash != "" {
+		existing, err := f.findUploaded(ctx, file)
+		if err != nil {
+			return nil, fmt.Errorf("[Infrastructure.FileRepository.InitiateMultipartUpload]%w", err)
+		}
+		if existing != nil {
+			return existing, nil
+		}
+	}
+	uploadResp, err := f.oss.InitiateMultipartUpload(ctx, &oss.Object{
+		Bucket: file.Domain,
+		Key:    strconv.FormatUint(file.ID, 10),
+	}, file.Type)
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.InitiateMultipartUpload]oss initiate upload failed: %w", err)
+	}
+	if err := f.db.WithContext(ctx).File.Create(&model.File{
+		ID:       file.ID,
+		Domain:   file.Domain,
+		FileName: file.Name,
+		FilePath: uploadResp.AccessURL,
+		FileSize: uint64(file.Size),
+		FileType: file.Type,
+		FileHash: file.Hash,
+	}); err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.InitiateMultipartUpload]create file failed: %w", err)
+	}
+	f.invalidate(ctx, file.ID)
+	upload.FileID = file.ID
+	upload.Domain = file.Domain
+	upload.UploadID = uploadResp.UploadID
+	upload.UploadBy = file.UploadBy
+	key := multipartKey(file.ID)
+	pipe := f.rdb.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		"domain":     upload.Domain,
+		"upload_id":  upload.UploadID,
+		"part_size":  upload.PartSize,
+		"part_count": upload.PartCount,
+		"upload_by":  upload.UploadBy,
+		"updated_at": upload.UpdatedAt.Unix(),
+	})
+	pipe.Expire(ctx, key, multipartExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.InitiateMultipartUpload]save upload failed: %w", err)
+	}
+	if err := f.PendingUpload(ctx, file.ID, file.UploadBy); err != nil {
+		return nil, err
+	}
+	file.AccessURL = uploadResp.AccessURL
+	return file, nil
+}
+
+func (f *FileRepository) GetMultipartUpload(ctx context.Context, fileID uint64) (*domain.MultipartUpload, error) {
+	values, err := f.rdb.HGetAll(ctx, multipartKey(fileID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.GetMultipartUpload]get upload failed: %w", err)
+	}
+	if len(values) == 0 || values["upload_id"] == "" {
+		return nil, domain.ErrUploadNotFound
+	}
+	partSize, _ := strconv.ParseInt(values["part_size"], 10, 64)
+	partCount, _ := strconv.Atoi(values["part_count"])
+	uploadBy, _ := strconv.ParseUint(values["upload_by"], 10, 64)
+	updatedAt, _ := strconv.ParseInt(values["updated_at"], 10, 64)
+	return &domain.MultipartUpload{
+		FileID:    fileID,
+		Domain:    values["domain"],
+		UploadID:  values["upload_id"],
+		PartSize:  partSize,
+		PartCount: partCount,
+		UploadBy:  uploadBy,
+		Completed: values["completed"] == "1",
+		UpdatedAt: time.Unix(updatedAt, 0),
+	}, nil
+}
+
+func (f *FileRepository) TouchMultipartUpload(ctx context.Context, fileID uint64, at time.Time) error {
+	pipe := f.rdb.TxPipeline()
+	pipe.HSet(ctx, multipartKey(fileID), "updated_at", at.Unix())
+	pipe.Expire(ctx, multipartKey(fileID), multipartExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.TouchMultipartUpload]touch upload failed: %w", err)
+	}
+	return nil
+}
+
+func (f *FileRepository) PresignUploadPart(ctx context.Context, upload *domain.MultipartUpload, partNumber int, expires time.Duration) (string, error) {
+	url, err := f.oss.PresignedPartURL(ctx, multipartObject(upload), upload.UploadID, partNumber, expires)
+	if err != nil {
+		return "", fmt.Errorf("[Infrastructure.FileRepository.PresignUploadPart]presign part %d failed: %w", partNumber, err)
+	}
+	return url, nil
+}
+
+func (f *FileRepository) ListUploadedParts(ctx context.Context, upload *domain.MultipartUpload) ([]*domain.UploadedPart, error) {
+	res, err := f.oss.ListUploadedParts(ctx, multipartObject(upload), upload.UploadID)
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.ListUploadedParts]oss list parts failed: %w", err)
+	}
+	parts := make([]*domain.UploadedPart, 0, len(res))
+	for _, p := range res {
+		parts = append(parts, &domain.UploadedPart{Number: p.Number, ETag: p.ETag, Size: p.Size})
+	}
+	return parts, nil
+}
+
+func (f *FileRepository) CompleteMultipartUpload(ctx context.Context, upload *domain.MultipartUpload, parts []*domain.UploadedPart) error {
+	ossParts := make([]oss.Part, 0, len(parts))
+	for _, p := range parts {
+		ossParts = append(ossParts, oss.Part{Number: p.Number, ETag: p.ETag, Size: p.Size})
+	}
+	if err := f.oss.CompleteMultipartUpload(ctx, multipartObject(upload), upload.UploadID, ossParts); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.CompleteMultipartUpload]oss complete upload failed: %w", err)
+	}
+	// 存储中的分片上传已结束，重试时不能再次合并
+	pipe := f.rdb.TxPipeline()
+	pipe.HSet(ctx, multipartKey(upload.FileID), "completed", 1, "updated_at", time.Now().Unix())
+	pipe.Expire(ctx, multipartKey(upload.FileID), multipartExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.CompleteMultipartUpload]mark upload completed failed: %w", err)
+	}
+	upload.Completed = true
+	return nil
+}
+
+func (f *FileRepository) AbortMultipartUpload(ctx context.Context, upload *domain.MultipartUpload) error {
+	if err := f.oss.AbortMultipartUpload(ctx, multipartObject(upload), upload.UploadID); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.AbortMultipartUpload]oss abort upload failed: %w", err)
+	}
+	if err := f.deleteUploadState(ctx, upload.FileID); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.AbortMultipartUpload]%w", err)
+	}
+	return nil
+}
+
+// deleteUploadState 删除待上传标记、分片上传会话与异步校验记录
+func (f *FileRepository) deleteUploadState(ctx context.Context, fileID uint64) error {
+	if err := f.rdb.Del(ctx, pendingKey(fileID), multipartKey(fileID), verifyKey(fileID)).Err(); err != nil {
+		return fmt.Errorf("delete file cache failed: %w", err)
+	}
+	return nil
+}
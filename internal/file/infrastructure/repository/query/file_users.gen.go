@@ -31,6 +31,7 @@ func newFileUser(db *gorm.DB, opts ...gen.DOOption) fileUser {
 	_fileUser.ID = field.NewUint(tableName, "id")
 	_fileUser.FileID = field.NewUint64(tableName, "file_id")
 	_fileUser.UserID = field.NewUint64(tableName, "user_id")
+	_fileUser.Pinned = field.NewBool(tableName, "pinned")
 	_fileUser.CreatedAt = field.NewTime(tableName, "created_at")
 	_fileUser.UpdatedAt = field.NewTime(tableName, "updated_at")
 
@@ -47,6 +48,7 @@ type fileUser struct {
 	ID        field.Uint   // 主键，自增ID
 	FileID    field.Uint64 // 文件ID，逻辑关联
 	UserID    field.Uint64 // 用户ID，逻辑关联，Sharding Key
+	Pinned    field.Bool   // 是否被引用，引用期间不能删除关联
 	CreatedAt field.Time   // 创建时间
 	UpdatedAt field.Time   // 更新时间
 
@@ -68,6 +70,7 @@ func (f *fileUser) updateTableName(table string) *fileUser {
 	f.ID = field.NewUint(table, "id")
 	f.FileID = field.NewUint64(table, "file_id")
 	f.UserID = field.NewUint64(table, "user_id")
+	f.Pinned = field.NewBool(table, "pinned")
 	f.CreatedAt = field.NewTime(table, "created_at")
 	f.UpdatedAt = field.NewTime(table, "updated_at")
 
@@ -86,10 +89,11 @@ func (f *fileUser) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (f *fileUser) fillFieldMap() {
-	f.fieldMap = make(map[string]field.Expr, 5)
+	f.fieldMap = make(map[string]field.Expr, 6)
 	f.fieldMap["id"] = f.ID
 	f.fieldMap["file_id"] = f.FileID
 	f.fieldMap["user_id"] = f.UserID
+	f.fieldMap["pinned"] = f.Pinned
 	f.fieldMap["created_at"] = f.CreatedAt
 	f.fieldMap["updated_at"] = f.UpdatedAt
 }
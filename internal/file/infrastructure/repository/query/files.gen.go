@@ -36,6 +36,7 @@ func newFile(db *gorm.DB, opts ...gen.DOOption) file {
 	_file.FileType = field.NewString(tableName, "file_type")
 	_file.FileHash = field.NewString(tableName, "file_hash")
 	_file.Status = field.NewField(tableName, "status")
+	_file.RefCount = field.NewUint32(tableName, "ref_count")
 	_file.ExtJSON = field.NewBytes(tableName, "ext_json")
 	_file.CreatedAt = field.NewTime(tableName, "created_at")
 	_file.UpdatedAt = field.NewTime(tableName, "updated_at")
@@ -59,6 +60,7 @@ type file struct {
 	FileType  field.String // 文件类型
 	FileHash  field.String // 文件哈希值，Sharding Key
 	Status    field.Field  // 状态 0-待上传 1-上传中 2-上传完成 3-上传失败
+	RefCount  field.Uint32 // 引用计数，即关联的用户数
 	ExtJSON   field.Bytes  // 扩展信息，JSON格式
 	CreatedAt field.Time   // 创建时间
 	UpdatedAt field.Time   // 更新时间
@@ -87,6 +89,7 @@ func (f *file) updateTableName(table string) *file {
 	f.FileType = field.NewString(table, "file_type")
 	f.FileHash = field.NewString(table, "file_hash")
 	f.Status = field.NewField(table, "status")
+	f.RefCount = field.NewUint32(table, "ref_count")
 	f.ExtJSON = field.NewBytes(table, "ext_json")
 	f.CreatedAt = field.NewTime(table, "created_at")
 	f.UpdatedAt = field.NewTime(table, "updated_at")
@@ -107,7 +110,7 @@ func (f *file) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (f *file) fillFieldMap() {
-	f.fieldMap = make(map[string]field.Expr, 12)
+	f.fieldMap = make(map[string]field.Expr, 13)
 	f.fieldMap["id"] = f.ID
 	f.fieldMap["domain"] = f.Domain
 	f.fieldMap["file_name"] = f.FileName
@@ -116,6 +119,7 @@ func (f *file) fillFieldMap() {
 	f.fieldMap["file_type"] = f.FileType
 	f.fieldMap["file_hash"] = f.FileHash
 	f.fieldMap["status"] = f.Status
+	f.fieldMap["ref_count"] = f.RefCount
 	f.fieldMap["ext_json"] = f.ExtJSON
 	f.fieldMap["created_at"] = f.CreatedAt
 	f.fieldMap["updated_at"] = f.UpdatedAt
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/file/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/repository/query"
+	"github.com/Wenrh2004/lark-lite-server/internal/file/infrastructure/third/oss"
+)
+
+// statusValue 文件状态列生成为 field.Field，比较时需要 driver.Valuer
+type statusValue byte
+
+func (s statusValue) Value() (driver.Value, error) {
+	return int64(s), nil
+}
+
+// tx 返回 ctx 中的事务，不在事务中时返回默认查询
+func (f *FileRepository) tx(ctx context.Context) *query.Query {
+	if tx, ok := ctx.Value(ctxTxKey).(*query.Query); ok {
+		return tx
+	}
+	return f.db
+}
+
+func (f *FileRepository) DeleteFileUser(ctx context.Context, fileID, userID uint64) (bool, error) {
+	q := f.tx(ctx)
+	fu := q.FileUser
+	info, err := fu.WithContext(ctx).Where(fu.FileID.Eq(fileID), fu.UserID.Eq(userID), fu.Pinned.Is(false)).Delete()
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.FileRepository.DeleteFileUser]delete file %d mapping failed: %w", fileID, err)
+	}
+	if info.RowsAffected == 0 {
+		pinned, err := fu.WithContext(ctx).Where(fu.FileID.Eq(fileID), fu.UserID.Eq(userID), fu.Pinned.Is(true)).Count()
+		if err != nil {
+			return false, fmt.Errorf("[Infrastructure.FileRepository.DeleteFileUser]query file %d mapping failed: %w", fileID, err)
+		}
+		if pinned > 0 {
+			return false, domain.ErrFilePinned
+		}
+		return false, nil
+	}
+	if err := f.release(ctx, q, fileID, uint32(info.RowsAffected)); err != nil {
+		return false, fmt.Errorf("[Infrastructure.FileRepository.DeleteFileUser]%w", err)
+	}
+	return true, nil
+}
+
+func (f *FileRepository) PinFileUser(ctx context.Context, fileID, userID uint64, pinned bool) (bool, error) {
+	fu := f.db.FileUser
+	count, err := fu.WithContext(ctx).Where(fu.FileID.Eq(fileID), fu.UserID.Eq(userID)).Count()
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.FileRepository.PinFileUser]query file %d mapping failed: %w", fileID, err)
+	}
+	if count == 0 {
+		return false, nil
+	}
+	if _, err := fu.WithContext(ctx).Where(fu.FileID.Eq(fileID), fu.UserID.Eq(userID)).
+		UpdateSimple(fu.Pinned.Value(pinned)); err != nil {
+		return false, fmt.Errorf("[Infrastructure.FileRepository.PinFileUser]update file %d mapping failed: %w", fileID, err)
+	}
+	return true, nil
+}
+
+// release 减少文件的引用计数，引用计数不足时置为 0，引用归零的文件由回收任务删除
+func (f *FileRepository) release(ctx context.Context, q *query.Query, fileID uint64, n uint32) error {
+	fq := q.File
+	now := time.Now()
+	info, err := fq.WithContext(ctx).Where(fq.ID.Eq(fileID), fq.RefCount.Gte(n)).
+		UpdateSimple(fq.RefCount.Sub(n), fq.UpdatedAt.Value(now))
+	if err != nil {
+		return fmt.Errorf("decrease file %d references failed: %w", fileID, err)
+	}
+	if info.RowsAffected == 0 {
+		if _, err := fq.WithContext(ctx).Where(fq.ID.Eq(fileID), fq.RefCount.Lt(n)).
+			UpdateSimple(fq.RefCount.Value(0), fq.UpdatedAt.Value(now)); err != nil {
+			return fmt.Errorf("reset file %d references failed: %w", fileID, err)
+		}
+	}
+	f.invalidate(ctx, fileID)
+	return nil
+}
+
+func (f *FileRepository) ListUnreferencedFiles(ctx context.Context, status int, before time.Time, limit int) ([]*domain.File, error) {
+	fq := f.db.File
+	res, err := fq.WithContext(ctx).
+		Where(fq.Status.Eq(statusValue(status)), fq.RefCount.Eq(0), fq.UpdatedAt.Lt(before)).
+		Order(fq.UpdatedAt).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.FileRepository.ListUnreferencedFiles]query files failed: %w", err)
+	}
+	files := make([]*domain.File, 0, len(res))
+	for _, m := range res {
+		files = append(files, toDomainFile(m))
+	}
+	return files, nil
+}
+
+// PurgeFile 在文件仍没有引用且状态未变化时删除文件记录，存在关联但引用计数为 0 时
+// （引用计数上线前创建的文件）按关联数修正引用计数并跳过。对象随后从存储中删除，
+// 文件记录需要物理删除，避免软删除的记录仍指向已删除的对象
+func (f *FileRepository) PurgeFile(ctx context.Context, file *domain.File) (bool, error) {
+	q := f.tx(ctx)
+	fu := q.FileUser
+	count, err := fu.WithContext(ctx).Where(fu.FileID.Eq(file.ID)).Count()
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.FileRepository.PurgeFile]query file %d mappings failed: %w", file.ID, err)
+	}
+	fq := q.File
+	if count > 0 {
+		if _, err := fq.WithContext(ctx).Where(fq.ID.Eq(file.ID), fq.RefCount.Eq(0)).
+			UpdateSimple(fq.RefCount.Value(uint32(count))); err != nil {
+			return false, fmt.Errorf("[Infrastructure.FileRepository.PurgeFile]repair file %d references failed: %w", file.ID, err)
+		}
+		f.invalidate(ctx, file.ID)
+		return false, nil
+	}
+	info, err := fq.WithContext(ctx).Unscoped().
+		Where(fq.ID.Eq(file.ID), fq.Status.Eq(statusValue(file.Status)), fq.RefCount.Eq(0)).
+		Delete()
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.FileRepository.PurgeFile]delete file %d failed: %w", file.ID, err)
+	}
+	f.invalidate(ctx, file.ID)
+	return info.RowsAffected > 0, nil
+}
+
+func (f *FileRepository) RemoveFileObject(ctx context.Context, file *domain.File) error {
+	if err := f.oss.RemoveObject(ctx, &oss.Object{
+		Bucket: file.Domain,
+		Key:    strconv.FormatUint(file.ID, 10),
+	}); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.RemoveFileObject]remove object %d failed: %w", file.ID, err)
+	}
+	if err := f.deleteUploadState(ctx, file.ID); err != nil {
+		return fmt.Errorf("[Infrastructure.FileRepository.RemoveFileObject]%w", err)
+	}
+	return nil
+}
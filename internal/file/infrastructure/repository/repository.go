@@ -14,6 +14,9 @@ import (
 
 const ctxTxKey = "TxKey"
 
+// ctxAfterCommitKey 事务提交后执行的回调列表在 context 中的键
+type ctxAfterCommitKey struct{}
+
 type Repository struct {
 	rdb    *redis.Client
 	oss    oss.Service
@@ -38,9 +41,27 @@ func NewTransaction(r *Repository) transaction.Transaction {
 	return r
 }
 
+// Transaction 在事务中执行 fn，事务提交后按注册顺序执行 afterCommit 注册的回调，回滚时丢弃回调
 func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	return query.Q.Transaction(func(tx *query.Query) error {
-		ctx = context.WithValue(ctx, ctxTxKey, tx)
+	var hooks []func()
+	if err := query.Q.Transaction(func(tx *query.Query) error {
+		ctx := context.WithValue(ctx, ctxTxKey, tx)
+		ctx = context.WithValue(ctx, ctxAfterCommitKey{}, &hooks)
 		return fn(ctx)
-	})
+	}); err != nil {
+		return err
+	}
+	for _, hook := range hooks {
+		hook()
+	}
+	return nil
+}
+
+// afterCommit 在 ctx 中的事务提交后执行 fn，不在事务中时立即执行
+func afterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(ctxAfterCommitKey{}).(*[]func()); ok {
+		*hooks = append(*hooks, fn)
+		return
+	}
+	fn()
 }
@@ -2,6 +2,11 @@ package oss
 
 import (
 	"context"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,7 +19,25 @@ type Service interface {
 	CheckBucketExists(ctx context.Context, bucketName string) (bool, error)
 	CreateBucket(ctx context.Context, bucketName string) error
 	CheckFileExists(ctx context.Context, bucketName, fileName string) (bool, error)
+	// StatObject 查询对象的大小与校验信息，对象不存在时返回 ErrObjectNotFound
+	StatObject(ctx context.Context, file *Object) (*ObjectInfo, error)
+	// GetObject 读取对象内容，调用方负责关闭
+	GetObject(ctx context.Context, file *Object) (io.ReadCloser, error)
+	// GetObjectRange 读取对象从 offset 开始的 length 字节，调用方负责关闭
+	GetObjectRange(ctx context.Context, file *Object, offset, length int64) (io.ReadCloser, error)
+	// RemoveObject 删除对象，对象不存在时不返回错误
+	RemoveObject(ctx context.Context, file *Object) error
 	PreUpload(ctx context.Context, file *Object) (*UploadResponse, error)
+	// PresignedDownloadURL 生成临时下载地址，filename 非空时通过 Content-Disposition 指定下载文件名
+	PresignedDownloadURL(ctx context.Context, file *Object, expires time.Duration, filename string) (string, error)
+	// InitiateMultipartUpload 创建分片上传，桶不存在时先创建桶
+	InitiateMultipartUpload(ctx context.Context, file *Object, contentType string) (*MultipartUploadResponse, error)
+	// PresignedPartURL 生成上传第 partNumber 个分片的 presigned PUT URL
+	PresignedPartURL(ctx context.Context, file *Object, uploadID string, partNumber int, expires time.Duration) (string, error)
+	// ListUploadedParts 按分片序号返回已上传的全部分片
+	ListUploadedParts(ctx context.Context, file *Object, uploadID string) ([]Part, error)
+	CompleteMultipartUpload(ctx context.Context, file *Object, uploadID string, parts []Part) error
+	AbortMultipartUpload(ctx context.Context, file *Object, uploadID string) error
 }
 
 func NewService(conf *viper.Viper) Service {
@@ -76,6 +99,45 @@ func (m *minioService) CheckFileExists(ctx context.Context, bucketName, fileName
 	return objectInfo.ETag != "", nil
 }
 
+func (m *minioService) StatObject(ctx context.Context, file *Object) (*ObjectInfo, error) {
+	info, err := m.minioClient.StatObject(ctx, file.Bucket, file.Key, minio.StatObjectOptions{Checksum: true})
+	if err != nil {
+		switch minio.ToErrorResponse(err).Code {
+		case "NoSuchKey", "NoSuchBucket":
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	return &ObjectInfo{
+		Size:           info.Size,
+		ETag:           strings.Trim(info.ETag, `"`),
+		ChecksumSHA256: info.ChecksumSHA256,
+	}, nil
+}
+
+func (m *minioService) GetObject(ctx context.Context, file *Object) (io.ReadCloser, error) {
+	return m.minioClient.GetObject(ctx, file.Bucket, file.Key, minio.GetObjectOptions{})
+}
+
+func (m *minioService) GetObjectRange(ctx context.Context, file *Object, offset, length int64) (io.ReadCloser, error) {
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(offset, offset+length-1); err != nil {
+		return nil, err
+	}
+	return m.minioClient.GetObject(ctx, file.Bucket, file.Key, opts)
+}
+
+func (m *minioService) RemoveObject(ctx context.Context, file *Object) error {
+	err := m.minioClient.RemoveObject(ctx, file.Bucket, file.Key, minio.RemoveObjectOptions{})
+	if err != nil {
+		switch minio.ToErrorResponse(err).Code {
+		case "NoSuchKey", "NoSuchBucket":
+			return nil
+		}
+	}
+	return err
+}
+
 func (m *minioService) CreateBucket(ctx context.Context, bucketName string) error {
 	err := m.minioClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{})
 	if err != nil {
@@ -97,7 +159,7 @@ func (m *minioService) PreUpload(ctx context.Context, file *Object) (*UploadResp
 			return nil, err
 		}
 	}
-	presignedURL, err := m.minioClient.PresignedPutObject(ctx, file.Bucket, file.Key, time.Duration(m.expires))
+	presignedURL, err := m.minioClient.PresignedPutObject(ctx, file.Bucket, file.Key, time.Duration(m.expires)*time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +170,91 @@ func (m *minioService) PreUpload(ctx context.Context, file *Object) (*UploadResp
 	}, nil
 }
 
+func (m *minioService) PresignedDownloadURL(ctx context.Context, file *Object, expires time.Duration, filename string) (string, error) {
+	var params url.Values
+	if filename != "" {
+		params = url.Values{}
+		// FormatMediaType 对非 ASCII 文件名使用 RFC 2231 编码
+		params.Set("response-content-disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	}
+	presignedURL, err := m.minioClient.PresignedGetObject(ctx, file.Bucket, file.Key, expires, params)
+	if err != nil {
+		return "", err
+	}
+	return presignedURL.String(), nil
+}
+
+func (m *minioService) InitiateMultipartUpload(ctx context.Context, file *Object, contentType string) (*MultipartUploadResponse, error) {
+	exists, err := m.CheckBucketExists(ctx, file.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err := m.CreateBucket(ctx, file.Bucket); err != nil {
+			return nil, err
+		}
+	}
+	uploadID, err := m.core().NewMultipartUpload(ctx, file.Bucket, file.Key, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return nil, err
+	}
+	return &MultipartUploadResponse{
+		UploadID:  uploadID,
+		AccessURL: m.buildAccessURL(file.Bucket, file.Key),
+	}, nil
+}
+
+func (m *minioService) PresignedPartURL(ctx context.Context, file *Object, uploadID string, partNumber int, expires time.Duration) (string, error) {
+	params := url.Values{}
+	params.Set("uploadId", uploadID)
+	params.Set("partNumber", strconv.Itoa(partNumber))
+	presignedURL, err := m.minioClient.Presign(ctx, http.MethodPut, file.Bucket, file.Key, expires, params)
+	if err != nil {
+		return "", err
+	}
+	return presignedURL.String(), nil
+}
+
+func (m *minioService) ListUploadedParts(ctx context.Context, file *Object, uploadID string) ([]Part, error) {
+	var parts []Part
+	marker := 0
+	for {
+		res, err := m.core().ListObjectParts(ctx, file.Bucket, file.Key, uploadID, marker, 1000)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range res.ObjectParts {
+			parts = append(parts, Part{Number: p.PartNumber, ETag: p.ETag, Size: p.Size})
+		}
+		if !res.IsTruncated {
+			return parts, nil
+		}
+		marker = res.NextPartNumberMarker
+	}
+}
+
+func (m *minioService) CompleteMultipartUpload(ctx context.Context, file *Object, uploadID string, parts []Part) error {
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, p := range parts {
+		completeParts = append(completeParts, minio.CompletePart{PartNumber: p.Number, ETag: p.ETag})
+	}
+	_, err := m.core().CompleteMultipartUpload(ctx, file.Bucket, file.Key, uploadID, completeParts, minio.PutObjectOptions{})
+	return err
+}
+
+func (m *minioService) AbortMultipartUpload(ctx context.Context, file *Object, uploadID string) error {
+	err := m.core().AbortMultipartUpload(ctx, file.Bucket, file.Key, uploadID)
+	if err != nil && minio.ToErrorResponse(err).Code == "NoSuchUpload" {
+		return nil
+	}
+	return err
+}
+
+// core 返回用于分片上传底层接口的客户端
+func (m *minioService) core() *minio.Core {
+	return &minio.Core{Client: m.minioClient}
+}
+
 func (m *minioService) buildAccessURL(bucket string, key string) string {
 	return strings.Join([]string{m.minioClient.EndpointURL().String(), bucket, key}, "/")
 }
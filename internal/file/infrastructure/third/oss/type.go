@@ -1,6 +1,11 @@
 package oss
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+var ErrObjectNotFound = errors.New("object not found")
 
 type Object struct {
 	Bucket string
@@ -12,3 +17,24 @@ type UploadResponse struct {
 	AccessURL string
 	ExpiresAt time.Time
 }
+
+type MultipartUploadResponse struct {
+	UploadID  string
+	AccessURL string
+}
+
+// Part 已上传的分片
+type Part struct {
+	Number int
+	ETag   string
+	Size   int64
+}
+
+// ObjectInfo 对象的大小与校验信息
+type ObjectInfo struct {
+	Size int64
+	// ETag 单次上传的对象为内容的 MD5，分片上传的对象带有 -<分片数> 后缀
+	ETag string
+	// ChecksumSHA256 上传时携带 SHA-256 校验和时存储返回的 base64 值，分片上传的对象带有 -<分片数> 后缀
+	ChecksumSHA256 string
+}
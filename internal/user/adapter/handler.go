@@ -2,13 +2,19 @@ package adapter
 
 import (
 	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol"
 	"go.uber.org/zap"
 
 	v1 "github.com/Wenrh2004/lark-lite-server/common/api/v1"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/common"
 	"github.com/Wenrh2004/lark-lite-server/kitex_gen/user"
 	"github.com/Wenrh2004/lark-lite-server/kitex_gen/user/userservice"
 	"github.com/Wenrh2004/lark-lite-server/pkg/adapter"
@@ -19,8 +25,9 @@ type UserHandler struct {
 	cli userservice.Client
 }
 
-func NewUserHandler(cli userservice.Client) *UserHandler {
+func NewUserHandler(srv *adapter.Service, cli userservice.Client) *UserHandler {
 	return &UserHandler{
+		srv: srv,
 		cli: cli,
 	}
 }
@@ -111,34 +118,16 @@ func (h *UserHandler) Login(ctx context.Context, c *app.RequestContext) {
 		})
 		return
 	}
-	if resp.User == nil || resp.User.Token == nil {
-		v1.HandlerError(c, v1.ErrInternalServerError)
-		return
-	}
-	c.SetCookie(
-		"UserRefreshToken",
-		resp.User.Token.RefreshToken,
-		int(resp.User.Token.RefreshExpiresIn),
-		"/api/v1/user/refresh",
-		"",
-		protocol.CookieSameSiteStrictMode,
-		true,
-		true,
-	)
-	v1.HandlerSuccess(c, &v1.UserAuthResponseBody{
-		UserId:    strconv.FormatInt(resp.User.UserId, 10),
-		Username:  resp.User.Username,
-		Nickname:  resp.User.Nickname,
-		AvatarUrl: resp.User.AvatarUrl,
-		Certificate: v1.UserCertificateResponseBody{
-			Certificate: resp.User.Token.AccessToken,
-			ExpiresIn:   resp.User.Token.AccessExpiresIn,
-		},
+	h.signInSuccess(c, resp.User, &v1.UserAuthResponseBody{
+		UserId:    strconv.FormatInt(resp.User.GetUserId(), 10),
+		Username:  resp.User.GetUsername(),
+		Nickname:  resp.User.GetNickname(),
+		AvatarUrl: resp.User.GetAvatarUrl(),
 	})
 }
 
 func (h *UserHandler) Refresh(ctx context.Context, c *app.RequestContext) {
-	refreshToken := c.Request.Header.Cookie("UserrefreshToken")
+	refreshToken := c.Request.Header.Cookie("UserRefreshToken")
 
 	if len(refreshToken) == 0 {
 		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] refresh token is empty")
@@ -188,9 +177,59 @@ func (h *UserHandler) Refresh(ctx context.Context, c *app.RequestContext) {
 	})
 }
 
+// UpdateUserRequest 资料部分更新，只修改请求中出现的字段，传空字符串表示清空
 type UpdateUserRequest struct {
-	Nickname  string `json:"nickname"`
-	AvatarUrl string `json:"avatar_url"`
+	Nickname *string `json:"nickname"`
+	// AvatarFileID 与 BackgroundFileID 为上传图片返回的 file_id，传 "0" 表示清空
+	AvatarFileID     *string `json:"avatar_file_id"`
+	BackgroundFileID *string `json:"background_file_id"`
+	Signature        *string `json:"signature"`
+	Email            *string `json:"email"`
+	Phone            *string `json:"phone"`
+	Gender           *int32  `json:"gender"`
+	// UpdatedAt 读取资料时返回的 updated_at，资料在此之后被修改时返回冲突
+	UpdatedAt int64 `json:"updated_at"`
+}
+
+// toUpdateRequest 按请求中出现的字段生成字段掩码
+func (r *UpdateUserRequest) toUpdateRequest(userID int64) (*user.UpdateRequest, error) {
+	req := &user.UpdateRequest{
+		UserId:    userID,
+		UpdatedAt: r.UpdatedAt,
+	}
+	setString := func(field string, v *string, dst *string) {
+		if v != nil {
+			*dst = *v
+			req.UpdateMask = append(req.UpdateMask, field)
+		}
+	}
+	setString("nickname", r.Nickname, &req.Nickname)
+	setString("signature", r.Signature, &req.Signature)
+	setString("email", r.Email, &req.Email)
+	setString("phone", r.Phone, &req.Phone)
+	if r.Gender != nil {
+		req.Gender = *r.Gender
+		req.UpdateMask = append(req.UpdateMask, "gender")
+	}
+	setFileID := func(field string, v *string, dst *uint64) error {
+		if v == nil {
+			return nil
+		}
+		id, err := strconv.ParseUint(*v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*dst = id
+		req.UpdateMask = append(req.UpdateMask, field)
+		return nil
+	}
+	if err := setFileID("avatar_file_id", r.AvatarFileID, &req.AvatarFileId); err != nil {
+		return nil, err
+	}
+	if err := setFileID("background_file_id", r.BackgroundFileID, &req.BackgroundFileId); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 func (h *UserHandler) GetUserInfo(ctx context.Context, c *app.RequestContext) {
@@ -228,12 +267,7 @@ func (h *UserHandler) GetUserInfo(ctx context.Context, c *app.RequestContext) {
 		v1.HandlerError(c, v1.ErrInternalServerError)
 		return
 	}
-	v1.HandlerSuccess(c, &v1.UserAuthResponseBody{
-		UserId:    strconv.FormatInt(resp.GetUser().GetUserId(), 10),
-		Username:  resp.GetUser().GetUsername(),
-		Nickname:  resp.GetUser().GetNickname(),
-		AvatarUrl: resp.GetUser().GetAvatarUrl(),
-	})
+	v1.HandlerSuccess(c, toUserInfoResponseBody(resp.GetUser()))
 }
 
 func (h *UserHandler) UpdateUser(ctx context.Context, c *app.RequestContext) {
@@ -251,28 +285,13 @@ func (h *UserHandler) UpdateUser(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	resp, err := h.cli.Update(ctx, &user.UpdateRequest{
-		UserId:    userID,
-		Nickname:  req.Nickname,
-		AvatarUrl: req.AvatarUrl,
-	})
+	updateReq, err := req.toUpdateRequest(userID)
 	if err != nil {
-		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] UpdateUser failed", zap.Error(err))
-		v1.HandlerError(c, v1.ErrInternalServerError)
+		v1.HandlerError(c, v1.ErrBadRequest)
 		return
 	}
-	if resp == nil || resp.Code != 0 {
-		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] UpdateUser business error", zap.Any("resp", resp))
-		msg := "internal error"
-		code := 500
-		if resp != nil {
-			msg = resp.Message
-			code = int(resp.Code)
-		}
-		v1.HandlerError(c, v1.Error{
-			Code:    code,
-			Message: msg,
-		})
+	resp, err := h.cli.Update(ctx, updateReq)
+	if !h.handleBaseResponse(ctx, c, "UpdateUser", resp, err) {
 		return
 	}
 	// 更新后再查一次用户信息
@@ -283,15 +302,1173 @@ func (h *UserHandler) UpdateUser(ctx context.Context, c *app.RequestContext) {
 		v1.HandlerError(c, v1.ErrInternalServerError)
 		return
 	}
-	v1.HandlerSuccess(c, &v1.UserAuthResponseBody{
-		UserId:    strconv.FormatInt(userInfoResp.GetUser().GetUserId(), 10),
-		Username:  userInfoResp.GetUser().GetUsername(),
-		Nickname:  userInfoResp.GetUser().GetNickname(),
-		AvatarUrl: userInfoResp.GetUser().GetAvatarUrl(),
+	v1.HandlerSuccess(c, toUserInfoResponseBody(userInfoResp.GetUser()))
+}
+
+func toUserInfoResponseBody(u *user.UserAuthInfo) *v1.UserAuthResponseBody {
+	return &v1.UserAuthResponseBody{
+		UserId:           strconv.FormatInt(u.GetUserId(), 10),
+		Username:         u.GetUsername(),
+		Nickname:         u.GetNickname(),
+		AvatarUrl:        u.GetAvatarUrl(),
+		Email:            u.GetEmail(),
+		EmailVerified:    u.GetEmailVerified(),
+		Phone:            u.GetPhone(),
+		PhoneVerified:    u.GetPhoneVerified(),
+		BackgroundUrl:    u.GetBackgroundUrl(),
+		Signature:        u.GetSignature(),
+		Gender:           u.GetGender(),
+		UpdatedAt:        u.GetUpdatedAt(),
+		AvatarFileID:     formatFileID(u.GetAvatarFileId()),
+		BackgroundFileID: formatFileID(u.GetBackgroundFileId()),
+	}
+}
+
+func formatFileID(id uint64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatUint(id, 10)
+}
+
+func (h *UserHandler) Logout(ctx context.Context, c *app.RequestContext) {
+	resp, err := h.cli.Logout(ctx, &user.LogoutRequest{
+		AccessToken:  string(c.GetHeader("Authorization")),
+		RefreshToken: string(c.Request.Header.Cookie("UserRefreshToken")),
+	})
+	if !h.handleBaseResponse(ctx, c, "Logout", resp, err) {
+		return
+	}
+	clearRefreshCookie(c)
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) LogoutAll(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.LogoutAll(ctx, &user.LogoutAllRequest{UserId: userID})
+	if !h.handleBaseResponse(ctx, c, "LogoutAll", resp, err) {
+		return
+	}
+	clearRefreshCookie(c)
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) SendEmailCode(ctx context.Context, c *app.RequestContext) {
+	var req v1.SendEmailCodeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.SendEmailCode(ctx, &user.SendEmailCodeRequest{
+		UserId: userID,
+		Email:  req.Email,
+	})
+	if !h.handleBaseResponse(ctx, c, "SendEmailCode", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) VerifyEmail(ctx context.Context, c *app.RequestContext) {
+	var req v1.VerifyEmailRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.VerifyEmail(ctx, &user.VerifyEmailRequest{
+		UserId: userID,
+		Email:  req.Email,
+		Code:   req.Code,
+	})
+	if !h.handleBaseResponse(ctx, c, "VerifyEmail", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, c *app.RequestContext) {
+	var req v1.RequestPasswordResetRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RequestPasswordReset(ctx, &user.RequestPasswordResetRequest{
+		Email: req.Email,
+	})
+	if !h.handleBaseResponse(ctx, c, "RequestPasswordReset", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) ResetPassword(ctx context.Context, c *app.RequestContext) {
+	var req v1.ResetPasswordRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ResetPassword(ctx, &user.ResetPasswordRequest{
+		Email:    req.Email,
+		Code:     req.Code,
+		Password: req.Password,
+	})
+	if !h.handleBaseResponse(ctx, c, "ResetPassword", resp, err) {
+		return
+	}
+	clearRefreshCookie(c)
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) SendPhoneCode(ctx context.Context, c *app.RequestContext) {
+	var req v1.SendPhoneCodeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.SendPhoneCode(ctx, &user.SendPhoneCodeRequest{
+		Phone: req.Phone,
 	})
+	if !h.handleBaseResponse(ctx, c, "SendPhoneCode", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) PhoneLogin(ctx context.Context, c *app.RequestContext) {
+	var req v1.PhoneLoginRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.PhoneLogin(ctx, &user.PhoneLoginRequest{
+		Phone: req.Phone,
+		Code:  req.Code,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] phone login failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "PhoneLogin", resp.GetResp(), nil) {
+		return
+	}
+	h.signInSuccess(c, resp.User, &v1.UserAuthResponseBody{
+		UserId:        strconv.FormatInt(resp.User.GetUserId(), 10),
+		Username:      resp.User.GetUsername(),
+		Nickname:      resp.User.GetNickname(),
+		AvatarUrl:     resp.User.GetAvatarUrl(),
+		Phone:         resp.User.GetPhone(),
+		PhoneVerified: resp.User.GetPhoneVerified(),
+	})
+}
+
+// VerifyMFA 使用登录返回的挑战令牌与 TOTP 验证码或恢复码完成登录
+func (h *UserHandler) VerifyMFA(ctx context.Context, c *app.RequestContext) {
+	var req v1.VerifyMFARequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.VerifyMFA(ctx, &user.VerifyMFARequest{
+		MfaToken: req.MFAToken,
+		Code:     req.Code,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] VerifyMFA failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "VerifyMFA", resp.GetResp(), nil) {
+		return
+	}
+	h.signInSuccess(c, resp.User, &v1.UserAuthResponseBody{
+		UserId:    strconv.FormatInt(resp.User.GetUserId(), 10),
+		Username:  resp.User.GetUsername(),
+		Nickname:  resp.User.GetNickname(),
+		AvatarUrl: resp.User.GetAvatarUrl(),
+	})
+}
+
+func (h *UserHandler) EnrollTOTP(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.EnrollTOTP(ctx, &user.EnrollTOTPRequest{UserId: userID})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] EnrollTOTP failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "EnrollTOTP", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, &v1.EnrollTOTPResponseBody{
+		Secret: resp.Secret,
+		URI:    resp.Uri,
+	})
+}
+
+func (h *UserHandler) ConfirmTOTP(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	var req v1.MFACodeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ConfirmTOTP(ctx, &user.ConfirmTOTPRequest{
+		UserId: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] ConfirmTOTP failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "ConfirmTOTP", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, &v1.RecoveryCodesResponseBody{RecoveryCodes: resp.RecoveryCodes})
+}
+
+func (h *UserHandler) DisableTOTP(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	var req v1.MFACodeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.DisableTOTP(ctx, &user.DisableTOTPRequest{
+		UserId: userID,
+		Code:   req.Code,
+	})
+	if !h.handleBaseResponse(ctx, c, "DisableTOTP", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) RegenerateRecoveryCodes(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	var req v1.MFACodeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RegenerateRecoveryCodes(ctx, &user.RegenerateRecoveryCodesRequest{
+		UserId: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] RegenerateRecoveryCodes failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "RegenerateRecoveryCodes", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, &v1.RecoveryCodesResponseBody{RecoveryCodes: resp.RecoveryCodes})
+}
+
+func (h *UserHandler) ListPolicies(ctx context.Context, c *app.RequestContext) {
+	var req v1.ListPoliciesRequest
+	if err := c.BindQuery(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ListPolicies(ctx, &user.ListPoliciesRequest{
+		Filter: &user.Policy{
+			Subject: req.Subject,
+			Domain:  req.Domain,
+			Object:  req.Object,
+			Action:  req.Action,
+		},
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] ListPolicies failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "ListPolicies", resp.GetResp(), nil) {
+		return
+	}
+	policies := make([]*v1.PolicyResponseBody, 0, len(resp.Policies))
+	for _, p := range resp.Policies {
+		policies = append(policies, &v1.PolicyResponseBody{
+			Subject: p.Subject,
+			Domain:  p.Domain,
+			Object:  p.Object,
+			Action:  p.Action,
+		})
+	}
+	v1.HandlerSuccess(c, policies)
+}
+
+func (h *UserHandler) AddPolicy(ctx context.Context, c *app.RequestContext) {
+	var req v1.PolicyRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.AddPolicy(ctx, &user.PolicyRequest{Policy: toPolicyMessage(&req)})
+	if !h.handleBaseResponse(ctx, c, "AddPolicy", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) RemovePolicy(ctx context.Context, c *app.RequestContext) {
+	var req v1.PolicyRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RemovePolicy(ctx, &user.PolicyRequest{Policy: toPolicyMessage(&req)})
+	if !h.handleBaseResponse(ctx, c, "RemovePolicy", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
 }
 
-// UploadFile 文件上传接口
-func (h *UserHandler) UploadFile(ctx context.Context, c *app.RequestContext) {
-	FileUploadHandler(ctx, c)
+func toPolicyMessage(req *v1.PolicyRequest) *user.Policy {
+	return &user.Policy{
+		Subject: req.Subject,
+		Domain:  req.Domain,
+		Object:  req.Object,
+		Action:  req.Action,
+	}
+}
+
+func (h *UserHandler) GetUserRoles(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.GetUserRoles(ctx, &user.GetUserRolesRequest{
+		UserId: userID,
+		Domain: c.Query("domain"),
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] GetUserRoles failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "GetUserRoles", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, &v1.UserRolesResponseBody{Roles: resp.Roles})
+}
+
+func (h *UserHandler) AssignRole(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	var req v1.AssignRoleRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.AssignRole(ctx, &user.RoleRequest{
+		UserId: userID,
+		Role:   req.Role,
+		Domain: req.Domain,
+	})
+	if !h.handleBaseResponse(ctx, c, "AssignRole", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) RevokeRole(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RevokeRole(ctx, &user.RoleRequest{
+		UserId: userID,
+		Role:   c.Param("role"),
+		Domain: c.Query("domain"),
+	})
+	if !h.handleBaseResponse(ctx, c, "RevokeRole", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) CreateAccessToken(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	var req v1.CreateAccessTokenRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.CreateAccessToken(ctx, &user.CreateAccessTokenRequest{
+		UserId:    userID,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresIn: req.ExpiresIn,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] CreateAccessToken failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "CreateAccessToken", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, &v1.CreateAccessTokenResponseBody{
+		AccessTokenResponseBody: toAccessTokenResponseBody(resp.Token),
+		Token:                   resp.Secret,
+	})
+}
+
+func (h *UserHandler) ListAccessTokens(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ListAccessTokens(ctx, &user.ListAccessTokensRequest{UserId: userID})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] ListAccessTokens failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "ListAccessTokens", resp.GetResp(), nil) {
+		return
+	}
+	tokens := make([]v1.AccessTokenResponseBody, 0, len(resp.Tokens))
+	for _, t := range resp.Tokens {
+		tokens = append(tokens, toAccessTokenResponseBody(t))
+	}
+	v1.HandlerSuccess(c, tokens)
+}
+
+func (h *UserHandler) RevokeAccessToken(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	tokenID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RevokeAccessToken(ctx, &user.RevokeAccessTokenRequest{
+		UserId:  userID,
+		TokenId: tokenID,
+	})
+	if !h.handleBaseResponse(ctx, c, "RevokeAccessToken", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func toAccessTokenResponseBody(t *user.AccessToken) v1.AccessTokenResponseBody {
+	return v1.AccessTokenResponseBody{
+		ID:         strconv.FormatInt(t.GetId(), 10),
+		Name:       t.GetName(),
+		Prefix:     t.GetPrefix(),
+		Scopes:     t.GetScopes(),
+		CreatedAt:  t.GetCreatedAt(),
+		ExpiresAt:  t.GetExpiresAt(),
+		LastUsedAt: t.GetLastUsedAt(),
+	}
+}
+
+func (h *UserHandler) RequestDataExport(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RequestDataExport(ctx, &user.RequestDataExportRequest{UserId: userID})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] RequestDataExport failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "RequestDataExport", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, toDataJobResponseBody(resp))
+}
+
+func (h *UserHandler) GetDataExport(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	jobID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.GetDataExport(ctx, &user.GetDataExportRequest{
+		UserId: userID,
+		JobId:  jobID,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] GetDataExport failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "GetDataExport", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, toDataJobResponseBody(resp))
+}
+
+func (h *UserHandler) RequestAccountErasure(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RequestAccountErasure(ctx, &user.RequestAccountErasureRequest{UserId: userID})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] RequestAccountErasure failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "RequestAccountErasure", resp.GetResp(), nil) {
+		return
+	}
+	clearRefreshCookie(c)
+	v1.HandlerSuccess(c, toDataJobResponseBody(resp))
+}
+
+func toDataJobResponseBody(resp *user.DataJobResponse) *v1.DataJobResponseBody {
+	job := resp.GetJob()
+	return &v1.DataJobResponseBody{
+		ID:          strconv.FormatInt(job.GetId(), 10),
+		Type:        job.GetType(),
+		Status:      job.GetStatus(),
+		Step:        job.GetStep(),
+		CreatedAt:   job.GetCreatedAt(),
+		UpdatedAt:   job.GetUpdatedAt(),
+		FinishedAt:  job.GetFinishedAt(),
+		DownloadURL: resp.GetDownloadUrl(),
+	}
+}
+
+func (h *UserHandler) ListUsers(ctx context.Context, c *app.RequestContext) {
+	var req v1.ListUsersRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ListUsers(ctx, &user.ListUsersRequest{
+		UsernamePrefix: req.Username,
+		NicknamePrefix: req.Nickname,
+		Email:          req.Email,
+		Phone:          req.Phone,
+		CreatedFrom:    req.CreatedFrom,
+		CreatedTo:      req.CreatedTo,
+		Status:         req.Status,
+		SortBy:         req.SortBy,
+		Desc:           req.Order == "desc",
+		Page: &common.PageRequest{
+			Offset: int32(req.Offset),
+			Limit:  int32(req.Limit),
+		},
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] ListUsers failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "ListUsers", resp.GetResp(), nil) {
+		return
+	}
+	body := &v1.ListUsersResponseBody{
+		Users: make([]v1.UserSummaryResponseBody, 0, len(resp.Users)),
+		Total: resp.Total,
+	}
+	for _, u := range resp.Users {
+		body.Users = append(body.Users, v1.UserSummaryResponseBody{
+			UserId:          strconv.FormatInt(u.UserId, 10),
+			Username:        u.Username,
+			Nickname:        u.Nickname,
+			AvatarUrl:       u.AvatarUrl,
+			Email:           u.Email,
+			EmailVerified:   u.EmailVerified,
+			Phone:           u.Phone,
+			PhoneVerified:   u.PhoneVerified,
+			Status:          u.Status,
+			CreatedAt:       u.CreatedAt,
+			SuspendedUntil:  u.SuspendedUntil,
+			StatusReason:    u.StatusReason,
+			StatusChangedAt: u.StatusChangedAt,
+		})
+	}
+	v1.HandlerSuccess(c, body)
+}
+
+func (h *UserHandler) ChangeUserStatus(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	var req v1.ChangeUserStatusRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ChangeUserStatus(ctx, &user.ChangeUserStatusRequest{
+		UserId:         userID,
+		Status:         req.Status,
+		SuspendedUntil: req.SuspendedUntil,
+		Reason:         req.Reason,
+	})
+	if !h.handleBaseResponse(ctx, c, "ChangeUserStatus", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+// oauthStateCookie 绑定发起授权的浏览器，回调时与 state 参数比对以防止登录 CSRF
+const oauthStateCookie = "UserOAuthState"
+
+// OAuthLogin 跳转到第三方授权页
+func (h *UserHandler) OAuthLogin(ctx context.Context, c *app.RequestContext) {
+	resp, err := h.cli.OAuthAuthorize(ctx, &user.OAuthAuthorizeRequest{
+		Provider: c.Param("provider"),
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] OAuthAuthorize failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "OAuthAuthorize", resp.GetResp(), nil) {
+		return
+	}
+	setOAuthStateCookie(c, resp.State, 600)
+	c.Redirect(http.StatusFound, []byte(resp.AuthUrl))
+}
+
+// OAuthLink 为当前用户发起第三方身份绑定，返回授权地址
+func (h *UserHandler) OAuthLink(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.OAuthAuthorize(ctx, &user.OAuthAuthorizeRequest{
+		Provider: c.Param("provider"),
+		UserId:   userID,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] OAuthAuthorize failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "OAuthAuthorize", resp.GetResp(), nil) {
+		return
+	}
+	setOAuthStateCookie(c, resp.State, 600)
+	v1.HandlerSuccess(c, &v1.OAuthAuthorizeResponseBody{URL: resp.AuthUrl})
+}
+
+// OAuthCallback 第三方授权回调，登录时签发令牌，绑定时只返回绑定结果
+func (h *UserHandler) OAuthCallback(ctx context.Context, c *app.RequestContext) {
+	if len(c.Query("error")) > 0 {
+		v1.HandlerError(c, v1.ErrUnauthorized)
+		return
+	}
+	state := c.Query("state")
+	cookie := string(c.Request.Header.Cookie(oauthStateCookie))
+	setOAuthStateCookie(c, "", -1)
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(cookie)) != 1 {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.OAuthCallback(ctx, &user.OAuthCallbackRequest{
+		Provider: c.Param("provider"),
+		Code:     c.Query("code"),
+		State:    state,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] OAuthCallback failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "OAuthCallback", resp.GetResp(), nil) {
+		return
+	}
+	if resp.Linked {
+		v1.HandlerSuccess(c, &v1.OAuthLinkResponseBody{Linked: true})
+		return
+	}
+	h.signInSuccess(c, resp.User, &v1.UserAuthResponseBody{
+		UserId:        strconv.FormatInt(resp.User.GetUserId(), 10),
+		Username:      resp.User.GetUsername(),
+		Nickname:      resp.User.GetNickname(),
+		AvatarUrl:     resp.User.GetAvatarUrl(),
+		Email:         resp.User.GetEmail(),
+		EmailVerified: resp.User.GetEmailVerified(),
+	})
+}
+
+func (h *UserHandler) ListIdentities(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ListIdentities(ctx, &user.ListIdentitiesRequest{UserId: userID})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] ListIdentities failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "ListIdentities", resp.GetResp(), nil) {
+		return
+	}
+	identities := make([]*v1.IdentityResponseBody, 0, len(resp.Identities))
+	for _, i := range resp.Identities {
+		identities = append(identities, &v1.IdentityResponseBody{
+			Provider:  i.Provider,
+			Email:     i.Email,
+			CreatedAt: i.CreatedAt,
+		})
+	}
+	v1.HandlerSuccess(c, identities)
+}
+
+func (h *UserHandler) UnlinkIdentity(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.UnlinkIdentity(ctx, &user.UnlinkIdentityRequest{
+		UserId:   userID,
+		Provider: c.Param("provider"),
+	})
+	if !h.handleBaseResponse(ctx, c, "UnlinkIdentity", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func setOAuthStateCookie(c *app.RequestContext, state string, maxAge int) {
+	// 授权回调是第三方站点发起的跳转，需要使用 Lax 才能携带 Cookie
+	c.SetCookie(
+		oauthStateCookie,
+		state,
+		maxAge,
+		"/api/v1/user/oauth",
+		"",
+		protocol.CookieSameSiteLaxMode,
+		true,
+		true,
+	)
+}
+
+// signInSuccess 写入登录结果：用户启用二次验证时只返回挑战令牌，否则设置刷新令牌 Cookie 并返回访问令牌
+func (h *UserHandler) signInSuccess(c *app.RequestContext, info *user.UserAuthInfo, body *v1.UserAuthResponseBody) {
+	if info.GetToken() == nil {
+		if info.GetMfaToken() == "" {
+			v1.HandlerError(c, v1.ErrInternalServerError)
+			return
+		}
+		v1.HandlerSuccess(c, &v1.MFAChallengeResponseBody{
+			MFARequired: true,
+			MFAToken:    info.GetMfaToken(),
+			ExpiresIn:   info.GetMfaExpiresIn(),
+		})
+		return
+	}
+	c.SetCookie(
+		"UserRefreshToken",
+		info.Token.RefreshToken,
+		int(info.Token.RefreshExpiresIn),
+		"/api/v1/user/refresh",
+		"",
+		protocol.CookieSameSiteStrictMode,
+		true,
+		true,
+	)
+	body.Certificate = v1.UserCertificateResponseBody{
+		Certificate: info.Token.AccessToken,
+		ExpiresIn:   info.Token.AccessExpiresIn,
+	}
+	v1.HandlerSuccess(c, body)
+}
+
+// handleBaseResponse 处理 RPC 调用错误与业务错误码，返回 false 时已写入错误响应
+func (h *UserHandler) handleBaseResponse(ctx context.Context, c *app.RequestContext, op string, resp *common.BaseResponse, err error) bool {
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] "+op+" failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return false
+	}
+	if resp == nil || resp.Code != 0 {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] "+op+" business error", zap.Any("resp", resp))
+		msg := "internal error"
+		code := 500
+		if resp != nil {
+			msg = resp.Message
+			code = int(resp.Code)
+		}
+		v1.HandlerError(c, v1.Error{
+			Code:    code,
+			Message: msg,
+		})
+		return false
+	}
+	return true
+}
+
+func clearRefreshCookie(c *app.RequestContext) {
+	c.SetCookie(
+		"UserRefreshToken",
+		"",
+		-1,
+		"/api/v1/user/refresh",
+		"",
+		protocol.CookieSameSiteStrictMode,
+		true,
+		true,
+	)
+}
+
+// maxProfileImageSize 头像与背景图的大小上限，与用户服务的校验保持一致
+const maxProfileImageSize = 5 << 20
+
+// UploadProfileImage 上传头像或背景图，图片经用户服务存入文件服务，返回的 file_id 用于更新资料
+func (h *UserHandler) UploadProfileImage(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	if fileHeader.Size > maxProfileImageSize {
+		v1.HandlerError(c, v1.Error{Code: 400, Message: "image too large, at most 5MB"})
+		return
+	}
+	f, err := fileHeader.Open()
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] open upload file failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, maxProfileImageSize+1))
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] read upload file failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	resp, err := h.cli.UploadProfileImage(ctx, &user.UploadProfileImageRequest{
+		UserId:   userID,
+		FileName: fileHeader.Filename,
+		Data:     data,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] UploadProfileImage failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "UploadProfileImage", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, &v1.UploadProfileImageResponseBody{
+		FileID:    strconv.FormatUint(resp.GetFileId(), 10),
+		AccessURL: resp.GetAccessUrl(),
+	})
+}
+
+func (h *UserHandler) ListAuditLogs(ctx context.Context, c *app.RequestContext) {
+	var req v1.ListAuditLogsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ListAuditLogs(ctx, &user.ListAuditLogsRequest{
+		UserId:      req.UserID,
+		ActorId:     req.ActorID,
+		Action:      req.Action,
+		Outcome:     req.Outcome,
+		Ip:          req.IP,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		Page: &common.PageRequest{
+			Offset: int32(req.Offset),
+			Limit:  int32(req.Limit),
+		},
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] ListAuditLogs failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "ListAuditLogs", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, &v1.ListAuditLogsResponseBody{
+		Logs:  toAuditLogResponseBodies(resp.Logs),
+		Total: resp.Total,
+	})
+}
+
+func (h *UserHandler) GetRecentActivity(ctx context.Context, c *app.RequestContext) {
+	var req v1.RecentActivityRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.GetRecentActivity(ctx, &user.GetRecentActivityRequest{
+		UserId: userID,
+		Limit:  int32(req.Limit),
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] GetRecentActivity failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "GetRecentActivity", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, &v1.RecentActivityResponseBody{Logs: toAuditLogResponseBodies(resp.Logs)})
+}
+
+func (h *UserHandler) ListSessions(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ListSessions(ctx, &user.ListSessionsRequest{
+		UserId:           userID,
+		CurrentSessionId: c.GetString("session_id"),
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] ListSessions failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "ListSessions", resp.GetResp(), nil) {
+		return
+	}
+	sessions := make([]v1.SessionResponseBody, 0, len(resp.Sessions))
+	for _, s := range resp.Sessions {
+		sessions = append(sessions, v1.SessionResponseBody{
+			ID:         s.Id,
+			Device:     s.Device,
+			UserAgent:  s.UserAgent,
+			IP:         s.Ip,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			Current:    s.Current,
+		})
+	}
+	v1.HandlerSuccess(c, &v1.ListSessionsResponseBody{Sessions: sessions})
+}
+
+func (h *UserHandler) RevokeSession(ctx context.Context, c *app.RequestContext) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	sessionID := c.Param("id")
+	resp, err := h.cli.RevokeSession(ctx, &user.RevokeSessionRequest{
+		UserId:    userID,
+		SessionId: sessionID,
+	})
+	if !h.handleBaseResponse(ctx, c, "RevokeSession", resp, err) {
+		return
+	}
+	// 结束的是当前会话时一并清除刷新令牌
+	if sessionID == c.GetString("session_id") {
+		clearRefreshCookie(c)
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) GetPreferences(ctx context.Context, c *app.RequestContext) {
+	var req v1.GetPreferencesRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	var keys []string
+	if req.Keys != "" {
+		keys = strings.Split(req.Keys, ",")
+	}
+	resp, err := h.cli.GetPreferences(ctx, &user.GetPreferencesRequest{
+		UserId: userID,
+		Keys:   keys,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] GetPreferences failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "GetPreferences", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, toPreferencesResponseBody(resp.Values))
+}
+
+func (h *UserHandler) SetPreference(ctx context.Context, c *app.RequestContext) {
+	var req v1.SetPreferenceRequest
+	if err := c.BindAndValidate(&req); err != nil || len(req.Value) == 0 {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.SetPreference(ctx, &user.SetPreferenceRequest{
+		UserId: userID,
+		Key:    c.Param("key"),
+		Value:  string(req.Value),
+	})
+	if !h.handleBaseResponse(ctx, c, "SetPreference", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *UserHandler) BatchSetPreferences(ctx context.Context, c *app.RequestContext) {
+	var req v1.BatchSetPreferencesRequest
+	if err := c.BindAndValidate(&req); err != nil || len(req.Values) == 0 {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	values := make(map[string]string, len(req.Values))
+	for key, value := range req.Values {
+		if len(value) == 0 {
+			v1.HandlerError(c, v1.ErrBadRequest)
+			return
+		}
+		values[key] = string(value)
+	}
+	resp, err := h.cli.BatchSetPreferences(ctx, &user.BatchSetPreferencesRequest{
+		UserId: userID,
+		Values: values,
+	})
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.User] BatchSetPreferences failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return
+	}
+	if !h.handleBaseResponse(ctx, c, "BatchSetPreferences", resp.GetResp(), nil) {
+		return
+	}
+	v1.HandlerSuccess(c, toPreferencesResponseBody(resp.Values))
+}
+
+func toPreferencesResponseBody(values map[string]string) *v1.PreferencesResponseBody {
+	res := make(map[string]json.RawMessage, len(values))
+	for key, value := range values {
+		res[key] = json.RawMessage(value)
+	}
+	return &v1.PreferencesResponseBody{Values: res}
+}
+
+func toAuditLogResponseBodies(logs []*user.AuditLog) []v1.AuditLogResponseBody {
+	res := make([]v1.AuditLogResponseBody, 0, len(logs))
+	for _, l := range logs {
+		res = append(res, v1.AuditLogResponseBody{
+			ID:        strconv.FormatInt(l.Id, 10),
+			UserID:    strconv.FormatInt(l.UserId, 10),
+			ActorID:   strconv.FormatInt(l.ActorId, 10),
+			Action:    l.Action,
+			Outcome:   l.Outcome,
+			Reason:    l.Reason,
+			Detail:    l.Detail,
+			IP:        l.Ip,
+			UserAgent: l.UserAgent,
+			RequestID: l.RequestId,
+			CreatedAt: l.CreatedAt,
+		})
+	}
+	return res
 }
@@ -0,0 +1,29 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/adapter"
+)
+
+type UserJob struct {
+	srv            *adapter.Service
+	privacyService domain.PrivacyService
+}
+
+func NewUserJob(srv *adapter.Service, privacyService domain.PrivacyService) *UserJob {
+	return &UserJob{
+		srv:            srv,
+		privacyService: privacyService,
+	}
+}
+
+// RunDataJobs 执行待处理的数据导出与账号注销任务
+func (u *UserJob) RunDataJobs(ctx context.Context) error {
+	if err := u.privacyService.RunPendingJobs(ctx); err != nil {
+		return fmt.Errorf("[Adapter.UserJob.RunDataJobs]run pending jobs: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"go.uber.org/zap"
+
+	v1 "github.com/Wenrh2004/lark-lite-server/common/api/v1"
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/user"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/user/userservice"
+	"github.com/Wenrh2004/lark-lite-server/pkg/adapter"
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+	"github.com/Wenrh2004/lark-lite-server/pkg/clientinfo"
+	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
+)
+
+// deviceCookieMaxAge 设备ID Cookie 的有效期（秒）
+const deviceCookieMaxAge = 2 * 365 * 24 * 3600
+
+// ClientInfo 沿用或生成请求ID并写入响应头，同时将客户端 IP、User-Agent、请求ID与设备ID透传给用户服务，
+// 用于记录审计日志与识别新设备。设备ID优先取自 X-Device-ID 头，其次为 Cookie，都没有时生成并写入 Cookie
+func ClientInfo(ctx context.Context, c *app.RequestContext) {
+	requestID := clientinfo.RequestID(string(c.GetHeader(clientinfo.HeaderRequestID)))
+	c.Header(clientinfo.HeaderRequestID, requestID)
+	deviceID := string(c.GetHeader(clientinfo.HeaderDeviceID))
+	if deviceID == "" {
+		deviceID = string(c.Request.Header.Cookie(clientinfo.DeviceCookie))
+	}
+	deviceID, created := clientinfo.DeviceID(deviceID)
+	if created {
+		c.SetCookie(
+			clientinfo.DeviceCookie,
+			deviceID,
+			deviceCookieMaxAge,
+			"/",
+			"",
+			protocol.CookieSameSiteLaxMode,
+			true,
+			true,
+		)
+	}
+	c.Next(clientinfo.WithInfo(ctx, clientinfo.Info{
+		IP:        c.ClientIP(),
+		UserAgent: string(c.UserAgent()),
+		RequestID: requestID,
+		DeviceID:  deviceID,
+	}))
+}
+
+type AuthMiddleware struct {
+	srv        *adapter.Service
+	jwt        *jwt.JWT
+	revocation jwt.Revocation
+	cli        userservice.Client
+}
+
+func NewAuthMiddleware(srv *adapter.Service, j *jwt.JWT, revocation jwt.Revocation, cli userservice.Client) *AuthMiddleware {
+	return &AuthMiddleware{
+		srv:        srv,
+		jwt:        j,
+		revocation: revocation,
+		cli:        cli,
+	}
+}
+
+// Handle 校验 Authorization 头中的访问令牌，通过后将 user_id、session_id、roles 写入请求上下文，
+// 并将令牌透传给用户服务，由用户服务重新校验得到调用方身份
+func (m *AuthMiddleware) Handle(ctx context.Context, c *app.RequestContext) {
+	token := string(c.GetHeader("Authorization"))
+	claims, err := m.jwt.ParseToken(token)
+	if err != nil || claims.TokenType != jwt.TokenTypeAccess {
+		v1.HandlerError(c, v1.ErrUnauthorized)
+		c.Abort()
+		return
+	}
+	revoked, err := m.revocation.IsRevoked(ctx, claims)
+	if err != nil {
+		m.srv.Logger.WithContext(ctx).Error("[Adapter.AuthMiddleware] check revocation failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		c.Abort()
+		return
+	}
+	if revoked {
+		v1.HandlerError(c, v1.ErrUnauthorized)
+		c.Abort()
+		return
+	}
+	c.Set("user_id", strconv.FormatUint(claims.UserId, 10))
+	c.Set("session_id", claims.SessionID)
+	c.Set("roles", claims.Roles)
+	c.Next(authz.WithToken(ctx, token))
+}
+
+// HandleWithAccessToken 与 Handle 相同，但同时接受个人访问令牌，令牌的权限范围写入 scopes，
+// 需要配合 RequireScope 使用
+func (m *AuthMiddleware) HandleWithAccessToken(ctx context.Context, c *app.RequestContext) {
+	token := strings.TrimPrefix(string(c.GetHeader("Authorization")), "Bearer ")
+	if !strings.HasPrefix(token, domain.AccessTokenPrefix) {
+		m.Handle(ctx, c)
+		return
+	}
+	resp, err := m.cli.AuthenticateAccessToken(ctx, &user.AuthenticateAccessTokenRequest{Token: token})
+	if err != nil {
+		m.srv.Logger.WithContext(ctx).Error("[Adapter.AuthMiddleware] authenticate access token failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		c.Abort()
+		return
+	}
+	// 只有令牌无效、过期或已吊销时返回 401，账号状态等业务错误原样透传，内部错误返回 500
+	switch code := resp.GetResp().GetCode(); {
+	case code == 0:
+	case code == 401:
+		v1.HandlerError(c, v1.ErrUnauthorized)
+		c.Abort()
+		return
+	case code > 0 && code < 500:
+		v1.HandlerError(c, v1.Error{Code: int(code), Message: resp.GetResp().GetMessage()})
+		c.Abort()
+		return
+	default:
+		m.srv.Logger.WithContext(ctx).Error("[Adapter.AuthMiddleware] authenticate access token business error", zap.Any("resp", resp.GetResp()))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		c.Abort()
+		return
+	}
+	c.Set("user_id", strconv.FormatInt(resp.UserId, 10))
+	c.Set("scopes", resp.Scopes)
+	c.Next(authz.WithToken(ctx, token))
+}
+
+// RequireScope 要求个人访问令牌拥有指定权限范围，使用访问令牌登录的请求不受限制
+func RequireScope(scope string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		scopes, ok := c.Get("scopes")
+		if ok {
+			if s, _ := scopes.([]string); !slices.Contains(s, scope) {
+				v1.HandlerError(c, v1.ErrForbidden)
+				c.Abort()
+				return
+			}
+		}
+		c.Next(ctx)
+	}
+}
+
+// NewAuthenticator 用户服务 RPC 的调用方认证，个人访问令牌由 tokens 校验，其余令牌按访问令牌校验
+func NewAuthenticator(j *jwt.JWT, revocation jwt.Revocation, tokens domain.AccessTokenService) authz.Authenticator {
+	access := authz.NewJWTAuthenticator(j, revocation)
+	return authz.AuthenticatorFunc(func(ctx context.Context, token string) (*authz.Subject, error) {
+		if !strings.HasPrefix(token, domain.AccessTokenPrefix) {
+			return access.Authenticate(ctx, token)
+		}
+		t, err := tokens.Authenticate(ctx, token)
+		if err != nil {
+			if errors.Is(err, domain.ErrInvalidAccessToken) || errors.Is(err, domain.ErrUserSuspended) || errors.Is(err, domain.ErrUserBanned) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return &authz.Subject{UserID: t.UserID}, nil
+	})
+}
+
+type AuthzMiddleware struct {
+	srv      *adapter.Service
+	enforcer *authz.Enforcer
+}
+
+func NewAuthzMiddleware(srv *adapter.Service, enforcer *authz.Enforcer) *AuthzMiddleware {
+	return &AuthzMiddleware{
+		srv:      srv,
+		enforcer: enforcer,
+	}
+}
+
+// Handle 按路由与请求方法校验权限，需要在 AuthMiddleware 之后使用
+func (m *AuthzMiddleware) Handle(ctx context.Context, c *app.RequestContext) {
+	userID, roles := caller(c)
+	ok, err := m.enforcer.Enforce(userID, roles, authz.DomainAll, c.FullPath(), string(c.Method()))
+	if err != nil {
+		m.srv.Logger.WithContext(ctx).Error("[Adapter.AuthzMiddleware] enforce failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		c.Abort()
+		return
+	}
+	if !ok {
+		v1.HandlerError(c, v1.ErrForbidden)
+		c.Abort()
+		return
+	}
+	c.Next(ctx)
+}
+
+// caller 读取 AuthMiddleware 写入的用户 ID 与角色
+func caller(c *app.RequestContext) (uint64, []string) {
+	userID, _ := strconv.ParseUint(c.GetString("user_id"), 10, 64)
+	roles, _ := c.Get("roles")
+	r, _ := roles.([]string)
+	return userID, r
+}
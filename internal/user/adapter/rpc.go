@@ -2,23 +2,121 @@ package adapter
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
 	"github.com/Wenrh2004/lark-lite-server/kitex_gen/common"
 	"github.com/Wenrh2004/lark-lite-server/kitex_gen/user"
 	"github.com/Wenrh2004/lark-lite-server/pkg/adapter"
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
 )
 
 type UserServiceImpl struct {
-	srv         *adapter.Service
-	userService domain.UserService
+	srv                 *adapter.Service
+	userService         domain.UserService
+	verificationService domain.VerificationService
+	resetService        domain.PasswordResetService
+	oauthService        domain.OAuthService
+	mfaService          domain.MFAService
+	authzService        domain.AuthorizationService
+	accessTokenService  domain.AccessTokenService
+	adminService        domain.AdminService
+	privacyService      domain.PrivacyService
+	auditService        domain.AuditService
+	sessionService      domain.SessionService
+	preferenceService   domain.PreferenceService
+}
+
+// errorCodes 领域错误与业务响应码的映射
+var errorCodes = []struct {
+	err  error
+	code int32
+}{
+	{domain.ErrInvalidPassword, 400},
+	{domain.ErrInvalidEmail, 400},
+	{domain.ErrInvalidCode, 400},
+	{domain.ErrInvalidPhone, 400},
+	{domain.ErrUnknownProvider, 400},
+	{domain.ErrInvalidOAuthState, 400},
+	{domain.ErrMFANotEnrolled, 400},
+	{domain.ErrInvalidRole, 400},
+	{domain.ErrInvalidPolicy, 400},
+	{domain.ErrInvalidScope, 400},
+	{domain.ErrInvalidTokenName, 400},
+	{domain.ErrInvalidTokenExpiry, 400},
+	{domain.ErrInvalidUserStatus, 400},
+	{domain.ErrInvalidSortField, 400},
+	{domain.ErrInvalidStatusReason, 400},
+	{domain.ErrInvalidSuspendUntil, 400},
+	{domain.ErrInvalidUpdateMask, 400},
+	{domain.ErrInvalidNickname, 400},
+	{domain.ErrInvalidProfileImage, 400},
+	{domain.ErrInvalidSignature, 400},
+	{domain.ErrInvalidGender, 400},
+	{domain.ErrInvalidAuditAction, 400},
+	{domain.ErrInvalidAuditOutcome, 400},
+	{domain.ErrUnknownPreference, 400},
+	{domain.ErrInvalidPreference, 400},
+	{domain.ErrInvalidCredentials, 401},
+	{domain.ErrInvalidRefreshToken, 401},
+	{domain.ErrSessionNotFound, 401},
+	{domain.ErrRefreshTokenReused, 401},
+	{domain.ErrOAuthFailed, 401},
+	{domain.ErrInvalidMFACode, 401},
+	{domain.ErrInvalidMFAToken, 401},
+	{domain.ErrInvalidAccessToken, 401},
+	{domain.ErrUserSuspended, 403},
+	{domain.ErrUserBanned, 403},
+	{domain.ErrUserNotFound, 404},
+	{domain.ErrIdentityNotFound, 404},
+	{domain.ErrPolicyNotFound, 404},
+	{domain.ErrRoleNotAssigned, 404},
+	{domain.ErrAccessTokenNotFound, 404},
+	{domain.ErrDataJobNotFound, 404},
+	{domain.ErrUnknownSession, 404},
+	{domain.ErrUserAlreadyExists, 409},
+	{domain.ErrEmailAlreadyUsed, 409},
+	{domain.ErrPhoneAlreadyUsed, 409},
+	{domain.ErrEmailAlreadyVerified, 409},
+	{domain.ErrIdentityAlreadyLinked, 409},
+	{domain.ErrLastLoginMethod, 409},
+	{domain.ErrMFAAlreadyEnabled, 409},
+	{domain.ErrMFANotEnabled, 409},
+	{domain.ErrPolicyAlreadyExists, 409},
+	{domain.ErrProtectedPolicy, 409},
+	{domain.ErrRoleAlreadyAssigned, 409},
+	{domain.ErrPreferenceConflict, 409},
+	{domain.ErrLastAdmin, 409},
+	{domain.ErrTooManyAccessTokens, 409},
+	{domain.ErrInvalidStatusTransition, 409},
+	{domain.ErrRestoreExpired, 409},
+	{domain.ErrErasurePending, 409},
+	{domain.ErrUpdateConflict, 409},
+	{domain.ErrCodeTooFrequent, 429},
+	{domain.ErrMFATooManyAttempts, 429},
+}
+
+// errorResponse 将领域错误转换为业务响应，未知错误记录日志后统一返回内部错误
+func (u *UserServiceImpl) errorResponse(ctx context.Context, err error) *common.BaseResponse {
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			return &common.BaseResponse{Code: e.code, Message: e.err.Error()}
+		}
+	}
+	u.srv.Logger.WithContext(ctx).Error("[Adapter.UserService] internal error", zap.Error(err))
+	return &common.BaseResponse{Code: 500, Message: "internal error"}
 }
 
 func (u *UserServiceImpl) Register(ctx context.Context, req *user.RegisterRequest) (res *user.UserAuthInfoResponse, err error) {
 	newUser := domain.NewUser(req.Username, req.Password)
 	ur, err := u.userService.Register(ctx, newUser)
 	if err != nil {
-		return nil, err
+		return &user.UserAuthInfoResponse{Resp: u.errorResponse(ctx, err)}, nil
 	}
 	res = &user.UserAuthInfoResponse{
 		Resp: &common.BaseResponse{
@@ -30,12 +128,7 @@ func (u *UserServiceImpl) Register(ctx context.Context, req *user.RegisterReques
 			Username:  string(ur.Username),
 			Nickname:  string(ur.Nickname),
 			AvatarUrl: ur.AvatarURL,
-			Token: &user.TokenPair{
-				AccessToken:      ur.TokenPair.AccessToken.Token,
-				AccessExpiresIn:  ur.TokenPair.AccessToken.ExpiresIn,
-				RefreshToken:     ur.TokenPair.RefreshToken.Token,
-				RefreshExpiresIn: ur.TokenPair.RefreshToken.ExpiresIn,
-			},
+			Token:     toTokenPair(ur.TokenPair),
 		},
 	}
 	return res, nil
@@ -45,56 +138,96 @@ func (u *UserServiceImpl) Login(ctx context.Context, req *user.LoginRequest) (re
 	newUser := domain.NewUser(req.Username, req.Password)
 	ur, err := u.userService.Login(ctx, newUser)
 	if err != nil {
-		return nil, err
+		return &user.UserAuthInfoResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	info := &user.UserAuthInfo{
+		UserId:    int64(ur.ID),
+		Username:  string(ur.Username),
+		Nickname:  string(ur.Nickname),
+		AvatarUrl: ur.AvatarURL,
 	}
+	setSignInToken(info, ur)
 	res = &user.UserAuthInfoResponse{
 		Resp: &common.BaseResponse{
 			Code:    0,
 			Message: "success",
 		},
-		User: &user.UserAuthInfo{
-			UserId:    int64(ur.ID),
-			Username:  string(ur.Username),
-			Nickname:  string(ur.Nickname),
-			AvatarUrl: ur.AvatarURL,
-			Token: &user.TokenPair{
-				AccessToken:      ur.TokenPair.AccessToken.Token,
-				AccessExpiresIn:  ur.TokenPair.AccessToken.ExpiresIn,
-				RefreshToken:     ur.TokenPair.RefreshToken.Token,
-				RefreshExpiresIn: ur.TokenPair.RefreshToken.ExpiresIn,
-			},
-		},
+		User: info,
 	}
 	return res, nil
 }
 
 func (u *UserServiceImpl) Refresh(ctx context.Context, req *user.RefreshRequest) (res *user.AuthResponse, err error) {
-	// TODO implement me
-	panic("implement me")
+	pair, err := u.userService.Refresh(ctx, req.GetRefreshToken())
+	if err != nil {
+		return &user.AuthResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.AuthResponse{
+		Resp: &common.BaseResponse{
+			Code:    0,
+			Message: "success",
+		},
+		Token: toTokenPair(pair),
+	}, nil
 }
 
-func (u *UserServiceImpl) Update(ctx context.Context, req *user.UpdateRequest) (res *common.BaseResponse, err error) {
-	updateUser := &domain.User{
-		ID:            uint64(req.UserId),
-		Nickname:      domain.Username(req.Nickname),
-		AvatarURL:     req.AvatarUrl,
-		Email:         req.Email,
-		BackgroundURL: req.BackgroundUrl,
-		Signature:     req.Signature,
-		Phone:         req.Phone,
-		Gender:        domain.Gender(req.Gender),
+// setSignInToken 填充登录结果：已签发令牌时返回令牌对，需要二次验证时只返回挑战令牌
+func setSignInToken(info *user.UserAuthInfo, ur *domain.User) {
+	if ur.TokenPair != nil {
+		info.Token = toTokenPair(ur.TokenPair)
 	}
-	_, err = u.userService.GetUserByID(ctx, uint64(req.UserId))
-	if err != nil {
-		return &common.BaseResponse{Code: 1, Message: "user not found"}, nil
+	if ur.MFAChallenge != nil {
+		info.MfaToken = ur.MFAChallenge.Token
+		info.MfaExpiresIn = ur.MFAChallenge.ExpiresIn
+	}
+}
+
+func toTokenPair(pair *domain.CertificatePair) *user.TokenPair {
+	return &user.TokenPair{
+		AccessToken:      pair.AccessToken.Token,
+		AccessExpiresIn:  pair.AccessToken.ExpiresIn,
+		RefreshToken:     pair.RefreshToken.Token,
+		RefreshExpiresIn: pair.RefreshToken.ExpiresIn,
 	}
-	_, err = u.userService.UpdateUser(ctx, updateUser)
+}
+
+func (u *UserServiceImpl) Update(ctx context.Context, req *user.UpdateRequest) (res *common.BaseResponse, err error) {
+	mask, err := domain.NewProfileMask(req.GetUpdateMask())
 	if err != nil {
-		return &common.BaseResponse{Code: 3, Message: err.Error()}, nil
+		return u.errorResponse(ctx, err), nil
+	}
+	update := &domain.ProfileUpdate{
+		UserID:           uint64(req.GetUserId()),
+		Mask:             mask,
+		Nickname:         req.GetNickname(),
+		AvatarFileID:     req.GetAvatarFileId(),
+		BackgroundFileID: req.GetBackgroundFileId(),
+		Signature:        req.GetSignature(),
+		Email:            req.GetEmail(),
+		Phone:            req.GetPhone(),
+		Gender:           domain.Gender(req.GetGender()),
+	}
+	if req.GetUpdatedAt() > 0 {
+		update.UpdatedAt = time.Unix(req.GetUpdatedAt(), 0)
+	}
+	if _, err := u.userService.UpdateUser(ctx, update); err != nil {
+		return u.errorResponse(ctx, err), nil
 	}
 	return &common.BaseResponse{Code: 0, Message: "success"}, nil
 }
 
+func (u *UserServiceImpl) UploadProfileImage(ctx context.Context, req *user.UploadProfileImageRequest) (res *user.UploadProfileImageResponse, err error) {
+	f, err := u.userService.UploadProfileImage(ctx, uint64(req.GetUserId()), req.GetFileName(), req.GetData())
+	if err != nil {
+		return &user.UploadProfileImageResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.UploadProfileImageResponse{
+		Resp:      &common.BaseResponse{Code: 0, Message: "success"},
+		FileId:    f.ID,
+		AccessUrl: f.AccessURL,
+	}, nil
+}
+
 func (u *UserServiceImpl) GetUserInfo(ctx context.Context, req *user.GetUserInfoRequest) (res *user.GetUserInfoResponse, err error) {
 	ur, err := u.userService.GetUserByID(ctx, uint64(req.UserId))
 	if err != nil || ur == nil {
@@ -105,17 +238,612 @@ func (u *UserServiceImpl) GetUserInfo(ctx context.Context, req *user.GetUserInfo
 	return &user.GetUserInfoResponse{
 		Resp: &common.BaseResponse{Code: 0, Message: "success"},
 		User: &user.UserAuthInfo{
-			UserId:    int64(ur.ID),
-			Username:  ur.Username.String(),
-			Nickname:  ur.Nickname.String(),
-			AvatarUrl: ur.AvatarURL,
+			UserId:           int64(ur.ID),
+			Username:         ur.Username.String(),
+			Nickname:         ur.Nickname.String(),
+			AvatarUrl:        ur.AvatarURL,
+			Email:            ur.Email,
+			EmailVerified:    ur.EmailVerified,
+			Phone:            ur.Phone,
+			PhoneVerified:    ur.PhoneVerified,
+			BackgroundUrl:    ur.BackgroundURL,
+			Signature:        ur.Signature,
+			Gender:           int32(ur.Gender),
+			UpdatedAt:        ur.UpdatedAt.Unix(),
+			AvatarFileId:     ur.AvatarFileID,
+			BackgroundFileId: ur.BackgroundFileID,
 		},
 	}, nil
 }
 
-func NewUserServiceImpl(srv *adapter.Service, userService domain.UserService) *UserServiceImpl {
+func (u *UserServiceImpl) Logout(ctx context.Context, req *user.LogoutRequest) (res *common.BaseResponse, err error) {
+	if err := u.userService.Logout(ctx, req.GetAccessToken(), req.GetRefreshToken()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) LogoutAll(ctx context.Context, req *user.LogoutAllRequest) (res *common.BaseResponse, err error) {
+	if err := u.userService.LogoutAll(ctx, uint64(req.GetUserId())); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) SendEmailCode(ctx context.Context, req *user.SendEmailCodeRequest) (res *common.BaseResponse, err error) {
+	if err := u.verificationService.SendEmailCode(ctx, uint64(req.GetUserId()), req.GetEmail()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) VerifyEmail(ctx context.Context, req *user.VerifyEmailRequest) (res *common.BaseResponse, err error) {
+	if err := u.verificationService.VerifyEmail(ctx, uint64(req.GetUserId()), req.GetEmail(), req.GetCode()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetRequest) (res *common.BaseResponse, err error) {
+	if err := u.resetService.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) ResetPassword(ctx context.Context, req *user.ResetPasswordRequest) (res *common.BaseResponse, err error) {
+	err = u.resetService.ResetPassword(ctx, req.GetEmail(), req.GetCode(), domain.NewPassword(req.GetPassword()))
+	if err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) SendPhoneCode(ctx context.Context, req *user.SendPhoneCodeRequest) (res *common.BaseResponse, err error) {
+	if err := u.userService.SendPhoneCode(ctx, req.GetPhone()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) PhoneLogin(ctx context.Context, req *user.PhoneLoginRequest) (res *user.UserAuthInfoResponse, err error) {
+	ur, err := u.userService.LoginByPhone(ctx, req.GetPhone(), req.GetCode())
+	if err != nil {
+		return &user.UserAuthInfoResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	info := &user.UserAuthInfo{
+		UserId:        int64(ur.ID),
+		Username:      string(ur.Username),
+		Nickname:      string(ur.Nickname),
+		AvatarUrl:     ur.AvatarURL,
+		Phone:         ur.Phone,
+		PhoneVerified: ur.PhoneVerified,
+	}
+	setSignInToken(info, ur)
+	return &user.UserAuthInfoResponse{
+		Resp: &common.BaseResponse{
+			Code:    0,
+			Message: "success",
+		},
+		User: info,
+	}, nil
+}
+
+func (u *UserServiceImpl) OAuthAuthorize(ctx context.Context, req *user.OAuthAuthorizeRequest) (res *user.OAuthAuthorizeResponse, err error) {
+	authURL, state, err := u.oauthService.Authorize(ctx, req.GetProvider(), uint64(req.GetUserId()))
+	if err != nil {
+		return &user.OAuthAuthorizeResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.OAuthAuthorizeResponse{
+		Resp:    &common.BaseResponse{Code: 0, Message: "success"},
+		AuthUrl: authURL,
+		State:   state,
+	}, nil
+}
+
+func (u *UserServiceImpl) OAuthCallback(ctx context.Context, req *user.OAuthCallbackRequest) (res *user.OAuthCallbackResponse, err error) {
+	ur, linked, err := u.oauthService.Callback(ctx, req.GetProvider(), req.GetCode(), req.GetState())
+	if err != nil {
+		return &user.OAuthCallbackResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	info := &user.UserAuthInfo{
+		UserId:        int64(ur.ID),
+		Username:      string(ur.Username),
+		Nickname:      string(ur.Nickname),
+		AvatarUrl:     ur.AvatarURL,
+		Email:         ur.Email,
+		EmailVerified: ur.EmailVerified,
+	}
+	setSignInToken(info, ur)
+	return &user.OAuthCallbackResponse{
+		Resp:   &common.BaseResponse{Code: 0, Message: "success"},
+		User:   info,
+		Linked: linked,
+	}, nil
+}
+
+func (u *UserServiceImpl) ListIdentities(ctx context.Context, req *user.ListIdentitiesRequest) (res *user.ListIdentitiesResponse, err error) {
+	identities, err := u.oauthService.ListIdentities(ctx, uint64(req.GetUserId()))
+	if err != nil {
+		return &user.ListIdentitiesResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	res = &user.ListIdentitiesResponse{
+		Resp:       &common.BaseResponse{Code: 0, Message: "success"},
+		Identities: make([]*user.Identity, 0, len(identities)),
+	}
+	for _, i := range identities {
+		res.Identities = append(res.Identities, &user.Identity{
+			Provider:  i.Provider,
+			Subject:   i.Subject,
+			Email:     i.Email,
+			CreatedAt: i.CreatedAt.Unix(),
+		})
+	}
+	return res, nil
+}
+
+func (u *UserServiceImpl) UnlinkIdentity(ctx context.Context, req *user.UnlinkIdentityRequest) (res *common.BaseResponse, err error) {
+	if err := u.oauthService.Unlink(ctx, uint64(req.GetUserId()), req.GetProvider()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) VerifyMFA(ctx context.Context, req *user.VerifyMFARequest) (res *user.UserAuthInfoResponse, err error) {
+	ur, err := u.userService.VerifyMFA(ctx, req.GetMfaToken(), req.GetCode())
+	if err != nil {
+		return &user.UserAuthInfoResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	info := &user.UserAuthInfo{
+		UserId:    int64(ur.ID),
+		Username:  string(ur.Username),
+		Nickname:  string(ur.Nickname),
+		AvatarUrl: ur.AvatarURL,
+	}
+	setSignInToken(info, ur)
+	return &user.UserAuthInfoResponse{
+		Resp: &common.BaseResponse{Code: 0, Message: "success"},
+		User: info,
+	}, nil
+}
+
+func (u *UserServiceImpl) EnrollTOTP(ctx context.Context, req *user.EnrollTOTPRequest) (res *user.EnrollTOTPResponse, err error) {
+	secret, uri, err := u.mfaService.EnrollTOTP(ctx, uint64(req.GetUserId()))
+	if err != nil {
+		return &user.EnrollTOTPResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.EnrollTOTPResponse{
+		Resp:   &common.BaseResponse{Code: 0, Message: "success"},
+		Secret: secret,
+		Uri:    uri,
+	}, nil
+}
+
+func (u *UserServiceImpl) ConfirmTOTP(ctx context.Context, req *user.ConfirmTOTPRequest) (res *user.RecoveryCodesResponse, err error) {
+	codes, err := u.mfaService.ConfirmTOTP(ctx, uint64(req.GetUserId()), req.GetCode())
+	if err != nil {
+		return &user.RecoveryCodesResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.RecoveryCodesResponse{
+		Resp:          &common.BaseResponse{Code: 0, Message: "success"},
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (u *UserServiceImpl) DisableTOTP(ctx context.Context, req *user.DisableTOTPRequest) (res *common.BaseResponse, err error) {
+	if err := u.mfaService.DisableTOTP(ctx, uint64(req.GetUserId()), req.GetCode()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) RegenerateRecoveryCodes(ctx context.Context, req *user.RegenerateRecoveryCodesRequest) (res *user.RecoveryCodesResponse, err error) {
+	codes, err := u.mfaService.RegenerateRecoveryCodes(ctx, uint64(req.GetUserId()), req.GetCode())
+	if err != nil {
+		return &user.RecoveryCodesResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.RecoveryCodesResponse{
+		Resp:          &common.BaseResponse{Code: 0, Message: "success"},
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (u *UserServiceImpl) ListPolicies(ctx context.Context, req *user.ListPoliciesRequest) (res *user.ListPoliciesResponse, err error) {
+	policies, err := u.authzService.ListPolicies(ctx, toPolicy(req.GetFilter()))
+	if err != nil {
+		return &user.ListPoliciesResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	res = &user.ListPoliciesResponse{
+		Resp:     &common.BaseResponse{Code: 0, Message: "success"},
+		Policies: make([]*user.Policy, 0, len(policies)),
+	}
+	for _, p := range policies {
+		res.Policies = append(res.Policies, &user.Policy{
+			Subject: p.Subject,
+			Domain:  p.Domain,
+			Object:  p.Object,
+			Action:  p.Action,
+		})
+	}
+	return res, nil
+}
+
+func (u *UserServiceImpl) AddPolicy(ctx context.Context, req *user.PolicyRequest) (res *common.BaseResponse, err error) {
+	if err := u.authzService.AddPolicy(ctx, toPolicy(req.GetPolicy())); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) RemovePolicy(ctx context.Context, req *user.PolicyRequest) (res *common.BaseResponse, err error) {
+	if err := u.authzService.RemovePolicy(ctx, toPolicy(req.GetPolicy())); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) GetUserRoles(ctx context.Context, req *user.GetUserRolesRequest) (res *user.GetUserRolesResponse, err error) {
+	roles, err := u.authzService.GetRoles(ctx, uint64(req.GetUserId()), req.GetDomain())
+	if err != nil {
+		return &user.GetUserRolesResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.GetUserRolesResponse{
+		Resp:  &common.BaseResponse{Code: 0, Message: "success"},
+		Roles: roles,
+	}, nil
+}
+
+func (u *UserServiceImpl) AssignRole(ctx context.Context, req *user.RoleRequest) (res *common.BaseResponse, err error) {
+	if err := u.authzService.AssignRole(ctx, uint64(req.GetUserId()), req.GetRole(), req.GetDomain()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) RevokeRole(ctx context.Context, req *user.RoleRequest) (res *common.BaseResponse, err error) {
+	if err := u.authzService.RevokeRole(ctx, uint64(req.GetUserId()), req.GetRole(), req.GetDomain()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func toPolicy(p *user.Policy) authz.Policy {
+	return authz.Policy{
+		Subject: p.GetSubject(),
+		Domain:  p.GetDomain(),
+		Object:  p.GetObject(),
+		Action:  p.GetAction(),
+	}
+}
+
+func (u *UserServiceImpl) ListUsers(ctx context.Context, req *user.ListUsersRequest) (res *user.ListUsersResponse, err error) {
+	filter := domain.UserFilter{
+		UsernamePrefix: req.GetUsernamePrefix(),
+		NicknamePrefix: req.GetNicknamePrefix(),
+		Email:          req.GetEmail(),
+		Phone:          req.GetPhone(),
+		Status:         domain.UserStatus(req.GetStatus()),
+	}
+	if req.GetCreatedFrom() > 0 {
+		filter.CreatedFrom = time.Unix(req.GetCreatedFrom(), 0)
+	}
+	if req.GetCreatedTo() > 0 {
+		filter.CreatedTo = time.Unix(req.GetCreatedTo(), 0)
+	}
+	users, total, err := u.adminService.ListUsers(ctx, filter,
+		domain.UserSort{Field: domain.UserSortField(req.GetSortBy()), Desc: req.GetDesc()},
+		page.Page{Offset: int(req.GetPage().GetOffset()), Limit: int(req.GetPage().GetLimit())})
+	if err != nil {
+		return &user.ListUsersResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	res = &user.ListUsersResponse{
+		Resp:  &common.BaseResponse{Code: 0, Message: "success"},
+		Users: make([]*user.UserSummary, 0, len(users)),
+		Total: total,
+	}
+	now := time.Now()
+	for _, usr := range users {
+		status := usr.StatusAt(now)
+		summary := &user.UserSummary{
+			UserId:        int64(usr.ID),
+			Username:      usr.Username.String(),
+			Nickname:      usr.Nickname.String(),
+			AvatarUrl:     usr.AvatarURL,
+			Email:         usr.Email,
+			EmailVerified: usr.EmailVerified,
+			Phone:         usr.Phone,
+			PhoneVerified: usr.PhoneVerified,
+			Status:        string(status),
+			CreatedAt:     usr.CreatedAt.Unix(),
+			StatusReason:  usr.StatusReason,
+		}
+		if status == domain.UserStatusSuspended {
+			summary.SuspendedUntil = usr.SuspendedUntil.Unix()
+		}
+		if !usr.StatusChangedAt.IsZero() {
+			summary.StatusChangedAt = usr.StatusChangedAt.Unix()
+		}
+		res.Users = append(res.Users, summary)
+	}
+	return res, nil
+}
+
+func (u *UserServiceImpl) ChangeUserStatus(ctx context.Context, req *user.ChangeUserStatusRequest) (res *common.BaseResponse, err error) {
+	var until time.Time
+	if req.GetSuspendedUntil() > 0 {
+		until = time.Unix(req.GetSuspendedUntil(), 0)
+	}
+	if err := u.adminService.ChangeStatus(ctx, uint64(req.GetUserId()), domain.UserStatus(req.GetStatus()), until, req.GetReason()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) CreateAccessToken(ctx context.Context, req *user.CreateAccessTokenRequest) (res *user.CreateAccessTokenResponse, err error) {
+	token, secret, err := u.accessTokenService.Create(ctx, uint64(req.GetUserId()), req.GetName(), req.GetScopes(),
+		time.Duration(req.GetExpiresIn())*time.Second)
+	if err != nil {
+		return &user.CreateAccessTokenResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.CreateAccessTokenResponse{
+		Resp:   &common.BaseResponse{Code: 0, Message: "success"},
+		Token:  toAccessToken(token),
+		Secret: secret,
+	}, nil
+}
+
+func (u *UserServiceImpl) ListAccessTokens(ctx context.Context, req *user.ListAccessTokensRequest) (res *user.ListAccessTokensResponse, err error) {
+	tokens, err := u.accessTokenService.List(ctx, uint64(req.GetUserId()))
+	if err != nil {
+		return &user.ListAccessTokensResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	res = &user.ListAccessTokensResponse{
+		Resp:   &common.BaseResponse{Code: 0, Message: "success"},
+		Tokens: make([]*user.AccessToken, 0, len(tokens)),
+	}
+	for _, t := range tokens {
+		res.Tokens = append(res.Tokens, toAccessToken(t))
+	}
+	return res, nil
+}
+
+func (u *UserServiceImpl) RevokeAccessToken(ctx context.Context, req *user.RevokeAccessTokenRequest) (res *common.BaseResponse, err error) {
+	if err := u.accessTokenService.Revoke(ctx, uint64(req.GetUserId()), uint64(req.GetTokenId())); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) AuthenticateAccessToken(ctx context.Context, req *user.AuthenticateAccessTokenRequest) (res *user.AuthenticateAccessTokenResponse, err error) {
+	token, err := u.accessTokenService.Authenticate(ctx, req.GetToken())
+	if err != nil {
+		return &user.AuthenticateAccessTokenResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.AuthenticateAccessTokenResponse{
+		Resp:   &common.BaseResponse{Code: 0, Message: "success"},
+		UserId: int64(token.UserID),
+		Scopes: token.Scopes,
+	}, nil
+}
+
+func toAccessToken(t *domain.AccessToken) *user.AccessToken {
+	res := &user.AccessToken{
+		Id:        int64(t.ID),
+		Name:      t.Name,
+		Prefix:    t.Prefix,
+		Scopes:    t.Scopes,
+		CreatedAt: t.CreatedAt.Unix(),
+	}
+	if !t.ExpiresAt.IsZero() {
+		res.ExpiresAt = t.ExpiresAt.Unix()
+	}
+	if !t.LastUsedAt.IsZero() {
+		res.LastUsedAt = t.LastUsedAt.Unix()
+	}
+	return res
+}
+
+func (u *UserServiceImpl) RequestDataExport(ctx context.Context, req *user.RequestDataExportRequest) (res *user.DataJobResponse, err error) {
+	job, err := u.privacyService.RequestExport(ctx, uint64(req.GetUserId()))
+	if err != nil {
+		return &user.DataJobResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return toDataJobResponse(job), nil
+}
+
+func (u *UserServiceImpl) GetDataExport(ctx context.Context, req *user.GetDataExportRequest) (res *user.DataJobResponse, err error) {
+	job, err := u.privacyService.GetExport(ctx, uint64(req.GetUserId()), uint64(req.GetJobId()))
+	if err != nil {
+		return &user.DataJobResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return toDataJobResponse(job), nil
+}
+
+func (u *UserServiceImpl) RequestAccountErasure(ctx context.Context, req *user.RequestAccountErasureRequest) (res *user.DataJobResponse, err error) {
+	job, err := u.privacyService.RequestErasure(ctx, uint64(req.GetUserId()))
+	if err != nil {
+		return &user.DataJobResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return toDataJobResponse(job), nil
+}
+
+func (u *UserServiceImpl) ListAuditLogs(ctx context.Context, req *user.ListAuditLogsRequest) (res *user.ListAuditLogsResponse, err error) {
+	filter := domain.AuditFilter{
+		UserID:  uint64(req.GetUserId()),
+		ActorID: uint64(req.GetActorId()),
+		Action:  domain.AuditAction(req.GetAction()),
+		Outcome: domain.AuditOutcome(req.GetOutcome()),
+		IP:      req.GetIp(),
+	}
+	if req.GetCreatedFrom() > 0 {
+		filter.CreatedFrom = time.Unix(req.GetCreatedFrom(), 0)
+	}
+	if req.GetCreatedTo() > 0 {
+		filter.CreatedTo = time.Unix(req.GetCreatedTo(), 0)
+	}
+	logs, total, err := u.auditService.ListAuditLogs(ctx, filter,
+		page.Page{Offset: int(req.GetPage().GetOffset()), Limit: int(req.GetPage().GetLimit())})
+	if err != nil {
+		return &user.ListAuditLogsResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.ListAuditLogsResponse{
+		Resp:  &common.BaseResponse{Code: 0, Message: "success"},
+		Logs:  toAuditLogMessages(logs),
+		Total: total,
+	}, nil
+}
+
+func (u *UserServiceImpl) GetRecentActivity(ctx context.Context, req *user.GetRecentActivityRequest) (res *user.GetRecentActivityResponse, err error) {
+	logs, err := u.auditService.RecentActivity(ctx, uint64(req.GetUserId()), int(req.GetLimit()))
+	if err != nil {
+		return &user.GetRecentActivityResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return &user.GetRecentActivityResponse{
+		Resp: &common.BaseResponse{Code: 0, Message: "success"},
+		Logs: toAuditLogMessages(logs),
+	}, nil
+}
+
+func (u *UserServiceImpl) ListSessions(ctx context.Context, req *user.ListSessionsRequest) (res *user.ListSessionsResponse, err error) {
+	sessions, err := u.sessionService.ListSessions(ctx, uint64(req.GetUserId()))
+	if err != nil {
+		return &user.ListSessionsResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	infos := make([]*user.SessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		info := &user.SessionInfo{
+			Id:        s.ID,
+			Device:    s.Device,
+			UserAgent: s.UserAgent,
+			Ip:        s.IP,
+			Current:   s.ID == req.GetCurrentSessionId(),
+		}
+		if !s.CreatedAt.IsZero() {
+			info.CreatedAt = s.CreatedAt.Unix()
+		}
+		if !s.LastSeenAt.IsZero() {
+			info.LastSeenAt = s.LastSeenAt.Unix()
+		}
+		infos = append(infos, info)
+	}
+	return &user.ListSessionsResponse{
+		Resp:     &common.BaseResponse{Code: 0, Message: "success"},
+		Sessions: infos,
+	}, nil
+}
+
+func (u *UserServiceImpl) RevokeSession(ctx context.Context, req *user.RevokeSessionRequest) (res *common.BaseResponse, err error) {
+	if err := u.sessionService.RevokeSession(ctx, uint64(req.GetUserId()), req.GetSessionId()); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) GetPreferences(ctx context.Context, req *user.GetPreferencesRequest) (res *user.PreferencesResponse, err error) {
+	prefs, err := u.preferenceService.GetPreferences(ctx, uint64(req.GetUserId()), req.GetKeys())
+	if err != nil {
+		return &user.PreferencesResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return toPreferencesResponse(prefs), nil
+}
+
+func (u *UserServiceImpl) SetPreference(ctx context.Context, req *user.SetPreferenceRequest) (res *common.BaseResponse, err error) {
+	if err := u.preferenceService.SetPreference(ctx, uint64(req.GetUserId()), req.GetKey(), json.RawMessage(req.GetValue())); err != nil {
+		return u.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (u *UserServiceImpl) BatchSetPreferences(ctx context.Context, req *user.BatchSetPreferencesRequest) (res *user.PreferencesResponse, err error) {
+	values := make(domain.Preferences, len(req.GetValues()))
+	for key, value := range req.GetValues() {
+		values[key] = json.RawMessage(value)
+	}
+	prefs, err := u.preferenceService.SetPreferences(ctx, uint64(req.GetUserId()), values)
+	if err != nil {
+		return &user.PreferencesResponse{Resp: u.errorResponse(ctx, err)}, nil
+	}
+	return toPreferencesResponse(prefs), nil
+}
+
+func toPreferencesResponse(prefs domain.Preferences) *user.PreferencesResponse {
+	values := make(map[string]string, len(prefs))
+	for key, value := range prefs {
+		values[key] = string(value)
+	}
+	return &user.PreferencesResponse{
+		Resp:   &common.BaseResponse{Code: 0, Message: "success"},
+		Values: values,
+	}
+}
+
+func toAuditLogMessages(logs []*domain.AuditLog) []*user.AuditLog {
+	res := make([]*user.AuditLog, 0, len(logs))
+	for _, l := range logs {
+		res = append(res, &user.AuditLog{
+			Id:        int64(l.ID),
+			UserId:    int64(l.UserID),
+			ActorId:   int64(l.ActorID),
+			Action:    string(l.Action),
+			Outcome:   string(l.Outcome),
+			Reason:    l.Reason,
+			Detail:    l.Detail,
+			Ip:        l.IP,
+			UserAgent: l.UserAgent,
+			RequestId: l.RequestID,
+			CreatedAt: l.CreatedAt.Unix(),
+		})
+	}
+	return res
+}
+
+func toDataJobResponse(job *domain.DataJob) *user.DataJobResponse {
+	res := &user.DataJob{
+		Id:        int64(job.ID),
+		Type:      string(job.Type),
+		Status:    string(job.Status),
+		Step:      job.Step,
+		CreatedAt: job.CreatedAt.Unix(),
+		UpdatedAt: job.UpdatedAt.Unix(),
+	}
+	if !job.FinishedAt.IsZero() {
+		res.FinishedAt = job.FinishedAt.Unix()
+	}
+	return &user.DataJobResponse{
+		Resp:        &common.BaseResponse{Code: 0, Message: "success"},
+		Job:         res,
+		DownloadUrl: job.DownloadURL,
+	}
+}
+
+func NewUserServiceImpl(
+	srv *adapter.Service,
+	userService domain.UserService,
+	verificationService domain.VerificationService,
+	resetService domain.PasswordResetService,
+	oauthService domain.OAuthService,
+	mfaService domain.MFAService,
+	authzService domain.AuthorizationService,
+	accessTokenService domain.AccessTokenService,
+	adminService domain.AdminService,
+	privacyService domain.PrivacyService,
+	auditService domain.AuditService,
+	sessionService domain.SessionService,
+	preferenceService domain.PreferenceService,
+) *UserServiceImpl {
 	return &UserServiceImpl{
-		srv:         srv,
-		userService: userService,
+		srv:                 srv,
+		userService:         userService,
+		verificationService: verificationService,
+		resetService:        resetService,
+		oauthService:        oauthService,
+		mfaService:          mfaService,
+		authzService:        authzService,
+		accessTokenService:  accessTokenService,
+		adminService:        adminService,
+		privacyService:      privacyService,
+		auditService:        auditService,
+		sessionService:      sessionService,
+		preferenceService:   preferenceService,
 	}
 }
@@ -1,12 +1,20 @@
 package application
 
 import (
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/server"
 	"github.com/spf13/viper"
 
 	"github.com/Wenrh2004/lark-lite-server/internal/user/adapter"
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+	workspace "github.com/Wenrh2004/lark-lite-server/internal/workspace/adapter"
 	"github.com/Wenrh2004/lark-lite-server/kitex_gen/user/userservice"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/http"
 	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/task"
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
 	"github.com/Wenrh2004/lark-lite-server/pkg/log"
 )
 
@@ -45,8 +53,16 @@ import (
 // @Param data body UserAuthRequest true "登录参数"
 // @Success 200 {object} UserAuthResponseBody
 // @Router /v1/user/login [post]
+
+// @Summary 刷新令牌
+// @Description 使用 UserRefreshToken Cookie 轮换刷新令牌并获取新的访问令牌
+// @Tags 用户
+// @Produce json
+// @Success 200 {object} RefreshResponse
+// @Router /v1/user/refresh [post]
+
 // @Summary 获取用户信息
-// @Description 获取当前登录用户的信息
+// @Description 获取当前登录用户的信息，支持 user:read 权限的个人访问令牌
 // @Tags 用户
 // @Accept json
 // @Produce json
@@ -55,7 +71,7 @@ import (
 // @Router /v1/user/info [get]
 
 // @Summary 更新用户信息
-// @Description 更新当前登录用户的信息
+// @Description 部分更新当前登录用户的信息，只修改请求中出现的字段，携带 updated_at 时资料已被修改则返回 409，支持 user:write 权限的个人访问令牌
 // @Tags 用户
 // @Accept json
 // @Produce json
@@ -63,27 +79,644 @@ import (
 // @Param data body UpdateUserRequest true "更新参数"
 // @Success 200 {object} UserAuthResponseBody
 // @Router /v1/user/update [put]
-func NewUserHTTPApplication(conf *viper.Viper, logger *log.Logger, handler *adapter.UserHandler) *http.Server {
+
+// @Summary 退出登录
+// @Description 吊销当前访问令牌并结束当前会话
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} Response
+// @Router /v1/user/logout [post]
+
+// @Summary 退出所有设备
+// @Description 吊销当前用户的全部令牌并结束所有会话
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} Response
+// @Router /v1/user/logout/all [post]
+
+// @Summary 发送邮箱验证码
+// @Description 向指定邮箱发送验证码，邮箱为空时发送到当前邮箱，同一邮箱发送频率受限
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body SendEmailCodeRequest true "邮箱"
+// @Success 200 {object} Response
+// @Router /v1/user/email/code [post]
+
+// @Summary 验证邮箱
+// @Description 校验邮箱验证码，通过后将该邮箱设为当前用户邮箱并标记为已验证
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body VerifyEmailRequest true "邮箱与验证码"
+// @Success 200 {object} Response
+// @Router /v1/user/email/verify [post]
+
+// @Summary 申请重置密码
+// @Description 向已验证的邮箱发送重置密码验证码，邮箱未绑定账号时同样返回成功
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param data body RequestPasswordResetRequest true "邮箱"
+// @Success 200 {object} Response
+// @Router /v1/user/password/reset/code [post]
+
+// @Summary 重置密码
+// @Description 校验验证码后设置新密码，并使该用户的全部会话失效
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param data body ResetPasswordRequest true "邮箱、验证码与新密码"
+// @Success 200 {object} Response
+// @Router /v1/user/password/reset [post]
+
+// @Summary 发送手机登录验证码
+// @Description 向手机号发送登录验证码，同一手机号发送频率受限
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param data body SendPhoneCodeRequest true "手机号"
+// @Success 200 {object} Response
+// @Router /v1/user/phone/code [post]
+
+// @Summary 手机号登录
+// @Description 使用手机号与验证码登录，手机号未注册时自动注册
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param data body PhoneLoginRequest true "手机号与验证码"
+// @Success 200 {object} UserAuthResponseBody
+// @Router /v1/user/phone/login [post]
+
+// @Summary 第三方登录
+// @Description 跳转到第三方授权页，授权完成后回调 /v1/user/oauth/{provider}/callback
+// @Tags 用户
+// @Param provider path string true "身份提供方"
+// @Success 302
+// @Router /v1/user/oauth/{provider}/login [get]
+
+// @Summary 第三方授权回调
+// @Description 完成第三方登录或身份绑定，首次登录自动注册
+// @Tags 用户
+// @Produce json
+// @Param provider path string true "身份提供方"
+// @Param code query string true "授权码"
+// @Param state query string true "授权请求标识"
+// @Success 200 {object} UserAuthResponseBody
+// @Router /v1/user/oauth/{provider}/callback [get]
+
+// @Summary 绑定第三方身份
+// @Description 为当前用户发起第三方身份绑定，返回授权地址
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Param provider path string true "身份提供方"
+// @Success 200 {object} OAuthAuthorizeResponseBody
+// @Router /v1/user/oauth/{provider}/link [post]
+
+// @Summary 已绑定的第三方身份
+// @Description 列出当前用户绑定的第三方身份
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} IdentityResponseBody
+// @Router /v1/user/identities [get]
+
+// @Summary 解绑第三方身份
+// @Description 解绑当前用户在指定提供方的身份，不能解绑最后一种登录方式
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Param provider path string true "身份提供方"
+// @Success 200 {object} Response
+// @Router /v1/user/identities/{provider} [delete]
+
+// @Summary 二次验证登录
+// @Description 使用登录返回的挑战令牌与 TOTP 验证码或恢复码完成登录
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param data body VerifyMFARequest true "挑战令牌与验证码"
+// @Success 200 {object} UserAuthResponseBody
+// @Router /v1/user/mfa/verify [post]
+
+// @Summary 生成 TOTP 密钥
+// @Description 为当前用户生成待确认的 TOTP 密钥，返回密钥与 otpauth:// 地址
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} EnrollTOTPResponseBody
+// @Router /v1/user/mfa/totp/enroll [post]
+
+// @Summary 启用 TOTP
+// @Description 使用认证器生成的验证码确认密钥并启用二次验证，返回只展示一次的恢复码
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body MFACodeRequest true "TOTP 验证码"
+// @Success 200 {object} RecoveryCodesResponseBody
+// @Router /v1/user/mfa/totp/confirm [post]
+
+// @Summary 关闭 TOTP
+// @Description 校验 TOTP 验证码或恢复码后关闭二次验证
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body MFACodeRequest true "TOTP 验证码或恢复码"
+// @Success 200 {object} Response
+// @Router /v1/user/mfa/totp/disable [post]
+
+// @Summary 重新生成恢复码
+// @Description 校验 TOTP 验证码后生成新的恢复码，旧恢复码全部作废
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body MFACodeRequest true "TOTP 验证码"
+// @Success 200 {object} RecoveryCodesResponseBody
+// @Router /v1/user/mfa/recovery-codes [post]
+
+// @Summary 创建个人访问令牌
+// @Description 创建用于开放接口的个人访问令牌，明文令牌只在创建时返回一次
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body CreateAccessTokenRequest true "令牌名称、权限范围与有效期"
+// @Success 200 {object} CreateAccessTokenResponseBody
+// @Router /v1/user/tokens [post]
+
+// @Summary 查询个人访问令牌
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} AccessTokenResponseBody
+// @Router /v1/user/tokens [get]
+
+// @Summary 撤销个人访问令牌
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Param id path string true "令牌 ID"
+// @Success 200 {object} Response
+// @Router /v1/user/tokens/{id} [delete]
+
+// @Summary 上传头像或背景图
+// @Description 上传 jpeg、png、gif 或 webp 图片，最大 5MB，返回的 file_id 用于更新资料的 avatar_file_id 或 background_file_id
+// @Tags 用户
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param file formData file true "图片文件"
+// @Success 200 {object} UploadProfileImageResponseBody
+// @Router /v1/user/profile/image [post]
+
+// @Summary 导出个人数据
+// @Description 创建个人数据导出任务，已有未完成的导出任务时返回该任务
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} DataJobResponseBody
+// @Router /v1/user/data/export [post]
+
+// @Summary 查询个人数据导出任务
+// @Description 查询导出任务进度，完成后返回导出文件的临时下载地址
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Param id path string true "任务 ID"
+// @Success 200 {object} DataJobResponseBody
+// @Router /v1/user/data/export/{id} [get]
+
+// @Summary 注销账号
+// @Description 立即禁止登录并吊销全部令牌，随后异步删除账号数据并解除文件关联
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} DataJobResponseBody
+// @Router /v1/user/data/erase [post]
+
+// @Summary 查询访问策略
+// @Description 查询与条件匹配的访问策略，未提供的条件匹配任意值
+// @Tags 管理
+// @Produce json
+// @Security Bearer
+// @Param subject query string false "主体"
+// @Param domain query string false "域"
+// @Param object query string false "对象"
+// @Param action query string false "动作"
+// @Success 200 {array} PolicyResponseBody
+// @Router /v1/user/admin/policies [get]
+
+// @Summary 添加访问策略
+// @Tags 管理
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body PolicyRequest true "策略"
+// @Success 200 {object} Response
+// @Router /v1/user/admin/policies [post]
+
+// @Summary 删除访问策略
+// @Description 删除访问策略，内置的管理员策略不能删除
+// @Tags 管理
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body PolicyRequest true "策略"
+// @Success 200 {object} Response
+// @Router /v1/user/admin/policies [delete]
+
+// @Summary 查询用户角色
+// @Tags 管理
+// @Produce json
+// @Security Bearer
+// @Param id path string true "用户 ID"
+// @Param domain query string false "域，为空时查询全局角色"
+// @Success 200 {object} UserRolesResponseBody
+// @Router /v1/user/admin/users/{id}/roles [get]
+
+// @Summary 分配角色
+// @Tags 管理
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "用户 ID"
+// @Param data body AssignRoleRequest true "角色"
+// @Success 200 {object} Response
+// @Router /v1/user/admin/users/{id}/roles [post]
+
+// @Summary 撤销角色
+// @Description 撤销用户角色，撤销全局角色后该用户需要重新登录
+// @Tags 管理
+// @Produce json
+// @Security Bearer
+// @Param id path string true "用户 ID"
+// @Param role path string true "角色"
+// @Param domain query string false "域，为空时为全局角色"
+// @Success 200 {object} Response
+// @Router /v1/user/admin/users/{id}/roles/{role} [delete]
+
+// @Summary 查询用户列表
+// @Description 按条件分页查询用户，用户名与昵称按前缀匹配
+// @Tags 管理
+// @Produce json
+// @Security Bearer
+// @Param username query string false "用户名前缀"
+// @Param nickname query string false "昵称前缀"
+// @Param email query string false "邮箱"
+// @Param phone query string false "手机号"
+// @Param created_from query int false "创建时间起点（含），unix 秒"
+// @Param created_to query int false "创建时间终点（不含），unix 秒"
+// @Param status query string false "状态：active、suspended、banned、deleted"
+// @Param sort_by query string false "排序字段：id、username、created_at"
+// @Param order query string false "排序方向：asc、desc"
+// @Param offset query int false "偏移量"
+// @Param limit query int false "每页数量，最大 100"
+// @Success 200 {object} ListUsersResponseBody
+// @Router /v1/user/admin/users [get]
+
+// @Summary 变更账号状态
+// @Description 暂停、封禁、删除或恢复账号，变更为非正常状态后该用户的令牌立即失效，已删除的账号在 30 天内可以恢复
+// @Tags 管理
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "用户 ID"
+// @Param data body ChangeUserStatusRequest true "目标状态与原因"
+// @Success 200 {object} Response
+// @Router /v1/user/admin/users/{id}/status [put]
+
+// @Summary 查询最近活动
+// @Description 查询当前用户最近的登录、登出、密码与二次验证变更等安全事件，按时间倒序返回
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Param limit query int false "数量，默认 20，最大 100"
+// @Success 200 {object} RecentActivityResponseBody
+// @Router /v1/user/activity [get]
+
+// @Summary 查询登录设备
+// @Description 查询当前用户未过期的会话及其设备、IP 与最近使用时间，按最近使用时间倒序返回
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} ListSessionsResponseBody
+// @Router /v1/user/sessions [get]
+
+// @Summary 结束会话
+// @Description 结束当前用户的指定会话，该会话的访问令牌与刷新令牌立即失效
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Param id path string true "会话 ID"
+// @Success 200 {object} Response
+// @Router /v1/user/sessions/{id} [delete]
+
+// @Summary 查询偏好设置
+// @Description 查询当前用户的偏好设置，未设置的偏好项返回默认值。可用的偏好项：ui.theme、ui.sidebar_collapsed、ui.font_size、language、knowledge_base.default、notification.email、notification.mention、notification.digest
+// @Tags 用户
+// @Produce json
+// @Security Bearer
+// @Param keys query string false "以逗号分隔的偏好项，为空时返回全部偏好项"
+// @Success 200 {object} PreferencesResponseBody
+// @Router /v1/user/preferences [get]
+
+// @Summary 设置偏好
+// @Description 设置单个偏好项，值为 null 时恢复默认值，未知的偏好项或不合法的值返回 400
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param key path string true "偏好项"
+// @Param data body SetPreferenceRequest true "偏好值"
+// @Success 200 {object} Response
+// @Router /v1/user/preferences/{key} [put]
+
+// @Summary 批量设置偏好
+// @Description 批量设置偏好项，值为 null 时恢复默认值，任一偏好项不合法时全部不生效，返回设置后的全部偏好
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body BatchSetPreferencesRequest true "偏好项与偏好值"
+// @Success 200 {object} PreferencesResponseBody
+// @Router /v1/user/preferences [patch]
+
+// @Summary 查询审计日志
+// @Description 按条件分页查询安全审计日志，按时间倒序返回
+// @Tags 管理
+// @Produce json
+// @Security Bearer
+// @Param user_id query string false "事件所属用户 ID"
+// @Param actor_id query string false "操作人 ID"
+// @Param action query string false "事件类型：register、login、refresh、logout、logout_all、session_revoke、password_change、mfa_enable、mfa_disable、recovery_codes_renewal、policy_add、policy_remove、role_assign、role_revoke、status_change"
+// @Param outcome query string false "结果：success、failure、mfa_required"
+// @Param ip query string false "客户端 IP"
+// @Param created_from query int false "时间起点（含），unix 秒"
+// @Param created_to query int false "时间终点（不含），unix 秒"
+// @Param offset query int false "偏移量"
+// @Param limit query int false "每页数量，最大 100"
+// @Success 200 {object} ListAuditLogsResponseBody
+// @Router /v1/user/admin/audit-logs [get]
+
+// @Summary 创建工作空间
+// @Description 创建工作空间，当前用户成为所有者
+// @Tags 工作空间
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body CreateWorkspaceRequest true "请求参数"
+// @Success 200 {object} WorkspaceResponseBody
+// @Router /v1/workspaces [post]
+
+// @Summary 工作空间列表
+// @Description 列出当前用户加入的工作空间
+// @Tags 工作空间
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} ListWorkspacesResponseBody
+// @Router /v1/workspaces [get]
+
+// @Summary 接受邀请
+// @Description 使用邀请链接或邮件中的邀请令牌加入工作空间，邮件邀请要求当前用户已验证收件邮箱
+// @Tags 工作空间
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param data body AcceptInvitationRequest true "请求参数"
+// @Success 200 {object} WorkspaceResponseBody
+// @Router /v1/workspaces/invitations/accept [post]
+
+// @Summary 工作空间详情
+// @Description 查询当前用户所在的工作空间
+// @Tags 工作空间
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Success 200 {object} WorkspaceResponseBody
+// @Router /v1/workspaces/{id} [get]
+
+// @Summary 重命名工作空间
+// @Description 所有者或管理员重命名工作空间
+// @Tags 工作空间
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Param data body RenameWorkspaceRequest true "请求参数"
+// @Success 200 {object} Response
+// @Router /v1/workspaces/{id} [put]
+
+// @Summary 删除工作空间
+// @Description 所有者删除工作空间
+// @Tags 工作空间
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Success 200 {object} Response
+// @Router /v1/workspaces/{id} [delete]
+
+// @Summary 成员列表
+// @Description 分页查询工作空间成员
+// @Tags 工作空间
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Param offset query int false "偏移量"
+// @Param limit query int false "每页数量，最大 200"
+// @Success 200 {object} ListMembersResponseBody
+// @Router /v1/workspaces/{id}/members [get]
+
+// @Summary 修改成员角色
+// @Description 所有者或管理员修改成员角色，转让所有者使用转让接口
+// @Tags 工作空间
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Param member_id path string true "成员用户 ID"
+// @Param data body ChangeMemberRoleRequest true "请求参数"
+// @Success 200 {object} Response
+// @Router /v1/workspaces/{id}/members/{member_id} [put]
+
+// @Summary 移除成员
+// @Description 移除成员，member_id 为当前用户本人时表示退出工作空间
+// @Tags 工作空间
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Param member_id path string true "成员用户 ID"
+// @Success 200 {object} Response
+// @Router /v1/workspaces/{id}/members/{member_id} [delete]
+
+// @Summary 转让所有者
+// @Description 所有者将工作空间转让给其他成员
+// @Tags 工作空间
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Param data body TransferOwnershipRequest true "请求参数"
+// @Success 200 {object} Response
+// @Router /v1/workspaces/{id}/transfer [post]
+
+// @Summary 创建邀请链接
+// @Description 创建邀请链接，邀请令牌只在创建时返回
+// @Tags 工作空间
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Param data body CreateInviteLinkRequest true "请求参数"
+// @Success 200 {object} CreateInviteLinkResponseBody
+// @Router /v1/workspaces/{id}/invite-links [post]
+
+// @Summary 发送邮件邀请
+// @Description 向多个邮箱发送邀请邮件
+// @Tags 工作空间
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Param data body SendEmailInvitationsRequest true "请求参数"
+// @Success 200 {object} ListInvitationsResponseBody
+// @Router /v1/workspaces/{id}/invitations [post]
+
+// @Summary 邀请列表
+// @Description 查询工作空间中仍有效的邀请
+// @Tags 工作空间
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Success 200 {object} ListInvitationsResponseBody
+// @Router /v1/workspaces/{id}/invitations [get]
+
+// @Summary 撤销邀请
+// @Description 撤销邀请链接或邮件邀请
+// @Tags 工作空间
+// @Produce json
+// @Security Bearer
+// @Param id path string true "工作空间 ID"
+// @Param invitation_id path string true "邀请 ID"
+// @Success 200 {object} Response
+// @Router /v1/workspaces/{id}/invitations/{invitation_id} [delete]
+func NewUserHTTPApplication(conf *viper.Viper, logger *log.Logger, handler *adapter.UserHandler, workspaceHandler *workspace.WorkspaceHandler, auth *adapter.AuthMiddleware, authzMiddleware *adapter.AuthzMiddleware) *http.Server {
 	h := http.NewServer(conf, logger)
 
-	v1 := h.Group("/v1")
+	v1 := h.Group("/v1", adapter.ClientInfo)
 
 	userGroup := v1.Group("/user")
 
 	// 不需要认证的路由
 	userGroup.POST("/login", handler.Login)
 	userGroup.POST("/register", handler.Register)
-	userGroup.POST("/upload", handler.UploadFile)
+	userGroup.POST("/refresh", handler.Refresh)
+	userGroup.POST("/password/reset/code", handler.RequestPasswordReset)
+	userGroup.POST("/password/reset", handler.ResetPassword)
+	userGroup.POST("/phone/code", handler.SendPhoneCode)
+	userGroup.POST("/phone/login", handler.PhoneLogin)
+	userGroup.GET("/oauth/:provider/login", handler.OAuthLogin)
+	userGroup.GET("/oauth/:provider/callback", handler.OAuthCallback)
+	userGroup.POST("/mfa/verify", handler.VerifyMFA)
 
 	// 需要认证的路由
-	userGroup.GET("/info", handler.GetUserInfo)
-	userGroup.PUT("/update", handler.UpdateUser)
+	authGroup := userGroup.Group("", auth.Handle)
+	authGroup.POST("/logout", handler.Logout)
+	authGroup.POST("/logout/all", handler.LogoutAll)
+	authGroup.POST("/email/code", handler.SendEmailCode)
+	authGroup.POST("/email/verify", handler.VerifyEmail)
+	authGroup.POST("/oauth/:provider/link", handler.OAuthLink)
+	authGroup.GET("/identities", handler.ListIdentities)
+	authGroup.DELETE("/identities/:provider", handler.UnlinkIdentity)
+	authGroup.POST("/mfa/totp/enroll", handler.EnrollTOTP)
+	authGroup.POST("/mfa/totp/confirm", handler.ConfirmTOTP)
+	authGroup.POST("/mfa/totp/disable", handler.DisableTOTP)
+	authGroup.POST("/mfa/recovery-codes", handler.RegenerateRecoveryCodes)
+	authGroup.GET("/tokens", handler.ListAccessTokens)
+	authGroup.POST("/tokens", handler.CreateAccessToken)
+	authGroup.DELETE("/tokens/:id", handler.RevokeAccessToken)
+	authGroup.POST("/profile/image", handler.UploadProfileImage)
+	authGroup.POST("/data/export", handler.RequestDataExport)
+	authGroup.GET("/data/export/:id", handler.GetDataExport)
+	authGroup.POST("/data/erase", handler.RequestAccountErasure)
+	authGroup.GET("/activity", handler.GetRecentActivity)
+	authGroup.GET("/sessions", handler.ListSessions)
+	authGroup.DELETE("/sessions/:id", handler.RevokeSession)
+	authGroup.GET("/preferences", handler.GetPreferences)
+	authGroup.PUT("/preferences/:key", handler.SetPreference)
+	authGroup.PATCH("/preferences", handler.BatchSetPreferences)
+
+	// 开放接口，同时接受个人访问令牌
+	openGroup := userGroup.Group("", auth.HandleWithAccessToken)
+	openGroup.GET("/info", adapter.RequireScope(domain.ScopeUserRead), handler.GetUserInfo)
+	openGroup.PUT("/update", adapter.RequireScope(domain.ScopeUserWrite), handler.UpdateUser)
+
+	// 需要权限的管理路由
+	adminGroup := userGroup.Group("/admin", auth.Handle, authzMiddleware.Handle)
+	adminGroup.GET("/policies", handler.ListPolicies)
+	adminGroup.POST("/policies", handler.AddPolicy)
+	adminGroup.DELETE("/policies", handler.RemovePolicy)
+	adminGroup.GET("/users/:id/roles", handler.GetUserRoles)
+	adminGroup.POST("/users/:id/roles", handler.AssignRole)
+	adminGroup.DELETE("/users/:id/roles/:role", handler.RevokeRole)
+	adminGroup.GET("/users", handler.ListUsers)
+	adminGroup.PUT("/users/:id/status", handler.ChangeUserStatus)
+	adminGroup.GET("/audit-logs", handler.ListAuditLogs)
+
+	// 工作空间路由，调用方令牌透传给工作空间服务校验
+	workspaceGroup := v1.Group("/workspaces", auth.Handle)
+	workspaceGroup.POST("", workspaceHandler.CreateWorkspace)
+	workspaceGroup.GET("", workspaceHandler.ListWorkspaces)
+	workspaceGroup.POST("/invitations/accept", workspaceHandler.AcceptInvitation)
+	workspaceGroup.GET("/:id", workspaceHandler.GetWorkspace)
+	workspaceGroup.PUT("/:id", workspaceHandler.RenameWorkspace)
+	workspaceGroup.DELETE("/:id", workspaceHandler.DeleteWorkspace)
+	workspaceGroup.GET("/:id/members", workspaceHandler.ListMembers)
+	workspaceGroup.PUT("/:id/members/:member_id", workspaceHandler.ChangeMemberRole)
+	workspaceGroup.DELETE("/:id/members/:member_id", workspaceHandler.RemoveMember)
+	workspaceGroup.POST("/:id/transfer", workspaceHandler.TransferOwnership)
+	workspaceGroup.POST("/:id/invite-links", workspaceHandler.CreateInviteLink)
+	workspaceGroup.POST("/:id/invitations", workspaceHandler.SendEmailInvitations)
+	workspaceGroup.GET("/:id/invitations", workspaceHandler.ListInvitations)
+	workspaceGroup.DELETE("/:id/invitations/:invitation_id", workspaceHandler.RevokeInvitation)
 	return h
 }
 
-func NewUserRPCApplication(conf *viper.Viper, logger *log.Logger, handler *adapter.UserServiceImpl) *rpc.Server {
-	server := userservice.NewServer(handler)
-	s := rpc.NewServer(server, logger)
+// adminMethods 需要权限校验的管理接口
+var adminMethods = []string{
+	"ListPolicies",
+	"AddPolicy",
+	"RemovePolicy",
+	"GetUserRoles",
+	"AssignRole",
+	"RevokeRole",
+	"ListUsers",
+	"ChangeUserStatus",
+	"ListAuditLogs",
+}
+
+// NewUserTaskApplication 定时执行个人数据导出与账号注销任务
+func NewUserTaskApplication(conf *viper.Viper, logger *log.Logger, job *adapter.UserJob) *task.Server {
+	interval := conf.GetDuration("app.task.data_job.interval")
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	return task.NewServer(logger, interval, job.RunDataJobs)
+}
+
+func NewUserRPCApplication(conf *viper.Viper, logger *log.Logger, handler *adapter.UserServiceImpl, enforcer *authz.Enforcer, auth authz.Authenticator) *rpc.Server {
+	svr := userservice.NewServer(handler,
+		server.WithMetaHandler(transmeta.ServerTTHeaderHandler),
+		server.WithMiddleware(enforcer.KitexMiddleware(auth, adminMethods...)),
+	)
+	s := rpc.NewServer(svr, logger)
 
 	return s
 }
@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+)
+
+// 个人访问令牌的授权范围
+const (
+	ScopeUserRead  = "user:read"
+	ScopeUserWrite = "user:write"
+	ScopeFileRead  = "file:read"
+	ScopeFileWrite = "file:write"
+)
+
+var accessTokenScopes = map[string]struct{}{
+	ScopeUserRead:  {},
+	ScopeUserWrite: {},
+	ScopeFileRead:  {},
+	ScopeFileWrite: {},
+}
+
+const (
+	// AccessTokenPrefix 个人访问令牌的固定前缀，用于与 JWT 区分
+	AccessTokenPrefix = "llpat_"
+	// accessTokenDisplayLen 列表中展示的令牌前缀长度
+	accessTokenDisplayLen = 12
+	maxAccessTokens       = 50
+	maxAccessTokenTTL     = 366 * 24 * time.Hour
+	// accessTokenTouchInterval 最近使用时间的最小更新间隔，避免每次请求都写库
+	accessTokenTouchInterval = time.Minute
+)
+
+// AccessToken 个人访问令牌，只保存令牌的 SHA-256 哈希，ExpiresAt 为零值表示永不过期
+type AccessToken struct {
+	ID         uint64
+	UserID     uint64
+	Name       string
+	Hash       string
+	Prefix     string
+	Scopes     []string
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	CreatedAt  time.Time
+}
+
+func (t *AccessToken) Expired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && !now.Before(t.ExpiresAt)
+}
+
+// HashAccessToken 计算令牌哈希，令牌为高熵随机值，使用 SHA-256 即可
+func HashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// newAccessTokenSecret 生成 160 位随机令牌
+func newAccessTokenSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return AccessTokenPrefix + strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)), nil
+}
+
+// normalizeScopes 校验并去重授权范围
+func normalizeScopes(scopes []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(scopes))
+	res := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		s = strings.TrimSpace(s)
+		if _, ok := accessTokenScopes[s]; !ok {
+			return nil, ErrInvalidScope
+		}
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		res = append(res, s)
+	}
+	if len(res) == 0 {
+		return nil, ErrInvalidScope
+	}
+	return res, nil
+}
+
+type AccessTokenService interface {
+	// Create 创建个人访问令牌，expiresIn 为 0 表示永不过期，明文令牌只在创建时返回
+	Create(ctx context.Context, userID uint64, name string, scopes []string, expiresIn time.Duration) (*AccessToken, string, error)
+	List(ctx context.Context, userID uint64) ([]*AccessToken, error)
+	Revoke(ctx context.Context, userID, tokenID uint64) error
+	// Authenticate 校验明文令牌，令牌不存在或已过期时返回 ErrInvalidAccessToken
+	Authenticate(ctx context.Context, token string) (*AccessToken, error)
+}
+
+type accessTokenService struct {
+	srv  *domain.Service
+	repo UserRepository
+	pat  AccessTokenRepository
+}
+
+func (a *accessTokenService) Create(ctx context.Context, userID uint64, name string, scopes []string, expiresIn time.Duration) (*AccessToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > 64 {
+		return nil, "", ErrInvalidTokenName
+	}
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if expiresIn < 0 || expiresIn > maxAccessTokenTTL {
+		return nil, "", ErrInvalidTokenExpiry
+	}
+	if _, err := a.repo.GetUserByID(ctx, userID); err != nil {
+		return nil, "", fmt.Errorf("[Domain.Service.AccessToken] get user by id: %w", err)
+	}
+	count, err := a.pat.CountAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("[Domain.Service.AccessToken] count tokens: %w", err)
+	}
+	if count >= maxAccessTokens {
+		return nil, "", ErrTooManyAccessTokens
+	}
+	id, err := a.srv.Sid.GenUint64()
+	if err != nil {
+		return nil, "", fmt.Errorf("[Domain.Service.AccessToken] gen sid field: %w", err)
+	}
+	secret, err := newAccessTokenSecret()
+	if err != nil {
+		return nil, "", fmt.Errorf("[Domain.Service.AccessToken] generate token: %w", err)
+	}
+	now := time.Now()
+	token := &AccessToken{
+		ID:        id,
+		UserID:    userID,
+		Name:      name,
+		Hash:      HashAccessToken(secret),
+		Prefix:    secret[:accessTokenDisplayLen],
+		Scopes:    scopes,
+		CreatedAt: now,
+	}
+	if expiresIn > 0 {
+		token.ExpiresAt = now.Add(expiresIn)
+	}
+	if err := a.pat.CreateAccessToken(ctx, token); err != nil {
+		return nil, "", fmt.Errorf("[Domain.Service.AccessToken] create token: %w", err)
+	}
+	return token, secret, nil
+}
+
+func (a *accessTokenService) List(ctx context.Context, userID uint64) ([]*AccessToken, error) {
+	tokens, err := a.pat.ListAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.AccessToken] list tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+func (a *accessTokenService) Revoke(ctx context.Context, userID, tokenID uint64) error {
+	return a.pat.DeleteAccessToken(ctx, userID, tokenID)
+}
+
+func (a *accessTokenService) Authenticate(ctx context.Context, token string) (*AccessToken, error) {
+	if !strings.HasPrefix(token, AccessTokenPrefix) {
+		return nil, ErrInvalidAccessToken
+	}
+	t, err := a.pat.GetAccessTokenByHash(ctx, HashAccessToken(token))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if t.Expired(now) {
+		return nil, ErrInvalidAccessToken
+	}
+	user, err := a.repo.GetUserWithCredentials(ctx, t.UserID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrInvalidAccessToken
+		}
+		return nil, fmt.Errorf("[Domain.Service.AccessToken] get user by id: %w", err)
+	}
+	if err := user.CheckActive(now); err != nil {
+		return nil, err
+	}
+	if now.Sub(t.LastUsedAt) >= accessTokenTouchInterval {
+		// 最近使用时间只用于展示，更新失败不影响认证
+		if err := a.pat.TouchAccessToken(ctx, t.ID, now); err != nil {
+			a.srv.Logger.WithContext(ctx).Warn("[Domain.Service.AccessToken] touch token failed", zap.Uint64("token_id", t.ID), zap.Error(err))
+		} else {
+			t.LastUsedAt = now
+		}
+	}
+	return t, nil
+}
+
+func NewAccessTokenService(srv *domain.Service, repo UserRepository, pat AccessTokenRepository) AccessTokenService {
+	return &accessTokenService{
+		srv:  srv,
+		repo: repo,
+		pat:  pat,
+	}
+}
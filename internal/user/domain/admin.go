@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
+
+const (
+	defaultUserPageSize = 20
+	maxUserPageSize     = 100
+)
+
+// UserSortField 用户列表排序字段
+type UserSortField string
+
+const (
+	UserSortID        UserSortField = "id"
+	UserSortUsername  UserSortField = "username"
+	UserSortCreatedAt UserSortField = "created_at"
+)
+
+// UserFilter 用户查询条件，零值字段不参与过滤
+type UserFilter struct {
+	UsernamePrefix string
+	NicknamePrefix string
+	Email          string
+	Phone          string
+	// CreatedFrom 与 CreatedTo 为左闭右开区间
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Status 为空时只查询未删除的用户
+	Status UserStatus
+}
+
+// UserSort 用户列表排序方式，Field 为空时按创建时间排序
+type UserSort struct {
+	Field UserSortField
+	Desc  bool
+}
+
+type AdminService interface {
+	// ListUsers 分页查询用户，返回当前页用户与满足条件的总数
+	ListUsers(ctx context.Context, filter UserFilter, sort UserSort, p page.Page) ([]*User, int64, error)
+	// ChangeStatus 按状态机变更账号状态并记录原因，变更为非正常状态时立即吊销账号的全部令牌，
+	// 账号有未结束的注销任务时返回 ErrErasurePending
+	ChangeStatus(ctx context.Context, userID uint64, status UserStatus, until time.Time, reason string) error
+}
+
+type adminService struct {
+	srv         *domain.Service
+	repo        UserRepository
+	job         DataJobRepository
+	userService UserService
+	audit       AuditService
+}
+
+func (a *adminService) ListUsers(ctx context.Context, filter UserFilter, sort UserSort, p page.Page) ([]*User, int64, error) {
+	filter.UsernamePrefix = strings.TrimSpace(filter.UsernamePrefix)
+	filter.NicknamePrefix = strings.TrimSpace(filter.NicknamePrefix)
+	if filter.Email != "" {
+		filter.Email = NormalizeEmail(filter.Email)
+	}
+	if filter.Phone != "" {
+		phone, err := NormalizePhone(filter.Phone)
+		if err != nil {
+			return nil, 0, err
+		}
+		filter.Phone = phone
+	}
+	if _, ok := userStatusTransitions[filter.Status]; filter.Status != "" && !ok {
+		return nil, 0, ErrInvalidUserStatus
+	}
+	switch sort.Field {
+	case "":
+		sort.Field = UserSortCreatedAt
+	case UserSortID, UserSortUsername, UserSortCreatedAt:
+	default:
+		return nil, 0, ErrInvalidSortField
+	}
+	users, total, err := a.repo.ListUsers(ctx, filter, sort, p.Normalize(defaultUserPageSize, maxUserPageSize))
+	if err != nil {
+		return nil, 0, fmt.Errorf("[Domain.Service.Admin] list users: %w", err)
+	}
+	return users, total, nil
+}
+
+func (a *adminService) ChangeStatus(ctx context.Context, userID uint64, status UserStatus, until time.Time, reason string) (err error) {
+	defer func() {
+		a.audit.Record(ctx, NewAuditLog(AuditStatusChange, userID, fmt.Sprintf("%s: %s", status, reason), err))
+	}()
+	user, err := a.repo.GetUserByIDWithDeleted(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Admin] get user by id: %w", err)
+	}
+	// 注销任务执行到一半时恢复账号会得到文件已解除关联、凭证已删除的正常账号
+	_, err = a.job.GetActiveDataJob(ctx, userID, DataJobErasure)
+	if err == nil {
+		return ErrErasurePending
+	}
+	if !errors.Is(err, ErrDataJobNotFound) {
+		return fmt.Errorf("[Domain.Service.Admin] get active erasure job: %w", err)
+	}
+	from := user.StatusAt(time.Now())
+	if err := user.ChangeStatus(status, until, strings.TrimSpace(reason), time.Now()); err != nil {
+		return err
+	}
+	if err := a.repo.UpdateStatus(ctx, user); err != nil {
+		return fmt.Errorf("[Domain.Service.Admin] update status: %w", err)
+	}
+	if status != UserStatusActive {
+		if err := a.userService.LogoutAll(ctx, userID); err != nil {
+			return fmt.Errorf("[Domain.Service.Admin] revoke user tokens: %w", err)
+		}
+	}
+	a.srv.Logger.WithContext(ctx).Info("[Domain.Service.Admin] user status changed",
+		zap.Uint64("user_id", userID), zap.String("from", string(from)), zap.String("to", string(status)),
+		zap.String("reason", user.StatusReason))
+	return nil
+}
+
+func NewAdminService(srv *domain.Service, repo UserRepository, job DataJobRepository, userService UserService, audit AuditService) AdminService {
+	return &adminService{
+		srv:         srv,
+		repo:        repo,
+		job:         job,
+		userService: userService,
+		audit:       audit,
+	}
+}
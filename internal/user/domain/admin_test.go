@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChangeStatusBlockedByPendingErasure(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	jobs := &fakeDataJobRepo{}
+	admin := NewAdminService(env.srv, env.users, jobs, env.service, env.audit)
+	user := env.addUser(t, &User{ID: 1, Username: NewUsername("alice")})
+	if err := admin.ChangeStatus(ctx, user.ID, UserStatusDeleted, time.Time{}, "requested"); err != nil {
+		t.Fatalf("delete account: %v", err)
+	}
+	job := &DataJob{ID: 10, UserID: user.ID, Type: DataJobErasure, Status: DataJobRunning, Step: "files_detached"}
+	if err := jobs.CreateDataJob(ctx, job); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+	if err := admin.ChangeStatus(ctx, user.ID, UserStatusActive, time.Time{}, "restore"); !errors.Is(err, ErrErasurePending) {
+		t.Fatalf("restore during erasure err = %v, want ErrErasurePending", err)
+	}
+	if u, _ := env.users.GetUserByIDWithDeleted(ctx, user.ID); u.Status != UserStatusDeleted {
+		t.Fatalf("status = %s, want deleted", u.Status)
+	}
+
+	// 注销任务最终失败后账号可以由管理员恢复
+	job.Status = DataJobFailed
+	if err := jobs.UpdateDataJob(ctx, job); err != nil {
+		t.Fatalf("update job: %v", err)
+	}
+	if err := admin.ChangeStatus(ctx, user.ID, UserStatusActive, time.Time{}, "restore"); err != nil {
+		t.Fatalf("restore after failed erasure: %v", err)
+	}
+}
@@ -1,5 +1,16 @@
 package domain
 
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/hasher"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mail"
+)
+
 type Certificate struct {
 	Token     string
 	ExpiresIn int64
@@ -23,6 +34,40 @@ func NewCertificatePair(ack, rfk string, ackExpiresIn, rfkExpiresIn int64) *Cert
 	}
 }
 
+// Session 刷新令牌族：一次登录及其后续轮换出的令牌共享同一个会话，
+// RefreshID 记录当前唯一有效的刷新令牌 jti
+type Session struct {
+	ID        string
+	UserID    uint64
+	RefreshID string
+	ExpiresAt time.Time
+	// Device 由 User-Agent 解析出的设备名称，如 "Chrome on macOS"
+	Device    string
+	UserAgent string
+	// IP 最近一次使用该会话的客户端 IP
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+}
+
+// Identity 用户绑定的第三方身份，Provider 与 Subject 联合唯一
+type Identity struct {
+	ID        uint64
+	UserID    uint64
+	Provider  string
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+}
+
+// OAuthState 一次第三方授权请求，UserID 非零时表示为该用户绑定身份而非登录
+type OAuthState struct {
+	State    string
+	Provider string
+	Verifier string
+	UserID   uint64
+}
+
 type Gender int
 
 const (
@@ -42,10 +87,15 @@ func (u *Username) String() string {
 	return string(*u)
 }
 
+const (
+	passwordMinLength = 6
+	passwordMaxLength = 64
+)
+
+// Password 用户密码：由客户端提交时为明文，经 Encrypt 或从仓储读取后为编码哈希值
 type Password string
 
 func NewPassword(password string) Password {
-	// TODO: add the password encryption logic here
 	pwd := Password(password)
 	return pwd
 }
@@ -54,6 +104,38 @@ func (p *Password) String() string {
 	return string(*p)
 }
 
+// IsSet 判断是否设置了密码，手机号注册的用户在设置密码前不能使用密码登录
+func (p *Password) IsSet() bool {
+	return *p != ""
+}
+
+// Validate 校验明文密码长度
+func (p *Password) Validate() error {
+	if l := len(*p); l < passwordMinLength || l > passwordMaxLength {
+		return ErrInvalidPassword
+	}
+	return nil
+}
+
+// Encrypt 将明文密码替换为编码哈希值
+func (p *Password) Encrypt(h hasher.Hasher) error {
+	encoded, err := h.Hash(p.String())
+	if err != nil {
+		return fmt.Errorf("[Domain.Password.Encrypt] hash password: %w", err)
+	}
+	*p = Password(encoded)
+	return nil
+}
+
+// Compare 校验明文密码 plain 是否与当前哈希值匹配，rehash 表示哈希参数已过期需要重新生成
+func (p *Password) Compare(h hasher.Hasher, plain Password) (ok bool, rehash bool, err error) {
+	ok, err = h.Verify(plain.String(), p.String())
+	if err != nil || !ok {
+		return false, false, err
+	}
+	return true, h.NeedsRehash(p.String()), nil
+}
+
 // User 实体
 type User struct {
 	ID            uint64
@@ -62,11 +144,67 @@ type User struct {
 	Nickname      Username
 	AvatarURL     string
 	BackgroundURL string
-	Signature     string
-	Email         string
-	Phone         string
-	Gender        Gender
-	TokenPair     *CertificatePair
+	// AvatarFileID 与 BackgroundFileID 为文件服务中的图片文件，AvatarURL 与 BackgroundURL 在设置文件时一并保存
+	AvatarFileID     uint64
+	BackgroundFileID uint64
+	Signature        string
+	Email            string
+	EmailVerified    bool
+	Phone            string
+	PhoneVerified    bool
+	Gender           Gender
+	TOTP             TOTP
+	Status           UserStatus
+	// SuspendedUntil 暂停截止时间，仅 Status 为 UserStatusSuspended 时有效
+	SuspendedUntil  time.Time
+	StatusReason    string
+	StatusChangedAt time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	TokenPair       *CertificatePair
+	// MFAChallenge 启用二次验证时登录返回的挑战令牌，此时 TokenPair 为空
+	MFAChallenge *Certificate
+}
+
+// TOTP 用户的 TOTP 二次验证配置，Secret 非空且未启用表示等待确认
+type TOTP struct {
+	Secret  string
+	Enabled bool
+	// RecoveryCodes 尚未使用的恢复码哈希
+	RecoveryCodes []string
+}
+
+// NormalizeEmail 去除邮箱首尾空白并转为小写
+func NormalizeEmail(email string) string {
+	return mail.NormalizeAddress(email)
+}
+
+// ValidateEmail 校验邮箱格式，只接受不带显示名称的纯地址
+func ValidateEmail(email string) error {
+	if !mail.ValidAddress(email) {
+		return ErrInvalidEmail
+	}
+	return nil
+}
+
+// defaultPhoneRegion 未带国际区号的手机号按中国大陆号码解析
+const defaultPhoneRegion = "CN"
+
+// NormalizePhone 将手机号规范化为 E.164 格式，如 +8613800138000
+func NormalizePhone(phone string) (string, error) {
+	num, err := phonenumbers.Parse(strings.TrimSpace(phone), defaultPhoneRegion)
+	if err != nil || !phonenumbers.IsValidNumber(num) {
+		return "", ErrInvalidPhone
+	}
+	return phonenumbers.Format(num, phonenumbers.E164), nil
+}
+
+// MaskPhone 隐藏手机号中间四位，用作默认昵称
+func MaskPhone(phone string) string {
+	if len(phone) < 8 {
+		return phone
+	}
+	return phone[:len(phone)-8] + "****" + phone[len(phone)-4:]
 }
 
 func NewUser(username, password string) *User {
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+	"github.com/Wenrh2004/lark-lite-server/pkg/clientinfo"
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
+
+const (
+	defaultAuditPageSize = 20
+	maxAuditPageSize     = 100
+	// maxAuditFieldLength 原因、详情与 User-Agent 的最大长度，超出部分截断
+	maxAuditFieldLength = 255
+)
+
+// AuditAction 审计事件类型
+type AuditAction string
+
+const (
+	AuditRegister  AuditAction = "register"
+	AuditLogin     AuditAction = "login"
+	AuditRefresh   AuditAction = "refresh"
+	AuditLogout    AuditAction = "logout"
+	AuditLogoutAll AuditAction = "logout_all"
+	// AuditSessionRevoke 用户在设备管理中结束某个会话
+	AuditSessionRevoke AuditAction = "session_revoke"
+	// AuditPasswordChange 修改密码，目前只有通过邮箱验证码重置密码一种方式
+	AuditPasswordChange AuditAction = "password_change"
+
+	AuditMFAEnable            AuditAction = "mfa_enable"
+	AuditMFADisable           AuditAction = "mfa_disable"
+	AuditRecoveryCodesRenewal AuditAction = "recovery_codes_renewal"
+
+	AuditPolicyAdd    AuditAction = "policy_add"
+	AuditPolicyRemove AuditAction = "policy_remove"
+	AuditRoleAssign   AuditAction = "role_assign"
+	AuditRoleRevoke   AuditAction = "role_revoke"
+	AuditStatusChange AuditAction = "status_change"
+)
+
+var auditActions = map[AuditAction]struct{}{
+	AuditRegister: {}, AuditLogin: {}, AuditRefresh: {}, AuditLogout: {}, AuditLogoutAll: {},
+	AuditSessionRevoke: {}, AuditPasswordChange: {},
+	AuditMFAEnable: {}, AuditMFADisable: {}, AuditRecoveryCodesRenewal: {},
+	AuditPolicyAdd: {}, AuditPolicyRemove: {}, AuditRoleAssign: {}, AuditRoleRevoke: {}, AuditStatusChange: {},
+}
+
+// AuditOutcome 审计事件结果
+type AuditOutcome string
+
+const (
+	AuditSuccess AuditOutcome = "success"
+	AuditFailure AuditOutcome = "failure"
+	// AuditMFARequired 密码等第一因素校验通过，等待二次验证
+	AuditMFARequired AuditOutcome = "mfa_required"
+)
+
+// AuditLog 安全审计事件，写入后不再修改
+type AuditLog struct {
+	ID     uint64
+	UserID uint64
+	// ActorID 发起操作的用户，管理操作时为管理员，其余为用户本人
+	ActorID   uint64
+	Action    AuditAction
+	Outcome   AuditOutcome
+	Reason    string
+	Detail    string
+	IP        string
+	UserAgent string
+	RequestID string
+	CreatedAt time.Time
+}
+
+// AuditFilter 审计日志查询条件，零值字段不参与过滤
+type AuditFilter struct {
+	UserID  uint64
+	ActorID uint64
+	Action  AuditAction
+	Outcome AuditOutcome
+	IP      string
+	// CreatedFrom 与 CreatedTo 为左闭右开区间
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+// NewAuditLog 创建用户 userID 的审计事件，err 为空时结果为成功，否则为失败并记录原因
+func NewAuditLog(action AuditAction, userID uint64, detail string, err error) *AuditLog {
+	log := &AuditLog{
+		UserID:  userID,
+		Action:  action,
+		Outcome: AuditSuccess,
+		Detail:  detail,
+	}
+	if err != nil {
+		log.Outcome = AuditFailure
+		log.Reason = err.Error()
+	}
+	return log
+}
+
+type AuditService interface {
+	// Record 补全客户端信息与操作人后异步写入审计事件，不阻塞调用方，写入失败只记录日志
+	Record(ctx context.Context, log *AuditLog)
+	// ListAuditLogs 按条件分页查询审计日志，按时间倒序返回当前页与满足条件的总数
+	ListAuditLogs(ctx context.Context, filter AuditFilter, p page.Page) ([]*AuditLog, int64, error)
+	// RecentActivity 查询用户最近的 limit 条审计事件，按时间倒序返回
+	RecentActivity(ctx context.Context, userID uint64, limit int) ([]*AuditLog, error)
+}
+
+type auditService struct {
+	srv  *domain.Service
+	repo AuditRepository
+}
+
+func (a *auditService) Record(ctx context.Context, log *AuditLog) {
+	id, err := a.srv.Sid.GenUint64()
+	if err != nil {
+		a.srv.Logger.WithContext(ctx).Error("[Domain.Service.Audit] gen sid field failed",
+			zap.String("action", string(log.Action)), zap.Uint64("user_id", log.UserID), zap.Error(err))
+		return
+	}
+	log.ID = id
+	info := clientinfo.FromContext(ctx)
+	log.IP = info.IP
+	log.UserAgent = truncate(info.UserAgent, maxAuditFieldLength)
+	log.RequestID = info.RequestID
+	if log.ActorID == 0 {
+		log.ActorID = log.UserID
+		if subject, _ := authz.SubjectFromContext(ctx); subject != 0 {
+			log.ActorID = subject
+		}
+	}
+	log.Reason = truncate(log.Reason, maxAuditFieldLength)
+	log.Detail = truncate(log.Detail, maxAuditFieldLength)
+	if log.CreatedAt.IsZero() {
+		log.CreatedAt = time.Now()
+	}
+	a.repo.AppendAuditLog(ctx, log)
+}
+
+func (a *auditService) ListAuditLogs(ctx context.Context, filter AuditFilter, p page.Page) ([]*AuditLog, int64, error) {
+	if _, ok := auditActions[filter.Action]; filter.Action != "" && !ok {
+		return nil, 0, ErrInvalidAuditAction
+	}
+	switch filter.Outcome {
+	case "", AuditSuccess, AuditFailure, AuditMFARequired:
+	default:
+		return nil, 0, ErrInvalidAuditOutcome
+	}
+	filter.IP = strings.TrimSpace(filter.IP)
+	logs, total, err := a.repo.ListAuditLogs(ctx, filter, p.Normalize(defaultAuditPageSize, maxAuditPageSize))
+	if err != nil {
+		return nil, 0, fmt.Errorf("[Domain.Service.Audit] list audit logs: %w", err)
+	}
+	return logs, total, nil
+}
+
+func (a *auditService) RecentActivity(ctx context.Context, userID uint64, limit int) ([]*AuditLog, error) {
+	logs, _, err := a.repo.ListAuditLogs(ctx, AuditFilter{UserID: userID},
+		page.Page{Limit: limit}.Normalize(defaultAuditPageSize, maxAuditPageSize))
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Audit] list recent activity: %w", err)
+	}
+	return logs, nil
+}
+
+// truncate 按字符截断 s，使其不超过 n 个字符
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+func NewAuditService(srv *domain.Service, repo AuditRepository) AuditService {
+	return &auditService{
+		srv:  srv,
+		repo: repo,
+	}
+}
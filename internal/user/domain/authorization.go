@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+)
+
+type AuthorizationService interface {
+	// ListPolicies 查询与 filter 匹配的策略，filter 中的空字段匹配任意值
+	ListPolicies(ctx context.Context, filter authz.Policy) ([]authz.Policy, error)
+	AddPolicy(ctx context.Context, policy authz.Policy) error
+	// RemovePolicy 删除策略，内置的管理员策略不能删除
+	RemovePolicy(ctx context.Context, policy authz.Policy) error
+	GetRoles(ctx context.Context, userID uint64, dom string) ([]string, error)
+	AssignRole(ctx context.Context, userID uint64, role, dom string) error
+	// RevokeRole 撤销角色，撤销全局角色后用户需要重新登录，不能撤销最后一个全局管理员
+	RevokeRole(ctx context.Context, userID uint64, role, dom string) error
+}
+
+type authorizationService struct {
+	srv         *domain.Service
+	repo        UserRepository
+	enforcer    *authz.Enforcer
+	userService UserService
+	audit       AuditService
+}
+
+func (a *authorizationService) ListPolicies(ctx context.Context, filter authz.Policy) ([]authz.Policy, error) {
+	policies, err := a.enforcer.Policies(filter)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Authorization] list policies: %w", err)
+	}
+	return policies, nil
+}
+
+func (a *authorizationService) AddPolicy(ctx context.Context, policy authz.Policy) (err error) {
+	defer func() {
+		a.audit.Record(ctx, NewAuditLog(AuditPolicyAdd, 0, policyDetail(policy), err))
+	}()
+	ok, err := a.enforcer.AddPolicy(policy)
+	if err != nil {
+		return toAuthzError("add policy", err)
+	}
+	if !ok {
+		return ErrPolicyAlreadyExists
+	}
+	return nil
+}
+
+func (a *authorizationService) RemovePolicy(ctx context.Context, policy authz.Policy) (err error) {
+	defer func() {
+		a.audit.Record(ctx, NewAuditLog(AuditPolicyRemove, 0, policyDetail(policy), err))
+	}()
+	if policy == adminPolicy {
+		return ErrProtectedPolicy
+	}
+	ok, err := a.enforcer.RemovePolicy(policy)
+	if err != nil {
+		return toAuthzError("remove policy", err)
+	}
+	if !ok {
+		return ErrPolicyNotFound
+	}
+	return nil
+}
+
+func (a *authorizationService) GetRoles(ctx context.Context, userID uint64, dom string) ([]string, error) {
+	if _, err := a.repo.GetUserByID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Authorization] get user by id: %w", err)
+	}
+	return a.enforcer.RolesForUser(userID, domainOrAll(dom)), nil
+}
+
+func (a *authorizationService) AssignRole(ctx context.Context, userID uint64, role, dom string) (err error) {
+	defer func() {
+		a.audit.Record(ctx, NewAuditLog(AuditRoleAssign, userID, roleDetail(role, dom), err))
+	}()
+	if _, err := a.repo.GetUserByID(ctx, userID); err != nil {
+		return fmt.Errorf("[Domain.Service.Authorization] get user by id: %w", err)
+	}
+	ok, err := a.enforcer.AssignRole(userID, role, domainOrAll(dom))
+	if err != nil {
+		return toAuthzError("assign role", err)
+	}
+	if !ok {
+		return ErrRoleAlreadyAssigned
+	}
+	return nil
+}
+
+func (a *authorizationService) RevokeRole(ctx context.Context, userID uint64, role, dom string) (err error) {
+	dom = domainOrAll(dom)
+	defer func() {
+		a.audit.Record(ctx, NewAuditLog(AuditRoleRevoke, userID, roleDetail(role, dom), err))
+	}()
+	if role == authz.RoleAdmin && dom == authz.DomainAll {
+		admins := a.enforcer.UsersForRole(authz.RoleAdmin, authz.DomainAll)
+		if len(admins) == 1 && admins[0] == userID {
+			return ErrLastAdmin
+		}
+	}
+	ok, err := a.enforcer.RevokeRole(userID, role, dom)
+	if err != nil {
+		return toAuthzError("revoke role", err)
+	}
+	if !ok {
+		return ErrRoleNotAssigned
+	}
+	// 访问令牌携带签发时的全局角色，撤销后需要结束已有会话
+	if dom == authz.DomainAll {
+		if err := a.userService.LogoutAll(ctx, userID); err != nil {
+			return fmt.Errorf("[Domain.Service.Authorization] logout user: %w", err)
+		}
+	}
+	return nil
+}
+
+// adminPolicy 内置的管理员策略，每次启动时自动补齐
+var adminPolicy = authz.Policy{
+	Subject: authz.RoleSubject(authz.RoleAdmin),
+	Domain:  authz.DomainAll,
+	Object:  "*",
+	Action:  "*",
+}
+
+func domainOrAll(dom string) string {
+	if dom == "" {
+		return authz.DomainAll
+	}
+	return dom
+}
+
+// policyDetail 审计日志中策略的表示，与 casbin 策略行的字段顺序一致
+func policyDetail(p authz.Policy) string {
+	return fmt.Sprintf("%s, %s, %s, %s", p.Subject, p.Domain, p.Object, p.Action)
+}
+
+// roleDetail 审计日志中角色的表示
+func roleDetail(role, dom string) string {
+	return fmt.Sprintf("%s@%s", role, domainOrAll(dom))
+}
+
+func toAuthzError(op string, err error) error {
+	switch {
+	case errors.Is(err, authz.ErrInvalidRole):
+		return ErrInvalidRole
+	case errors.Is(err, authz.ErrInvalidRule):
+		return ErrInvalidPolicy
+	}
+	return fmt.Errorf("[Domain.Service.Authorization] %s: %w", op, err)
+}
+
+func NewAuthorizationService(srv *domain.Service, repo UserRepository, enforcer *authz.Enforcer, userService UserService, audit AuditService) AuthorizationService {
+	return &authorizationService{
+		srv:         srv,
+		repo:        repo,
+		enforcer:    enforcer,
+		userService: userService,
+		audit:       audit,
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import "errors"
+
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrUserAlreadyExists  = errors.New("username already exists")
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrInvalidPassword    = errors.New("password length must be between 6 and 64")
+
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrSessionNotFound     = errors.New("session expired or revoked")
+	ErrRefreshTokenReused  = errors.New("refresh token reused, session revoked")
+	ErrUnknownSession      = errors.New("session not found")
+
+	ErrInvalidEmail         = errors.New("invalid email address")
+	ErrEmailAlreadyUsed     = errors.New("email already used by another account")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+	ErrCodeTooFrequent      = errors.New("verification code requested too frequently")
+	ErrInvalidCode          = errors.New("invalid or expired verification code")
+	ErrInvalidPhone         = errors.New("invalid phone number")
+	ErrPhoneAlreadyUsed     = errors.New("phone number already used by another account")
+
+	ErrUnknownProvider       = errors.New("unknown identity provider")
+	ErrInvalidOAuthState     = errors.New("invalid or expired oauth state")
+	ErrOAuthFailed           = errors.New("third-party authorization failed")
+	ErrIdentityNotFound      = errors.New("identity not linked")
+	ErrIdentityAlreadyLinked = errors.New("identity already linked to an account")
+	ErrLastLoginMethod       = errors.New("cannot remove the last login method")
+
+	ErrMFAAlreadyEnabled  = errors.New("two-factor authentication already enabled")
+	ErrMFANotEnabled      = errors.New("two-factor authentication not enabled")
+	ErrMFANotEnrolled     = errors.New("two-factor enrollment not started")
+	ErrInvalidMFACode     = errors.New("invalid two-factor code")
+	ErrInvalidMFAToken    = errors.New("invalid or expired two-factor challenge")
+	ErrMFATooManyAttempts = errors.New("too many two-factor attempts")
+
+	ErrInvalidRole         = errors.New("invalid role")
+	ErrInvalidPolicy       = errors.New("invalid policy")
+	ErrPolicyAlreadyExists = errors.New("policy already exists")
+	ErrPolicyNotFound      = errors.New("policy not found")
+	ErrProtectedPolicy     = errors.New("built-in policy cannot be removed")
+	ErrRoleAlreadyAssigned = errors.New("role already assigned")
+	ErrRoleNotAssigned     = errors.New("role not assigned")
+	ErrLastAdmin           = errors.New("cannot revoke the last administrator")
+
+	ErrInvalidScope        = errors.New("invalid access token scope")
+	ErrInvalidTokenName    = errors.New("access token name length must be between 1 and 64")
+	ErrInvalidTokenExpiry  = errors.New("access token expiry must be between 0 and 366 days")
+	ErrTooManyAccessTokens = errors.New("too many access tokens")
+	ErrAccessTokenNotFound = errors.New("access token not found")
+	ErrInvalidAccessToken  = errors.New("invalid or expired access token")
+
+	ErrInvalidUserStatus = errors.New("invalid user status")
+	ErrInvalidSortField  = errors.New("invalid sort field")
+
+	ErrUserSuspended           = errors.New("account suspended")
+	ErrUserBanned              = errors.New("account banned")
+	ErrInvalidStatusTransition = errors.New("account status cannot be changed to the target status")
+	ErrInvalidStatusReason     = errors.New("status change reason length must be between 1 and 255")
+	ErrInvalidSuspendUntil     = errors.New("suspension end time must be in the future")
+	ErrRestoreExpired          = errors.New("account restore period expired")
+	ErrErasurePending          = errors.New("account erasure in progress")
+
+	ErrDataJobNotFound = errors.New("data job not found")
+
+	ErrInvalidUpdateMask   = errors.New("update mask is empty or contains unsupported fields")
+	ErrInvalidNickname     = errors.New("nickname length must be between 1 and 64")
+	ErrInvalidProfileImage = errors.New("profile image must be an uploaded jpeg, png, gif or webp image of at most 5MB")
+	ErrInvalidSignature    = errors.New("signature length must not exceed 255")
+	ErrInvalidGender       = errors.New("invalid gender")
+	ErrUpdateConflict      = errors.New("profile was modified by another request, reload and retry")
+
+	ErrInvalidAuditAction  = errors.New("invalid audit action")
+	ErrInvalidAuditOutcome = errors.New("invalid audit outcome")
+
+	ErrUnknownPreference  = errors.New("unknown preference key")
+	ErrInvalidPreference  = errors.New("preference value does not match its schema")
+	ErrPreferenceConflict = errors.New("preferences were modified by another request, retry")
+)
@@ -0,0 +1,521 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
+	"github.com/Wenrh2004/lark-lite-server/pkg/log"
+	"github.com/Wenrh2004/lark-lite-server/pkg/sid"
+	"github.com/Wenrh2004/lark-lite-server/pkg/sms"
+)
+
+// 测试使用的内存仓储，只实现被测流程用到的方法，其余方法调用时 panic
+
+type fakeUserRepo struct {
+	UserRepository
+	mu    sync.Mutex
+	users map[uint64]*User
+}
+
+func newFakeUserRepo() *fakeUserRepo {
+	return &fakeUserRepo{users: make(map[uint64]*User)}
+}
+
+func (r *fakeUserRepo) CreateUser(ctx context.Context, user *User) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if u.Username == user.Username {
+			return nil, ErrUserAlreadyExists
+		}
+	}
+	u := *user
+	r.users[u.ID] = &u
+	res := u
+	return &res, nil
+}
+
+func (r *fakeUserRepo) GetUserByID(ctx context.Context, id uint64) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	res := *u
+	return &res, nil
+}
+
+func (r *fakeUserRepo) GetUserWithCredentials(ctx context.Context, id uint64) (*User, error) {
+	return r.GetUserByID(ctx, id)
+}
+
+func (r *fakeUserRepo) GetUserByIDWithDeleted(ctx context.Context, id uint64) (*User, error) {
+	return r.GetUserByID(ctx, id)
+}
+
+func (r *fakeUserRepo) UpdateStatus(ctx context.Context, user *User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[user.ID]
+	if !ok {
+		return ErrUserNotFound
+	}
+	u.Status, u.SuspendedUntil, u.StatusReason, u.StatusChangedAt = user.Status, user.SuspendedUntil, user.StatusReason, user.StatusChangedAt
+	return nil
+}
+
+func (r *fakeUserRepo) GetUserByVerifiedPhone(ctx context.Context, phone string) (*User, error) {
+	return r.find(func(u *User) bool { return u.Phone == phone && u.PhoneVerified })
+}
+
+func (r *fakeUserRepo) ReleasePendingPhone(ctx context.Context, phone string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if u.Phone == phone && !u.PhoneVerified {
+			u.Phone = ""
+		}
+	}
+	return nil
+}
+
+func (r *fakeUserRepo) GetUserByVerifiedEmail(ctx context.Context, email string) (*User, error) {
+	return r.find(func(u *User) bool { return u.Email == email && u.EmailVerified })
+}
+
+func (r *fakeUserRepo) find(match func(u *User) bool) (*User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if match(u) {
+			res := *u
+			return &res, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+type fakeSessionRepo struct {
+	SessionRepository
+	mu       sync.Mutex
+	sessions map[string]*Session
+}
+
+func newFakeSessionRepo() *fakeSessionRepo {
+	return &fakeSessionRepo{sessions: make(map[string]*Session)}
+}
+
+func (r *fakeSessionRepo) CreateSession(ctx context.Context, session *Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := *session
+	r.sessions[s.ID] = &s
+	return nil
+}
+
+func (r *fakeSessionRepo) RotateSession(ctx context.Context, session *Session, refreshID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.sessions[session.ID]
+	if !ok {
+		return ErrSessionNotFound
+	}
+	if s.RefreshID != session.RefreshID {
+		return ErrRefreshTokenReused
+	}
+	s.RefreshID = refreshID
+	s.ExpiresAt = session.ExpiresAt
+	s.LastSeenAt = session.LastSeenAt
+	return nil
+}
+
+func (r *fakeSessionRepo) GetSession(ctx context.Context, id string) (*Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.sessions[id]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	res := *s
+	return &res, nil
+}
+
+func (r *fakeSessionRepo) DeleteSession(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, id)
+	return nil
+}
+
+func (r *fakeSessionRepo) DeleteUserSessions(ctx context.Context, userID uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, s := range r.sessions {
+		if s.UserID == userID {
+			delete(r.sessions, id)
+		}
+	}
+	return nil
+}
+
+func (r *fakeSessionRepo) RememberDevice(ctx context.Context, userID uint64, device string) (bool, error) {
+	return false, nil
+}
+
+// fakeRevocation 与 Redis 吊销列表语义一致的内存实现
+type fakeRevocation struct {
+	mu       sync.Mutex
+	tokens   map[string]struct{}
+	sessions map[string]struct{}
+	users    map[uint64]int64
+}
+
+func newFakeRevocation() *fakeRevocation {
+	return &fakeRevocation{
+		tokens:   make(map[string]struct{}),
+		sessions: make(map[string]struct{}),
+		users:    make(map[uint64]int64),
+	}
+}
+
+func (r *fakeRevocation) Revoke(ctx context.Context, claims *jwt.CustomClaims) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens[claims.ID] = struct{}{}
+	return nil
+}
+
+func (r *fakeRevocation) RevokeUser(ctx context.Context, userId uint64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.users[userId] = at.UnixMilli()
+	return nil
+}
+
+func (r *fakeRevocation) RevokeSession(ctx context.Context, sessionId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[sessionId] = struct{}{}
+	return nil
+}
+
+func (r *fakeRevocation) IsRevoked(ctx context.Context, claims *jwt.CustomClaims) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.tokens[claims.ID]; ok {
+		return true, nil
+	}
+	if _, ok := r.sessions[claims.SessionID]; ok && claims.SessionID != "" {
+		return true, nil
+	}
+	before, ok := r.users[claims.UserId]
+	return ok && claims.IssuedAtMillis() <= before, nil
+}
+
+// fakeCodeRepo 与 Redis 验证码脚本语义一致的内存实现，now 用于模拟时间流逝
+type fakeCodeRepo struct {
+	mu       sync.Mutex
+	now      time.Time
+	codes    map[string]*fakeCode
+	cooldown map[string]time.Time
+	sent     map[string][]time.Time
+}
+
+type fakeCode struct {
+	value       string
+	attempts    int
+	maxAttempts int
+	expiresAt   time.Time
+}
+
+func newFakeCodeRepo() *fakeCodeRepo {
+	return &fakeCodeRepo{
+		now:      time.Now(),
+		codes:    make(map[string]*fakeCode),
+		cooldown: make(map[string]time.Time),
+		sent:     make(map[string][]time.Time),
+	}
+}
+
+// advance 模拟经过 d 时长
+func (r *fakeCodeRepo) advance(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.now = r.now.Add(d)
+}
+
+func (r *fakeCodeRepo) SaveCode(ctx context.Context, code *Code) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := string(code.Purpose) + ":" + code.Target
+	if r.now.Before(r.cooldown[key]) {
+		return ErrCodeTooFrequent
+	}
+	var recent []time.Time
+	for _, at := range r.sent[key] {
+		if r.now.Sub(at) < time.Hour {
+			recent = append(recent, at)
+		}
+	}
+	if len(recent) >= code.HourlyLimit {
+		return ErrCodeTooFrequent
+	}
+	r.sent[key] = append(recent, r.now)
+	r.cooldown[key] = r.now.Add(code.Cooldown)
+	r.codes[key] = &fakeCode{
+		value:       code.Value,
+		maxAttempts: code.MaxAttempts,
+		expiresAt:   r.now.Add(code.ExpiresIn),
+	}
+	return nil
+}
+
+func (r *fakeCodeRepo) VerifyCode(ctx context.Context, purpose CodePurpose, target, value string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := string(purpose) + ":" + target
+	c, ok := r.codes[key]
+	if !ok || value == "" || !r.now.Before(c.expiresAt) {
+		return ErrInvalidCode
+	}
+	if c.value == value {
+		delete(r.codes, key)
+		return nil
+	}
+	if c.attempts++; c.attempts >= c.maxAttempts {
+		delete(r.codes, key)
+	}
+	return ErrInvalidCode
+}
+
+type fakeIdentityRepo struct {
+	mu         sync.Mutex
+	identities []*Identity
+}
+
+func (r *fakeIdentityRepo) CreateIdentity(ctx context.Context, identity *Identity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, i := range r.identities {
+		if i.Provider == identity.Provider && i.Subject == identity.Subject {
+			return errors.New("duplicate identity")
+		}
+	}
+	i := *identity
+	r.identities = append(r.identities, &i)
+	return nil
+}
+
+func (r *fakeIdentityRepo) GetIdentity(ctx context.Context, provider, subject string) (*Identity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, i := range r.identities {
+		if i.Provider == provider && i.Subject == subject {
+			res := *i
+			return &res, nil
+		}
+	}
+	return nil, ErrIdentityNotFound
+}
+
+func (r *fakeIdentityRepo) ListIdentities(ctx context.Context, userID uint64) ([]*Identity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []*Identity
+	for _, i := range r.identities {
+		if i.UserID == userID {
+			c := *i
+			res = append(res, &c)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeIdentityRepo) DeleteIdentity(ctx context.Context, userID uint64, provider string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for n, i := range r.identities {
+		if i.UserID == userID && i.Provider == provider {
+			r.identities = append(r.identities[:n], r.identities[n+1:]...)
+			return nil
+		}
+	}
+	return ErrIdentityNotFound
+}
+
+func (r *fakeIdentityRepo) DeleteUserIdentities(ctx context.Context, userID uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := r.identities[:0]
+	for _, i := range r.identities {
+		if i.UserID != userID {
+			res = append(res, i)
+		}
+	}
+	r.identities = res
+	return nil
+}
+
+type fakeStateRepo struct {
+	mu     sync.Mutex
+	states map[string]*OAuthState
+}
+
+func (r *fakeStateRepo) SaveState(ctx context.Context, state *OAuthState, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.states == nil {
+		r.states = make(map[string]*OAuthState)
+	}
+	s := *state
+	r.states[s.State] = &s
+	return nil
+}
+
+func (r *fakeStateRepo) TakeState(ctx context.Context, state string) (*OAuthState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.states[state]
+	if !ok {
+		return nil, ErrInvalidOAuthState
+	}
+	delete(r.states, state)
+	return s, nil
+}
+
+type fakeDataJobRepo struct {
+	DataJobRepository
+	mu   sync.Mutex
+	jobs []*DataJob
+}
+
+func (r *fakeDataJobRepo) CreateDataJob(ctx context.Context, job *DataJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	j := *job
+	r.jobs = append(r.jobs, &j)
+	return nil
+}
+
+func (r *fakeDataJobRepo) GetActiveDataJob(ctx context.Context, userID uint64, typ DataJobType) (*DataJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, j := range r.jobs {
+		if j.UserID == userID && j.Type == typ && !j.Finished() {
+			res := *j
+			return &res, nil
+		}
+	}
+	return nil, ErrDataJobNotFound
+}
+
+func (r *fakeDataJobRepo) UpdateDataJob(ctx context.Context, job *DataJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for n, j := range r.jobs {
+		if j.ID == job.ID {
+			res := *job
+			r.jobs[n] = &res
+			return nil
+		}
+	}
+	return ErrDataJobNotFound
+}
+
+type fakeAudit struct {
+	AuditService
+	mu   sync.Mutex
+	logs []*AuditLog
+}
+
+func (a *fakeAudit) Record(ctx context.Context, log *AuditLog) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.logs = append(a.logs, log)
+}
+
+// testEnv 由内存仓储组装的用户服务
+type testEnv struct {
+	srv        *domain.Service
+	users      *fakeUserRepo
+	sessions   *fakeSessionRepo
+	revocation *fakeRevocation
+	audit      *fakeAudit
+	code       *fakeCodeRepo
+	sms        *sms.MemorySender
+	enforcer   *authz.Enforcer
+	service    *userService
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	conf := viper.New()
+	conf.Set("security.jwt.key", "test-key")
+	conf.Set("security.jwt.expiresByAccessToken", 1)
+	conf.Set("security.jwt.expiresByRefreshToken", 24)
+	logger := &log.Logger{Logger: zap.NewNop()}
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	// 内存数据库的每个连接相互独立，只保留一个连接
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("get sql db: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	env := &testEnv{
+		srv:        domain.NewService(logger, sid.NewSidWithMachineID(1), jwt.NewJwt(conf), nil),
+		users:      newFakeUserRepo(),
+		sessions:   newFakeSessionRepo(),
+		revocation: newFakeRevocation(),
+		audit:      &fakeAudit{},
+		code:       newFakeCodeRepo(),
+		sms:        sms.NewMemorySender(),
+		enforcer:   authz.NewEnforcer(conf, db, logger),
+	}
+	env.service = &userService{
+		srv:        env.srv,
+		repo:       env.users,
+		session:    env.sessions,
+		revocation: env.revocation,
+		code:       env.code,
+		sms:        env.sms,
+		enforcer:   env.enforcer,
+		audit:      env.audit,
+	}
+	return env
+}
+
+// addUser 保存一个正常状态的用户
+func (e *testEnv) addUser(t *testing.T, user *User) *User {
+	t.Helper()
+	user.Status = UserStatusActive
+	res, err := e.users.CreateUser(context.Background(), user)
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return res
+}
+
+// authenticated 按 RPC 服务端的方式校验访问令牌，令牌无效或已吊销时返回 false
+func (e *testEnv) authenticated(t *testing.T, token string) bool {
+	t.Helper()
+	s, err := authz.NewJWTAuthenticator(e.srv.Jwt, e.revocation).Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatalf("authenticate: %v", err)
+	}
+	return s != nil
+}
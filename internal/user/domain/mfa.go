@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mfa"
+)
+
+const (
+	recoveryCodeCount = 10
+	// mfaMaxAttempts 单个挑战令牌允许的最多失败次数，已登录用户管理二次验证时同样适用
+	mfaMaxAttempts = 5
+	// mfaLockout 已登录用户管理二次验证时失败次数的统计窗口，达到上限后窗口内不再校验验证码
+	mfaLockout = 15 * time.Minute
+)
+
+var totpCodePattern = regexp.MustCompile(`^[0-9]{6}$`)
+
+type MFAService interface {
+	// EnrollTOTP 生成待确认的 TOTP 密钥，返回密钥及 otpauth:// 地址，重复调用会替换未确认的密钥
+	EnrollTOTP(ctx context.Context, userID uint64) (secret, uri string, err error)
+	// ConfirmTOTP 使用验证码确认密钥并启用二次验证，返回明文恢复码
+	ConfirmTOTP(ctx context.Context, userID uint64, code string) ([]string, error)
+	// DisableTOTP 校验 TOTP 验证码或恢复码后关闭二次验证
+	DisableTOTP(ctx context.Context, userID uint64, code string) error
+	// RegenerateRecoveryCodes 校验验证码后生成新的恢复码，旧恢复码全部作废
+	RegenerateRecoveryCodes(ctx context.Context, userID uint64, code string) ([]string, error)
+}
+
+type mfaService struct {
+	srv   *domain.Service
+	repo  UserRepository
+	mfa   MFARepository
+	totp  *mfa.TOTP
+	audit AuditService
+}
+
+func (m *mfaService) EnrollTOTP(ctx context.Context, userID uint64) (string, string, error) {
+	user, err := m.repo.GetUserWithCredentials(ctx, userID)
+	if err != nil {
+		return "", "", fmt.Errorf("[Domain.Service.MFA] get user by id: %w", err)
+	}
+	if user.TOTP.Enabled {
+		return "", "", ErrMFAAlreadyEnabled
+	}
+	secret, uri, err := m.totp.Generate(user.Username.String())
+	if err != nil {
+		return "", "", fmt.Errorf("[Domain.Service.MFA] generate totp: %w", err)
+	}
+	if err := m.repo.UpdateTOTP(ctx, userID, TOTP{Secret: secret}); err != nil {
+		return "", "", fmt.Errorf("[Domain.Service.MFA] save totp: %w", err)
+	}
+	return secret, uri, nil
+}
+
+func (m *mfaService) ConfirmTOTP(ctx context.Context, userID uint64, code string) (res []string, err error) {
+	defer func() {
+		m.audit.Record(ctx, NewAuditLog(AuditMFAEnable, userID, "totp", err))
+	}()
+	user, err := m.repo.GetUserWithCredentials(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.MFA] get user by id: %w", err)
+	}
+	if user.TOTP.Enabled {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	if user.TOTP.Secret == "" {
+		return nil, ErrMFANotEnrolled
+	}
+	if err := m.limitAttempts(ctx, userID, func() error {
+		return verifyTOTP(ctx, m.mfa, m.totp, user, code)
+	}); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := m.repo.UpdateTOTP(ctx, userID, TOTP{
+		Secret:        user.TOTP.Secret,
+		Enabled:       true,
+		RecoveryCodes: hashes,
+	}); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.MFA] enable totp: %w", err)
+	}
+	return codes, nil
+}
+
+func (m *mfaService) DisableTOTP(ctx context.Context, userID uint64, code string) (err error) {
+	defer func() {
+		m.audit.Record(ctx, NewAuditLog(AuditMFADisable, userID, "totp", err))
+	}()
+	user, err := m.repo.GetUserWithCredentials(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.MFA] get user by id: %w", err)
+	}
+	if !user.TOTP.Enabled {
+		return ErrMFANotEnabled
+	}
+	if err := m.limitAttempts(ctx, userID, func() error {
+		return verifySecondFactor(ctx, m.repo, m.mfa, m.totp, user, code)
+	}); err != nil {
+		return err
+	}
+	if err := m.repo.UpdateTOTP(ctx, userID, TOTP{}); err != nil {
+		return fmt.Errorf("[Domain.Service.MFA] disable totp: %w", err)
+	}
+	return nil
+}
+
+func (m *mfaService) RegenerateRecoveryCodes(ctx context.Context, userID uint64, code string) (res []string, err error) {
+	defer func() {
+		m.audit.Record(ctx, NewAuditLog(AuditRecoveryCodesRenewal, userID, "", err))
+	}()
+	user, err := m.repo.GetUserWithCredentials(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.MFA] get user by id: %w", err)
+	}
+	if !user.TOTP.Enabled {
+		return nil, ErrMFANotEnabled
+	}
+	if err := m.limitAttempts(ctx, userID, func() error {
+		return verifyTOTP(ctx, m.mfa, m.totp, user, code)
+	}); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	user.TOTP.RecoveryCodes = hashes
+	if err := m.repo.UpdateTOTP(ctx, userID, user.TOTP); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.MFA] save recovery codes: %w", err)
+	}
+	return codes, nil
+}
+
+// limitAttempts 复用登录挑战的失败计数限制验证码校验次数，用户在 mfaLockout 内失败达到
+// mfaMaxAttempts 次后直接返回 ErrMFATooManyAttempts，不再校验验证码
+func (m *mfaService) limitAttempts(ctx context.Context, userID uint64, verify func() error) error {
+	key := mfaAttemptKey(userID)
+	failures, err := m.mfa.ChallengeFailures(ctx, key)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.MFA] get mfa failures: %w", err)
+	}
+	if failures >= mfaMaxAttempts {
+		return ErrMFATooManyAttempts
+	}
+	err = verify()
+	if !errors.Is(err, ErrInvalidMFACode) {
+		return err
+	}
+	failures, ferr := m.mfa.FailChallenge(ctx, key, mfaLockout)
+	if ferr != nil {
+		return fmt.Errorf("[Domain.Service.MFA] record mfa failure: %w", ferr)
+	}
+	if failures >= mfaMaxAttempts {
+		return ErrMFATooManyAttempts
+	}
+	return err
+}
+
+// mfaAttemptKey 已登录用户管理二次验证时的失败计数 ID，与挑战令牌 ID 不会冲突
+func mfaAttemptKey(userID uint64) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+func newRecoveryCodes() (codes []string, hashes []string, err error) {
+	codes, err = mfa.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[Domain.Service.MFA] generate recovery codes: %w", err)
+	}
+	hashes = make([]string, 0, len(codes))
+	for _, c := range codes {
+		hashes = append(hashes, mfa.HashRecoveryCode(c))
+	}
+	return codes, hashes, nil
+}
+
+// verifyTOTP 校验 TOTP 验证码，同一验证码在有效期内只能使用一次
+func verifyTOTP(ctx context.Context, repo MFARepository, t *mfa.TOTP, user *User, code string) error {
+	if !totpCodePattern.MatchString(code) || !t.Validate(code, user.TOTP.Secret) {
+		return ErrInvalidMFACode
+	}
+	fresh, err := repo.UseTOTPCode(ctx, user.ID, code, t.CodeTTL())
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.MFA] record totp code: %w", err)
+	}
+	if !fresh {
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+// verifySecondFactor 校验 TOTP 验证码或恢复码，恢复码使用后作废
+func verifySecondFactor(ctx context.Context, users UserRepository, repo MFARepository, t *mfa.TOTP, user *User, code string) error {
+	if totpCodePattern.MatchString(code) {
+		return verifyTOTP(ctx, repo, t, user, code)
+	}
+	ok, err := users.ConsumeRecoveryCode(ctx, user.ID, mfa.HashRecoveryCode(code))
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.MFA] consume recovery code: %w", err)
+	}
+	if !ok {
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+func NewMFAService(srv *domain.Service, repo UserRepository, mfaRepo MFARepository, t *mfa.TOTP, audit AuditService) MFAService {
+	return &mfaService{
+		srv:   srv,
+		repo:  repo,
+		mfa:   mfaRepo,
+		totp:  t,
+		audit: audit,
+	}
+}
@@ -0,0 +1,262 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/oauth"
+)
+
+// oauthStateTTL 用户在第三方授权页停留的最长时间
+const oauthStateTTL = 10 * time.Minute
+
+type OAuthService interface {
+	// Authorize 发起第三方授权，userID 非零时授权完成后为该用户绑定身份，返回授权地址与 state
+	Authorize(ctx context.Context, provider string, userID uint64) (authURL, state string, err error)
+	// Callback 完成第三方授权：登录请求返回已签发令牌的用户，身份未绑定时自动注册；
+	// 绑定请求返回绑定的用户且 linked 为 true
+	Callback(ctx context.Context, provider, code, state string) (user *User, linked bool, err error)
+	ListIdentities(ctx context.Context, userID uint64) ([]*Identity, error)
+	// Unlink 解绑第三方身份，解绑后用户没有其他登录方式时返回 ErrLastLoginMethod
+	Unlink(ctx context.Context, userID uint64, provider string) error
+}
+
+type oauthService struct {
+	srv         *domain.Service
+	repo        UserRepository
+	identity    IdentityRepository
+	state       OAuthStateRepository
+	userService UserService
+	providers   oauth.Providers
+	audit       AuditService
+}
+
+func (o *oauthService) Authorize(ctx context.Context, provider string, userID uint64) (string, string, error) {
+	p, err := o.providers.Get(provider)
+	if err != nil {
+		return "", "", ErrUnknownProvider
+	}
+	if userID != 0 {
+		if _, err := o.repo.GetUserByID(ctx, userID); err != nil {
+			return "", "", fmt.Errorf("[Domain.Service.OAuth] get user by id: %w", err)
+		}
+	}
+	st := &OAuthState{
+		State:    oauth.GenerateState(),
+		Provider: provider,
+		Verifier: oauth.GenerateVerifier(),
+		UserID:   userID,
+	}
+	authURL, err := p.AuthCodeURL(ctx, st.State, st.Verifier)
+	if err != nil {
+		return "", "", fmt.Errorf("[Domain.Service.OAuth] build auth url: %w", err)
+	}
+	if err := o.state.SaveState(ctx, st, oauthStateTTL); err != nil {
+		return "", "", fmt.Errorf("[Domain.Service.OAuth] save state: %w", err)
+	}
+	return authURL, st.State, nil
+}
+
+func (o *oauthService) Callback(ctx context.Context, provider, code, state string) (*User, bool, error) {
+	p, err := o.providers.Get(provider)
+	if err != nil {
+		return nil, false, ErrUnknownProvider
+	}
+	st, err := o.state.TakeState(ctx, state)
+	if err != nil {
+		return nil, false, err
+	}
+	if st.Provider != provider {
+		return nil, false, ErrInvalidOAuthState
+	}
+	ident, err := p.Exchange(ctx, code, st.Verifier)
+	if err != nil {
+		o.srv.Logger.WithContext(ctx).Warn("[Domain.Service.OAuth] exchange failed", zap.String("provider", provider), zap.Error(err))
+		if st.UserID == 0 {
+			o.audit.Record(ctx, NewAuditLog(AuditLogin, 0, "oauth:"+provider, ErrOAuthFailed))
+		}
+		return nil, false, ErrOAuthFailed
+	}
+	if st.UserID != 0 {
+		user, err := o.link(ctx, st.UserID, ident)
+		if err != nil {
+			return nil, false, err
+		}
+		return user, true, nil
+	}
+	linked, err := o.identity.GetIdentity(ctx, provider, ident.Subject)
+	if err != nil && !errors.Is(err, ErrIdentityNotFound) {
+		return nil, false, fmt.Errorf("[Domain.Service.OAuth] get identity: %w", err)
+	}
+	var user *User
+	if linked != nil {
+		user, err = o.repo.GetUserWithCredentials(ctx, linked.UserID)
+		if err != nil {
+			return nil, false, fmt.Errorf("[Domain.Service.OAuth] get user by id: %w", err)
+		}
+	} else {
+		if user, err = o.register(ctx, ident); err != nil {
+			o.audit.Record(ctx, NewAuditLog(AuditRegister, 0, "oauth:"+provider, err))
+			return nil, false, err
+		}
+		o.audit.Record(ctx, NewAuditLog(AuditRegister, user.ID, "oauth:"+provider, nil))
+	}
+	user, err = o.userService.SignIn(ctx, user, "oauth:"+provider)
+	if err != nil {
+		return nil, false, err
+	}
+	return user, false, nil
+}
+
+// link 为已登录用户绑定第三方身份，每个提供方只能绑定一个身份
+func (o *oauthService) link(ctx context.Context, userID uint64, ident *oauth.Identity) (*User, error) {
+	user, err := o.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.OAuth] get user by id: %w", err)
+	}
+	existing, err := o.identity.GetIdentity(ctx, ident.Provider, ident.Subject)
+	if err == nil {
+		if existing.UserID == userID {
+			return user, nil
+		}
+		return nil, ErrIdentityAlreadyLinked
+	}
+	if !errors.Is(err, ErrIdentityNotFound) {
+		return nil, fmt.Errorf("[Domain.Service.OAuth] get identity: %w", err)
+	}
+	identities, err := o.identity.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.OAuth] list identities: %w", err)
+	}
+	for _, i := range identities {
+		if i.Provider == ident.Provider {
+			return nil, ErrIdentityAlreadyLinked
+		}
+	}
+	if err := o.createIdentity(ctx, userID, ident); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// register 使用第三方身份注册新用户，提供方返回的已验证邮箱未被其他账号验证时一并设为已验证邮箱
+func (o *oauthService) register(ctx context.Context, ident *oauth.Identity) (*User, error) {
+	uid, err := o.srv.Sid.GenUint64()
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.OAuth] gen sid field: %w", err)
+	}
+	username := NewUsername(fmt.Sprintf("user_%d", uid))
+	nickname := username
+	if ident.Name != "" && utf8.RuneCountInString(ident.Name) <= 64 {
+		nickname = NewUsername(ident.Name)
+	}
+	user := &User{
+		ID:        uid,
+		Username:  username,
+		Nickname:  nickname,
+		AvatarURL: ident.AvatarURL,
+		Gender:    GenderUnknown,
+	}
+	if email := NormalizeEmail(ident.Email); ident.EmailVerified && ValidateEmail(email) == nil {
+		_, err := o.repo.GetUserByVerifiedEmail(ctx, email)
+		switch {
+		case errors.Is(err, ErrUserNotFound):
+			user.Email = email
+			user.EmailVerified = true
+		case err != nil:
+			return nil, fmt.Errorf("[Domain.Service.OAuth] get user by email: %w", err)
+		}
+	}
+	// 先写入身份占用 (provider, subject)，并发的首次登录只有一个能成功
+	if err := o.createIdentity(ctx, uid, ident); err != nil {
+		return nil, err
+	}
+	res, err := o.repo.CreateUser(ctx, user)
+	if err != nil {
+		if err := o.identity.DeleteIdentity(ctx, uid, ident.Provider); err != nil {
+			o.srv.Logger.WithContext(ctx).Error("[Domain.Service.OAuth] rollback identity failed", zap.Uint64("user_id", uid), zap.Error(err))
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *oauthService) createIdentity(ctx context.Context, userID uint64, ident *oauth.Identity) error {
+	id, err := o.srv.Sid.GenUint64()
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.OAuth] gen sid field: %w", err)
+	}
+	if err := o.identity.CreateIdentity(ctx, &Identity{
+		ID:       id,
+		UserID:   userID,
+		Provider: ident.Provider,
+		Subject:  ident.Subject,
+		Email:    ident.Email,
+	}); err != nil {
+		return fmt.Errorf("[Domain.Service.OAuth] create identity: %w", err)
+	}
+	return nil
+}
+
+func (o *oauthService) ListIdentities(ctx context.Context, userID uint64) ([]*Identity, error) {
+	identities, err := o.identity.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.OAuth] list identities: %w", err)
+	}
+	return identities, nil
+}
+
+func (o *oauthService) Unlink(ctx context.Context, userID uint64, provider string) error {
+	user, err := o.repo.GetUserWithCredentials(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.OAuth] get user by id: %w", err)
+	}
+	identities, err := o.identity.ListIdentities(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.OAuth] list identities: %w", err)
+	}
+	found := false
+	for _, i := range identities {
+		if i.Provider == provider {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrIdentityNotFound
+	}
+	// 已验证邮箱可以通过重置密码找回账号，同样视为一种登录方式
+	if len(identities) == 1 && !user.Password.IsSet() && !user.PhoneVerified && !user.EmailVerified {
+		return ErrLastLoginMethod
+	}
+	if err := o.identity.DeleteIdentity(ctx, userID, provider); err != nil {
+		return fmt.Errorf("[Domain.Service.OAuth] delete identity: %w", err)
+	}
+	return nil
+}
+
+func NewOAuthService(
+	srv *domain.Service,
+	repo UserRepository,
+	identity IdentityRepository,
+	state OAuthStateRepository,
+	userService UserService,
+	providers oauth.Providers,
+	audit AuditService,
+) OAuthService {
+	return &oauthService{
+		srv:         srv,
+		repo:        repo,
+		identity:    identity,
+		state:       state,
+		userService: userService,
+		providers:   providers,
+		audit:       audit,
+	}
+}
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/oauth"
+	"github.com/Wenrh2004/lark-lite-server/pkg/oauth/oauthtest"
+)
+
+type oauthEnv struct {
+	*testEnv
+	idp      *oauthtest.Server
+	identity *fakeIdentityRepo
+	state    *fakeStateRepo
+	oauth    OAuthService
+}
+
+// newOAuthEnv 对接本地模拟身份提供方的第三方登录服务，idp 与 other 两个提供方使用同一个身份提供方
+func newOAuthEnv(t *testing.T) *oauthEnv {
+	t.Helper()
+	idp := oauthtest.NewServer()
+	t.Cleanup(idp.Close)
+	env := &oauthEnv{
+		testEnv:  newTestEnv(t),
+		idp:      idp,
+		identity: &fakeIdentityRepo{},
+		state:    &fakeStateRepo{},
+	}
+	providers := oauth.Providers{
+		"idp":   oauth.NewOIDCProvider("idp", idp.Config("http://localhost/oauth/idp/callback")),
+		"other": oauth.NewOIDCProvider("other", idp.Config("http://localhost/oauth/other/callback")),
+	}
+	env.oauth = NewOAuthService(env.srv, env.users, env.identity, env.state, env.service, providers, env.audit)
+	return env
+}
+
+// authorize 发起授权并模拟用户在身份提供方同意授权，返回回调收到的授权码与 state
+func (e *oauthEnv) authorize(t *testing.T, provider string, userID uint64) (code, state string) {
+	t.Helper()
+	authURL, state, err := e.oauth.Authorize(context.Background(), provider, userID)
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	code, returned, err := e.idp.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("idp authorize: %v", err)
+	}
+	if returned != state {
+		t.Fatalf("idp returned state %q, want %q", returned, state)
+	}
+	return code, state
+}
+
+func TestOAuthLoginRegistersThenSignsIn(t *testing.T) {
+	env := newOAuthEnv(t)
+	ctx := context.Background()
+	env.idp.SetUser(oauthtest.User{Subject: "sub-1", Email: "Alice@Example.com", EmailVerified: true, Name: "Alice"})
+
+	code, state := env.authorize(t, "idp", 0)
+	user, linked, err := env.oauth.Callback(ctx, "idp", code, state)
+	if err != nil {
+		t.Fatalf("callback: %v", err)
+	}
+	if linked {
+		t.Fatal("login callback reported a link")
+	}
+	if user.Email != "alice@example.com" || !user.EmailVerified || user.Nickname.String() != "Alice" {
+		t.Fatalf("registered user = %+v", user)
+	}
+	if user.TokenPair == nil || !env.authenticated(t, user.TokenPair.AccessToken.Token) {
+		t.Fatal("login did not issue a valid access token")
+	}
+
+	code, state = env.authorize(t, "idp", 0)
+	again, _, err := env.oauth.Callback(ctx, "idp", code, state)
+	if err != nil {
+		t.Fatalf("second callback: %v", err)
+	}
+	if again.ID != user.ID {
+		t.Fatalf("second login user = %d, want %d", again.ID, user.ID)
+	}
+}
+
+func TestOAuthCallbackRejectsInvalidState(t *testing.T) {
+	env := newOAuthEnv(t)
+	ctx := context.Background()
+	env.idp.SetUser(oauthtest.User{Subject: "sub-1"})
+
+	code, _ := env.authorize(t, "idp", 0)
+	if _, _, err := env.oauth.Callback(ctx, "idp", code, "forged-state"); !errors.Is(err, ErrInvalidOAuthState) {
+		t.Fatalf("unknown state err = %v, want ErrInvalidOAuthState", err)
+	}
+
+	// 为其他提供方签发的 state 不能用于本提供方的回调，且校验后即作废
+	code, state := env.authorize(t, "other", 0)
+	if _, _, err := env.oauth.Callback(ctx, "idp", code, state); !errors.Is(err, ErrInvalidOAuthState) {
+		t.Fatalf("mismatched provider err = %v, want ErrInvalidOAuthState", err)
+	}
+	if _, _, err := env.oauth.Callback(ctx, "other", code, state); !errors.Is(err, ErrInvalidOAuthState) {
+		t.Fatalf("state after mismatch err = %v, want ErrInvalidOAuthState", err)
+	}
+
+	code, state = env.authorize(t, "idp", 0)
+	if _, _, err := env.oauth.Callback(ctx, "idp", code, state); err != nil {
+		t.Fatalf("callback: %v", err)
+	}
+	if _, _, err := env.oauth.Callback(ctx, "idp", code, state); !errors.Is(err, ErrInvalidOAuthState) {
+		t.Fatalf("replayed state err = %v, want ErrInvalidOAuthState", err)
+	}
+}
+
+func TestOAuthCallbackRequiresPKCEVerifier(t *testing.T) {
+	env := newOAuthEnv(t)
+	ctx := context.Background()
+	env.idp.SetUser(oauthtest.User{Subject: "sub-1"})
+
+	code, state := env.authorize(t, "idp", 0)
+	// 授权码被截获后，没有与 state 一同保存的 code_verifier 无法换取令牌
+	env.state.states[state].Verifier = oauth.GenerateVerifier()
+	if _, _, err := env.oauth.Callback(ctx, "idp", code, state); !errors.Is(err, ErrOAuthFailed) {
+		t.Fatalf("wrong verifier err = %v, want ErrOAuthFailed", err)
+	}
+	if len(env.users.users) != 0 || len(env.identity.identities) != 0 {
+		t.Fatal("failed exchange created a user or identity")
+	}
+}
+
+func TestOAuthLink(t *testing.T) {
+	env := newOAuthEnv(t)
+	ctx := context.Background()
+	alice := env.addUser(t, &User{ID: 1, Username: NewUsername("alice")})
+	bob := env.addUser(t, &User{ID: 2, Username: NewUsername("bob")})
+	env.idp.SetUser(oauthtest.User{Subject: "sub-1"})
+
+	code, state := env.authorize(t, "idp", alice.ID)
+	user, linked, err := env.oauth.Callback(ctx, "idp", code, state)
+	if err != nil {
+		t.Fatalf("link callback: %v", err)
+	}
+	if !linked || user.ID != alice.ID || user.TokenPair != nil {
+		t.Fatalf("link result = %+v linked=%v", user, linked)
+	}
+
+	// 已绑定到 alice 的身份不能再绑定到 bob
+	code, state = env.authorize(t, "idp", bob.ID)
+	if _, _, err := env.oauth.Callback(ctx, "idp", code, state); !errors.Is(err, ErrIdentityAlreadyLinked) {
+		t.Fatalf("link to another user err = %v, want ErrIdentityAlreadyLinked", err)
+	}
+	// 每个提供方只能绑定一个身份
+	env.idp.SetUser(oauthtest.User{Subject: "sub-2"})
+	code, state = env.authorize(t, "idp", alice.ID)
+	if _, _, err := env.oauth.Callback(ctx, "idp", code, state); !errors.Is(err, ErrIdentityAlreadyLinked) {
+		t.Fatalf("second identity err = %v, want ErrIdentityAlreadyLinked", err)
+	}
+
+	env.idp.SetUser(oauthtest.User{Subject: "sub-1"})
+	code, state = env.authorize(t, "idp", 0)
+	res, _, err := env.oauth.Callback(ctx, "idp", code, state)
+	if err != nil {
+		t.Fatalf("login with linked identity: %v", err)
+	}
+	if res.ID != alice.ID {
+		t.Fatalf("login user = %d, want %d", res.ID, alice.ID)
+	}
+}
+
+func TestOAuthRegisterEmailVerification(t *testing.T) {
+	tests := []struct {
+		name         string
+		idpUser      oauthtest.User
+		wantEmail    string
+		wantVerified bool
+	}{
+		{
+			name:    "unverified email",
+			idpUser: oauthtest.User{Subject: "sub-1", Email: "carol@example.com"},
+		},
+		{
+			name:    "email verified by another account",
+			idpUser: oauthtest.User{Subject: "sub-2", Email: "alice@example.com", EmailVerified: true},
+		},
+		{
+			name:         "verified email",
+			idpUser:      oauthtest.User{Subject: "sub-3", Email: "dave@example.com", EmailVerified: true},
+			wantEmail:    "dave@example.com",
+			wantVerified: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newOAuthEnv(t)
+			env.addUser(t, &User{ID: 1, Username: NewUsername("alice"), Email: "alice@example.com", EmailVerified: true})
+			env.idp.SetUser(tt.idpUser)
+			code, state := env.authorize(t, "idp", 0)
+			user, _, err := env.oauth.Callback(context.Background(), "idp", code, state)
+			if err != nil {
+				t.Fatalf("callback: %v", err)
+			}
+			if user.ID == 1 {
+				t.Fatal("identity was signed in to the account owning the email")
+			}
+			if user.Email != tt.wantEmail || user.EmailVerified != tt.wantVerified {
+				t.Fatalf("email = %q verified=%v, want %q verified=%v", user.Email, user.EmailVerified, tt.wantEmail, tt.wantVerified)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/hasher"
+	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mail"
+)
+
+type PasswordResetService interface {
+	// RequestPasswordReset 向已验证的邮箱发送重置验证码，邮箱未绑定账号时同样返回成功
+	RequestPasswordReset(ctx context.Context, email string) error
+	// ResetPassword 校验重置验证码后设置新密码，并吊销该用户的全部令牌
+	ResetPassword(ctx context.Context, email, code string, password Password) error
+}
+
+type passwordResetService struct {
+	srv        *domain.Service
+	repo       UserRepository
+	code       CodeRepository
+	session    SessionRepository
+	revocation jwt.Revocation
+	hasher     hasher.Hasher
+	sender     mail.Sender
+	audit      AuditService
+}
+
+func (p *passwordResetService) RequestPasswordReset(ctx context.Context, email string) error {
+	email = NormalizeEmail(email)
+	if err := ValidateEmail(email); err != nil {
+		return err
+	}
+	// 先写入验证码再查询用户，使限流对是否存在账号的邮箱表现一致，避免枚举邮箱
+	code, err := NewCode(CodePurposeResetPassword, email)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.PasswordReset] generate code: %w", err)
+	}
+	if err := p.code.SaveCode(ctx, code); err != nil {
+		return err
+	}
+	if _, err := p.repo.GetUserByVerifiedEmail(ctx, email); err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			p.srv.Logger.WithContext(ctx).Info("[Domain.Service.PasswordReset] reset requested for unknown email")
+			return nil
+		}
+		return fmt.Errorf("[Domain.Service.PasswordReset] get user by email: %w", err)
+	}
+	return sendCodeMail(p.sender, email, "重置密码", code)
+}
+
+func (p *passwordResetService) ResetPassword(ctx context.Context, email, code string, password Password) (err error) {
+	var userID uint64
+	defer func() {
+		p.audit.Record(ctx, NewAuditLog(AuditPasswordChange, userID, "reset:"+email, err))
+	}()
+	email = NormalizeEmail(email)
+	if err := ValidateEmail(email); err != nil {
+		return err
+	}
+	if err := password.Validate(); err != nil {
+		return err
+	}
+	if err := p.code.VerifyCode(ctx, CodePurposeResetPassword, email, code); err != nil {
+		return err
+	}
+	user, err := p.repo.GetUserByVerifiedEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return ErrInvalidCode
+		}
+		return fmt.Errorf("[Domain.Service.PasswordReset] get user by email: %w", err)
+	}
+	userID = user.ID
+	if err := password.Encrypt(p.hasher); err != nil {
+		return err
+	}
+	if err := p.repo.UpdatePassword(ctx, user.ID, password); err != nil {
+		return fmt.Errorf("[Domain.Service.PasswordReset] update password: %w", err)
+	}
+	if err := p.revocation.RevokeUser(ctx, user.ID, time.Now()); err != nil {
+		return fmt.Errorf("[Domain.Service.PasswordReset] revoke user tokens: %w", err)
+	}
+	if err := p.session.DeleteUserSessions(ctx, user.ID); err != nil {
+		return fmt.Errorf("[Domain.Service.PasswordReset] delete user sessions: %w", err)
+	}
+	p.srv.Logger.WithContext(ctx).Info("[Domain.Service.PasswordReset] password reset", zap.Uint64("user_id", user.ID))
+	return nil
+}
+
+func NewPasswordResetService(
+	srv *domain.Service,
+	repo UserRepository,
+	code CodeRepository,
+	session SessionRepository,
+	revocation jwt.Revocation,
+	h hasher.Hasher,
+	sender mail.Sender,
+	audit AuditService,
+) PasswordResetService {
+	return &passwordResetService{
+		srv:        srv,
+		repo:       repo,
+		code:       code,
+		session:    session,
+		revocation: revocation,
+		hasher:     h,
+		sender:     sender,
+		audit:      audit,
+	}
+}
@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+)
+
+// preferenceSaveAttempts 并发修改同一用户的偏好时的最大重试次数
+const preferenceSaveAttempts = 3
+
+// Preferences 用户偏好，键为偏好项名称，值为 JSON 编码的偏好值
+type Preferences map[string]json.RawMessage
+
+// PreferenceType 偏好值类型
+type PreferenceType string
+
+const (
+	PreferenceBool   PreferenceType = "bool"
+	PreferenceString PreferenceType = "string"
+	PreferenceInt    PreferenceType = "int"
+)
+
+// PreferenceSpec 偏好项定义，Default 为 JSON 编码的默认值
+type PreferenceSpec struct {
+	Type    PreferenceType
+	Default json.RawMessage
+	// Enum 字符串偏好的可选值，为空时不限制
+	Enum []string
+	// MaxLength 字符串偏好的最大字符数，为 0 时不限制
+	MaxLength int
+	// Min 与 Max 整数偏好的取值范围
+	Min, Max int64
+}
+
+// preferenceSchema 已知的偏好项，不在其中的键会被拒绝
+var preferenceSchema = map[string]PreferenceSpec{
+	"ui.theme":             {Type: PreferenceString, Default: json.RawMessage(`"system"`), Enum: []string{"light", "dark", "system"}},
+	"ui.sidebar_collapsed": {Type: PreferenceBool, Default: json.RawMessage(`false`)},
+	"ui.font_size":         {Type: PreferenceInt, Default: json.RawMessage(`14`), Min: 12, Max: 24},
+	"language":             {Type: PreferenceString, Default: json.RawMessage(`"zh-CN"`), Enum: []string{"zh-CN", "en-US"}},
+	// knowledge_base.default 默认打开的知识库 ID，空字符串表示未设置
+	"knowledge_base.default": {Type: PreferenceString, Default: json.RawMessage(`""`), MaxLength: 32},
+	"notification.email":     {Type: PreferenceBool, Default: json.RawMessage(`true`)},
+	"notification.mention":   {Type: PreferenceBool, Default: json.RawMessage(`true`)},
+	"notification.digest":    {Type: PreferenceString, Default: json.RawMessage(`"weekly"`), Enum: []string{"off", "daily", "weekly"}},
+}
+
+// Normalize 校验偏好值是否符合定义，返回紧凑编码的值
+func (s PreferenceSpec) Normalize(raw json.RawMessage) (json.RawMessage, error) {
+	if isJSONNull(raw) {
+		return nil, ErrInvalidPreference
+	}
+	var v any
+	switch s.Type {
+	case PreferenceBool:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return nil, ErrInvalidPreference
+		}
+		v = b
+	case PreferenceString:
+		var str string
+		if err := json.Unmarshal(raw, &str); err != nil {
+			return nil, ErrInvalidPreference
+		}
+		if s.MaxLength > 0 && utf8.RuneCountInString(str) > s.MaxLength {
+			return nil, ErrInvalidPreference
+		}
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, str) {
+			return nil, ErrInvalidPreference
+		}
+		v = str
+	case PreferenceInt:
+		var n int64
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return nil, ErrInvalidPreference
+		}
+		if n < s.Min || n > s.Max {
+			return nil, ErrInvalidPreference
+		}
+		v = n
+	default:
+		return nil, ErrInvalidPreference
+	}
+	return json.Marshal(v)
+}
+
+// isJSONNull 值为空或 JSON null
+func isJSONNull(raw json.RawMessage) bool {
+	raw = bytes.TrimSpace(raw)
+	return len(raw) == 0 || bytes.Equal(raw, []byte("null"))
+}
+
+// PreferenceKeys 返回全部已知的偏好项名称，按字典序排列
+func PreferenceKeys() []string {
+	keys := make([]string, 0, len(preferenceSchema))
+	for key := range preferenceSchema {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type PreferenceService interface {
+	// GetPreferences 查询用户的偏好，未设置的偏好项返回默认值，keys 为空时返回全部偏好项，
+	// 包含未知的偏好项时返回 ErrUnknownPreference
+	GetPreferences(ctx context.Context, userID uint64, keys []string) (Preferences, error)
+	// SetPreference 设置单个偏好项，值为 null 时恢复默认值
+	SetPreference(ctx context.Context, userID uint64, key string, value json.RawMessage) error
+	// SetPreferences 批量设置偏好项，值为 null 时恢复默认值，任一偏好项未知或不合法时全部不生效，
+	// 返回设置后的全部偏好
+	SetPreferences(ctx context.Context, userID uint64, values Preferences) (Preferences, error)
+}
+
+type preferenceService struct {
+	srv        *domain.Service
+	repo       UserRepository
+	preference PreferenceRepository
+}
+
+func (p *preferenceService) GetPreferences(ctx context.Context, userID uint64, keys []string) (Preferences, error) {
+	for _, key := range keys {
+		if _, ok := preferenceSchema[key]; !ok {
+			return nil, ErrUnknownPreference
+		}
+	}
+	if len(keys) == 0 {
+		keys = PreferenceKeys()
+	}
+	stored, _, err := p.preference.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Preference] get preferences: %w", err)
+	}
+	return p.resolve(ctx, userID, stored, keys), nil
+}
+
+// resolve 合并保存的偏好与默认值，保存的值已不符合当前定义时使用默认值
+func (p *preferenceService) resolve(ctx context.Context, userID uint64, stored Preferences, keys []string) Preferences {
+	res := make(Preferences, len(keys))
+	for _, key := range keys {
+		spec := preferenceSchema[key]
+		res[key] = spec.Default
+		raw, ok := stored[key]
+		if !ok {
+			continue
+		}
+		value, err := spec.Normalize(raw)
+		if err != nil {
+			p.srv.Logger.WithContext(ctx).Warn("[Domain.Service.Preference] stored preference does not match schema, use default",
+				zap.Uint64("user_id", userID), zap.String("key", key))
+			continue
+		}
+		res[key] = value
+	}
+	return res
+}
+
+func (p *preferenceService) SetPreference(ctx context.Context, userID uint64, key string, value json.RawMessage) error {
+	_, err := p.SetPreferences(ctx, userID, Preferences{key: value})
+	return err
+}
+
+func (p *preferenceService) SetPreferences(ctx context.Context, userID uint64, values Preferences) (Preferences, error) {
+	changes := make(Preferences, len(values))
+	for key, raw := range values {
+		spec, ok := preferenceSchema[key]
+		if !ok {
+			return nil, ErrUnknownPreference
+		}
+		if isJSONNull(raw) {
+			changes[key] = nil
+			continue
+		}
+		value, err := spec.Normalize(raw)
+		if err != nil {
+			return nil, err
+		}
+		changes[key] = value
+	}
+	if _, err := p.repo.GetUserByID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Preference] get user by id: %w", err)
+	}
+	for attempt := 1; ; attempt++ {
+		stored, version, err := p.preference.GetPreferences(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("[Domain.Service.Preference] get preferences: %w", err)
+		}
+		if stored == nil {
+			stored = make(Preferences, len(changes))
+		}
+		for key, value := range changes {
+			if value == nil {
+				delete(stored, key)
+				continue
+			}
+			stored[key] = value
+		}
+		err = p.preference.SavePreferences(ctx, userID, stored, version)
+		if err == nil {
+			return p.resolve(ctx, userID, stored, PreferenceKeys()), nil
+		}
+		if !errors.Is(err, ErrPreferenceConflict) || attempt >= preferenceSaveAttempts {
+			return nil, fmt.Errorf("[Domain.Service.Preference] save preferences: %w", err)
+		}
+	}
+}
+
+func NewPreferenceService(srv *domain.Service, repo UserRepository, preference PreferenceRepository) PreferenceService {
+	return &preferenceService{
+		srv:        srv,
+		repo:       repo,
+		preference: preference,
+	}
+}
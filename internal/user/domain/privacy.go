@@ -0,0 +1,549 @@
+package domain
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+)
+
+// DataJobType 个人数据任务类型
+type DataJobType string
+
+const (
+	DataJobExport  DataJobType = "export"
+	DataJobErasure DataJobType = "erasure"
+)
+
+// DataJobStatus 个人数据任务状态
+type DataJobStatus string
+
+const (
+	DataJobPending   DataJobStatus = "pending"
+	DataJobRunning   DataJobStatus = "running"
+	DataJobSucceeded DataJobStatus = "succeeded"
+	DataJobFailed    DataJobStatus = "failed"
+)
+
+const (
+	// dataJobMaxAttempts 任务失败后的最大执行次数，超过后标记为失败
+	dataJobMaxAttempts = 10
+	// dataJobTimeout 执行中的任务超过该时间未更新时视为中断，由其他实例重新领取
+	dataJobTimeout   = 10 * time.Minute
+	dataJobBatchSize = 10
+	// exportFileDomain 导出文件所在的文件业务域
+	exportFileDomain = "user-export"
+	// exportFileURLExpires 导出文件中附带的文件下载地址有效期
+	exportFileURLExpires = 7 * 24 * time.Hour
+	// exportDownloadExpires 导出文件下载地址有效期
+	exportDownloadExpires = time.Hour
+)
+
+// DataJob 个人数据导出或账号注销任务，Step 记录最近完成的步骤，中断后从下一步继续
+type DataJob struct {
+	ID         uint64
+	UserID     uint64
+	Type       DataJobType
+	Status     DataJobStatus
+	Step       string
+	Attempts   int
+	FileID     uint64
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt time.Time
+	// DownloadURL 导出文件的临时下载地址，只在查询已完成的导出任务时生成
+	DownloadURL string
+}
+
+// Finished 任务是否已结束
+func (j *DataJob) Finished() bool {
+	return j.Status == DataJobSucceeded || j.Status == DataJobFailed
+}
+
+// UserFile 用户在文件服务中的文件
+type UserFile struct {
+	ID          uint64
+	Domain      string
+	Name        string
+	Size        int64
+	ContentType string
+	MD5         string
+	CreatedAt   time.Time
+	AccessURL   string
+	DownloadURL string
+}
+
+type PrivacyService interface {
+	// RequestExport 创建个人数据导出任务，已有未结束的导出任务时直接返回该任务
+	RequestExport(ctx context.Context, userID uint64) (*DataJob, error)
+	// GetExport 查询导出任务，任务已完成时附带导出文件的临时下载地址
+	GetExport(ctx context.Context, userID, jobID uint64) (*DataJob, error)
+	// RequestErasure 注销账号：立即禁止登录并吊销全部令牌，随后异步删除账号数据并解除文件关联
+	RequestErasure(ctx context.Context, userID uint64) (*DataJob, error)
+	// RunPendingJobs 执行待处理的任务，失败的任务在下次执行时从最近完成的步骤之后继续
+	RunPendingJobs(ctx context.Context) error
+}
+
+type privacyService struct {
+	srv         *domain.Service
+	repo        UserRepository
+	identity    IdentityRepository
+	pat         AccessTokenRepository
+	session     SessionRepository
+	preference  PreferenceRepository
+	job         DataJobRepository
+	file        FileClient
+	enforcer    *authz.Enforcer
+	userService UserService
+	audit       AuditRepository
+}
+
+func (p *privacyService) RequestExport(ctx context.Context, userID uint64) (*DataJob, error) {
+	if _, err := p.repo.GetUserByID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Privacy] get user by id: %w", err)
+	}
+	return p.createJob(ctx, userID, DataJobExport)
+}
+
+func (p *privacyService) GetExport(ctx context.Context, userID, jobID uint64) (*DataJob, error) {
+	job, err := p.job.GetDataJob(ctx, userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Type != DataJobExport {
+		return nil, ErrDataJobNotFound
+	}
+	if job.Status != DataJobSucceeded {
+		return job, nil
+	}
+	files, err := p.file.ListUserFiles(ctx, userID, []uint64{job.FileID}, exportDownloadExpires)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Privacy] get export file: %w", err)
+	}
+	if len(files) > 0 {
+		job.DownloadURL = files[0].DownloadURL
+	}
+	return job, nil
+}
+
+func (p *privacyService) RequestErasure(ctx context.Context, userID uint64) (*DataJob, error) {
+	if _, err := p.repo.GetUserByID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Privacy] get user by id: %w", err)
+	}
+	job, err := p.createJob(ctx, userID, DataJobErasure)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.lockAccount(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// createJob 创建任务，用户已有同类未结束的任务时返回该任务
+func (p *privacyService) createJob(ctx context.Context, userID uint64, typ DataJobType) (*DataJob, error) {
+	job, err := p.job.GetActiveDataJob(ctx, userID, typ)
+	if err == nil {
+		return job, nil
+	}
+	if !errors.Is(err, ErrDataJobNotFound) {
+		return nil, fmt.Errorf("[Domain.Service.Privacy] get active job: %w", err)
+	}
+	id, err := p.srv.Sid.GenUint64()
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Privacy] gen sid field: %w", err)
+	}
+	now := time.Now()
+	job = &DataJob{
+		ID:        id,
+		UserID:    userID,
+		Type:      typ,
+		Status:    DataJobPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := p.job.CreateDataJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Privacy] create job: %w", err)
+	}
+	return job, nil
+}
+
+func (p *privacyService) RunPendingJobs(ctx context.Context) error {
+	jobs, err := p.job.ClaimDataJobs(ctx, time.Now().Add(-dataJobTimeout), dataJobBatchSize)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] claim jobs: %w", err)
+	}
+	for _, job := range jobs {
+		p.runJob(ctx, job)
+	}
+	return nil
+}
+
+func (p *privacyService) runJob(ctx context.Context, job *DataJob) {
+	steps := exportSteps
+	if job.Type == DataJobErasure {
+		steps = erasureSteps
+	}
+	err := p.runSteps(ctx, job, steps)
+	job.UpdatedAt = time.Now()
+	switch {
+	case err == nil:
+		job.Status = DataJobSucceeded
+		job.Error = ""
+		job.FinishedAt = job.UpdatedAt
+	case job.Attempts >= dataJobMaxAttempts || errors.Is(err, ErrUserNotFound):
+		// 用户已注销时导出任务无法继续，直接结束
+		job.Status = DataJobFailed
+		job.Error = err.Error()
+		job.FinishedAt = job.UpdatedAt
+	default:
+		job.Status = DataJobPending
+		job.Error = err.Error()
+	}
+	if err != nil {
+		p.srv.Logger.WithContext(ctx).Error("[Domain.Service.Privacy] run job failed", zap.Uint64("job_id", job.ID),
+			zap.String("type", string(job.Type)), zap.String("step", job.Step), zap.Int("attempts", job.Attempts), zap.Error(err))
+	}
+	if err := p.job.UpdateDataJob(ctx, job); err != nil {
+		p.srv.Logger.WithContext(ctx).Error("[Domain.Service.Privacy] update job failed", zap.Uint64("job_id", job.ID), zap.Error(err))
+	}
+}
+
+type dataJobStep struct {
+	name string
+	run  func(p *privacyService, ctx context.Context, job *DataJob) error
+}
+
+var exportSteps = []dataJobStep{
+	{"exported", (*privacyService).exportData},
+}
+
+// erasureSteps 注销步骤，每一步都可以重复执行
+var erasureSteps = []dataJobStep{
+	{"locked", (*privacyService).lockAccount},
+	{"files_detached", (*privacyService).detachFiles},
+	{"credentials_removed", (*privacyService).removeCredentials},
+	{"audit_anonymized", (*privacyService).anonymizeAuditLogs},
+	{"profile_deleted", (*privacyService).deleteProfile},
+}
+
+// runSteps 从 job.Step 之后的步骤开始执行，每完成一步保存一次进度
+func (p *privacyService) runSteps(ctx context.Context, job *DataJob, steps []dataJobStep) error {
+	start := 0
+	for i, step := range steps {
+		if step.name == job.Step {
+			start = i + 1
+		}
+	}
+	for _, step := range steps[start:] {
+		if err := step.run(p, ctx, job); err != nil {
+			return fmt.Errorf("%s: %w", step.name, err)
+		}
+		job.Step = step.name
+		job.UpdatedAt = time.Now()
+		if err := p.job.UpdateDataJob(ctx, job); err != nil {
+			return fmt.Errorf("save progress %s: %w", step.name, err)
+		}
+	}
+	return nil
+}
+
+// lockAccount 将账号标记为已删除并吊销全部令牌
+func (p *privacyService) lockAccount(ctx context.Context, job *DataJob) error {
+	user, err := p.repo.GetUserByIDWithDeleted(ctx, job.UserID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("[Domain.Service.Privacy] get user by id: %w", err)
+	}
+	if user.Status != UserStatusDeleted {
+		if err := user.ChangeStatus(UserStatusDeleted, time.Time{}, "account erasure requested", time.Now()); err != nil {
+			return err
+		}
+		if err := p.repo.UpdateStatus(ctx, user); err != nil {
+			return fmt.Errorf("[Domain.Service.Privacy] update status: %w", err)
+		}
+	}
+	if err := p.userService.LogoutAll(ctx, job.UserID); err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] revoke user tokens: %w", err)
+	}
+	return nil
+}
+
+func (p *privacyService) detachFiles(ctx context.Context, job *DataJob) error {
+	if err := p.file.DetachUserFiles(ctx, job.UserID); err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] detach files: %w", err)
+	}
+	return nil
+}
+
+func (p *privacyService) removeCredentials(ctx context.Context, job *DataJob) error {
+	if err := p.identity.DeleteUserIdentities(ctx, job.UserID); err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] delete identities: %w", err)
+	}
+	if err := p.pat.DeleteUserAccessTokens(ctx, job.UserID); err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] delete access tokens: %w", err)
+	}
+	if err := p.session.DeleteUserDevices(ctx, job.UserID); err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] delete devices: %w", err)
+	}
+	if err := p.enforcer.RemoveUser(job.UserID); err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] remove roles: %w", err)
+	}
+	return nil
+}
+
+// anonymizeAuditLogs 清除审计日志中的 IP 与 User-Agent，安全事件本身保留
+func (p *privacyService) anonymizeAuditLogs(ctx context.Context, job *DataJob) error {
+	if err := p.audit.AnonymizeUserAuditLogs(ctx, job.UserID); err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] anonymize audit logs: %w", err)
+	}
+	return nil
+}
+
+func (p *privacyService) deleteProfile(ctx context.Context, job *DataJob) error {
+	if err := p.preference.DeletePreferences(ctx, job.UserID); err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] delete preferences: %w", err)
+	}
+	if err := p.repo.DeleteUser(ctx, job.UserID); err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] delete user: %w", err)
+	}
+	return nil
+}
+
+// exportData 汇总用户数据打包为 zip，经文件服务上传后记录文件 ID
+func (p *privacyService) exportData(ctx context.Context, job *DataJob) error {
+	user, err := p.repo.GetUserByID(ctx, job.UserID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] get user by id: %w", err)
+	}
+	identities, err := p.identity.ListIdentities(ctx, job.UserID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] list identities: %w", err)
+	}
+	tokens, err := p.pat.ListAccessTokens(ctx, job.UserID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] list access tokens: %w", err)
+	}
+	files, err := p.file.ListUserFiles(ctx, job.UserID, nil, exportFileURLExpires)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] list files: %w", err)
+	}
+	prefs, _, err := p.preference.GetPreferences(ctx, job.UserID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] get preferences: %w", err)
+	}
+	if prefs == nil {
+		prefs = Preferences{}
+	}
+	logs, err := p.audit.ListUserAuditLogs(ctx, job.UserID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] list audit logs: %w", err)
+	}
+	archive, err := buildExportArchive(user, identities, tokens, p.enforcer.RolesForUser(job.UserID, authz.DomainAll), files, prefs, logs)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] build archive: %w", err)
+	}
+	name := fmt.Sprintf("export-%d-%s.zip", job.UserID, time.Now().Format("20060102150405"))
+	fileID, err := p.file.UploadUserFile(ctx, job.UserID, exportFileDomain, name, "application/zip", archive)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Privacy] upload archive: %w", err)
+	}
+	job.FileID = fileID
+	return nil
+}
+
+type exportProfile struct {
+	UserID        uint64    `json:"user_id"`
+	Username      string    `json:"username"`
+	Nickname      string    `json:"nickname"`
+	AvatarURL     string    `json:"avatar_url,omitempty"`
+	BackgroundURL string    `json:"background_url,omitempty"`
+	Signature     string    `json:"signature,omitempty"`
+	Gender        Gender    `json:"gender"`
+	Email         string    `json:"email,omitempty"`
+	EmailVerified bool      `json:"email_verified"`
+	Phone         string    `json:"phone,omitempty"`
+	PhoneVerified bool      `json:"phone_verified"`
+	TOTPEnabled   bool      `json:"totp_enabled"`
+	Status        string    `json:"status"`
+	Roles         []string  `json:"roles"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type exportIdentity struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type exportAccessToken struct {
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+type exportFile struct {
+	FileID      uint64    `json:"file_id"`
+	Domain      string    `json:"domain"`
+	Name        string    `json:"name"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"content_type"`
+	MD5         string    `json:"md5"`
+	CreatedAt   time.Time `json:"created_at"`
+	DownloadURL string    `json:"download_url"`
+}
+
+type exportAuditLog struct {
+	Action    string    `json:"action"`
+	Outcome   string    `json:"outcome"`
+	Reason    string    `json:"reason,omitempty"`
+	Detail    string    `json:"detail,omitempty"`
+	IP        string    `json:"ip,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// buildExportArchive 生成导出压缩包，不包含密码哈希、二次验证密钥等凭据
+func buildExportArchive(user *User, identities []*Identity, tokens []*AccessToken, roles []string, files []*UserFile, prefs Preferences, logs []*AuditLog) ([]byte, error) {
+	profile := exportProfile{
+		UserID:        user.ID,
+		Username:      user.Username.String(),
+		Nickname:      user.Nickname.String(),
+		AvatarURL:     user.AvatarURL,
+		BackgroundURL: user.BackgroundURL,
+		Signature:     user.Signature,
+		Gender:        user.Gender,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+		Phone:         user.Phone,
+		PhoneVerified: user.PhoneVerified,
+		TOTPEnabled:   user.TOTP.Enabled,
+		Status:        string(user.StatusAt(time.Now())),
+		Roles:         roles,
+		CreatedAt:     user.CreatedAt,
+	}
+	exportIdentities := make([]exportIdentity, 0, len(identities))
+	for _, i := range identities {
+		exportIdentities = append(exportIdentities, exportIdentity{
+			Provider:  i.Provider,
+			Subject:   i.Subject,
+			Email:     i.Email,
+			CreatedAt: i.CreatedAt,
+		})
+	}
+	exportTokens := make([]exportAccessToken, 0, len(tokens))
+	for _, t := range tokens {
+		et := exportAccessToken{
+			Name:      t.Name,
+			Prefix:    t.Prefix,
+			Scopes:    t.Scopes,
+			CreatedAt: t.CreatedAt,
+		}
+		if !t.ExpiresAt.IsZero() {
+			et.ExpiresAt = &t.ExpiresAt
+		}
+		if !t.LastUsedAt.IsZero() {
+			et.LastUsedAt = &t.LastUsedAt
+		}
+		exportTokens = append(exportTokens, et)
+	}
+	exportFiles := make([]exportFile, 0, len(files))
+	for _, f := range files {
+		if f.Domain == exportFileDomain {
+			continue
+		}
+		exportFiles = append(exportFiles, exportFile{
+			FileID:      f.ID,
+			Domain:      f.Domain,
+			Name:        f.Name,
+			Size:        f.Size,
+			ContentType: f.ContentType,
+			MD5:         f.MD5,
+			CreatedAt:   f.CreatedAt,
+			DownloadURL: f.DownloadURL,
+		})
+	}
+	exportLogs := make([]exportAuditLog, 0, len(logs))
+	for _, l := range logs {
+		exportLogs = append(exportLogs, exportAuditLog{
+			Action:    string(l.Action),
+			Outcome:   string(l.Outcome),
+			Reason:    l.Reason,
+			Detail:    l.Detail,
+			IP:        l.IP,
+			UserAgent: l.UserAgent,
+			CreatedAt: l.CreatedAt,
+		})
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, entry := range []struct {
+		name string
+		data any
+	}{
+		{"profile.json", profile},
+		{"identities.json", exportIdentities},
+		{"access_tokens.json", exportTokens},
+		{"files.json", exportFiles},
+		{"preferences.json", prefs},
+		{"audit_logs.json", exportLogs},
+	} {
+		w, err := zw.Create(entry.name)
+		if err != nil {
+			return nil, err
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(entry.data); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func NewPrivacyService(
+	srv *domain.Service,
+	repo UserRepository,
+	identity IdentityRepository,
+	pat AccessTokenRepository,
+	session SessionRepository,
+	preference PreferenceRepository,
+	job DataJobRepository,
+	file FileClient,
+	enforcer *authz.Enforcer,
+	userService UserService,
+	audit AuditRepository,
+) PrivacyService {
+	return &privacyService{
+		srv:         srv,
+		repo:        repo,
+		identity:    identity,
+		pat:         pat,
+		session:     session,
+		preference:  preference,
+		job:         job,
+		file:        file,
+		enforcer:    enforcer,
+		userService: userService,
+		audit:       audit,
+	}
+}
@@ -0,0 +1,250 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ProfileField 支持部分更新的资料字段，取值与接口中的字段名一致
+type ProfileField string
+
+const (
+	ProfileNickname         ProfileField = "nickname"
+	ProfileAvatarFileID     ProfileField = "avatar_file_id"
+	ProfileBackgroundFileID ProfileField = "background_file_id"
+	ProfileSignature        ProfileField = "signature"
+	ProfileEmail            ProfileField = "email"
+	ProfilePhone            ProfileField = "phone"
+	ProfileGender           ProfileField = "gender"
+)
+
+var profileFields = []ProfileField{
+	ProfileNickname,
+	ProfileAvatarFileID,
+	ProfileBackgroundFileID,
+	ProfileSignature,
+	ProfileEmail,
+	ProfilePhone,
+	ProfileGender,
+}
+
+const (
+	maxNicknameLen  = 64
+	maxSignatureLen = 255
+	// profileImageDomain 头像与背景图在文件服务中的业务域
+	profileImageDomain  = "user-profile"
+	maxProfileImageSize = 5 << 20
+)
+
+// profileImageTypes 允许作为头像与背景图的文件类型
+var profileImageTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+
+// ProfileUpdate 资料部分更新，只修改 Mask 中列出的字段，其余字段的值被忽略
+type ProfileUpdate struct {
+	UserID   uint64
+	Mask     []ProfileField
+	Nickname string
+	// AvatarFileID 与 BackgroundFileID 为文件服务中的文件 ID，为 0 表示清空
+	AvatarFileID     uint64
+	BackgroundFileID uint64
+	Signature        string
+	Email            string
+	Phone            string
+	Gender           Gender
+	// UpdatedAt 客户端读取资料时的更新时间，非零时与当前值不一致说明资料已被修改，拒绝更新
+	UpdatedAt time.Time
+	// avatarURL 与 backgroundURL 为校验图片文件时得到的访问地址，随文件 ID 一起保存
+	avatarURL     string
+	backgroundURL string
+}
+
+// NewProfileMask 解析字段掩码，去除重复字段，字段为空或不支持时返回 ErrInvalidUpdateMask
+func NewProfileMask(paths []string) ([]ProfileField, error) {
+	if len(paths) == 0 {
+		return nil, ErrInvalidUpdateMask
+	}
+	mask := make([]ProfileField, 0, len(paths))
+	for _, p := range paths {
+		f := ProfileField(strings.TrimSpace(p))
+		if !slices.Contains(profileFields, f) {
+			return nil, ErrInvalidUpdateMask
+		}
+		if !slices.Contains(mask, f) {
+			mask = append(mask, f)
+		}
+	}
+	return mask, nil
+}
+
+// Has 判断字段是否在掩码中
+func (p *ProfileUpdate) Has(f ProfileField) bool {
+	return slices.Contains(p.Mask, f)
+}
+
+// Validate 规范化并校验掩码中的字段，邮箱与手机号可以置空，图片文件由 checkProfileImage 另行校验
+func (p *ProfileUpdate) Validate() error {
+	if len(p.Mask) == 0 {
+		return ErrInvalidUpdateMask
+	}
+	if p.Has(ProfileNickname) {
+		p.Nickname = strings.TrimSpace(p.Nickname)
+		if n := utf8.RuneCountInString(p.Nickname); n == 0 || n > maxNicknameLen {
+			return ErrInvalidNickname
+		}
+	}
+	if p.Has(ProfileSignature) && utf8.RuneCountInString(p.Signature) > maxSignatureLen {
+		return ErrInvalidSignature
+	}
+	if p.Has(ProfileEmail) {
+		p.Email = NormalizeEmail(p.Email)
+		if p.Email != "" {
+			if err := ValidateEmail(p.Email); err != nil {
+				return err
+			}
+		}
+	}
+	if p.Has(ProfilePhone) {
+		p.Phone = strings.TrimSpace(p.Phone)
+		if p.Phone != "" {
+			phone, err := NormalizePhone(p.Phone)
+			if err != nil {
+				return err
+			}
+			p.Phone = phone
+		}
+	}
+	if p.Has(ProfileGender) && (p.Gender < GenderUnknown || p.Gender > GenderFemale) {
+		return ErrInvalidGender
+	}
+	return nil
+}
+
+// ApplyProfile 将掩码中的字段写入用户，修改邮箱或手机号后需要重新验证
+func (u *User) ApplyProfile(p *ProfileUpdate) {
+	if p.Has(ProfileNickname) {
+		u.Nickname = NewUsername(p.Nickname)
+	}
+	if p.Has(ProfileAvatarFileID) {
+		u.AvatarFileID = p.AvatarFileID
+		u.AvatarURL = p.avatarURL
+	}
+	if p.Has(ProfileBackgroundFileID) {
+		u.BackgroundFileID = p.BackgroundFileID
+		u.BackgroundURL = p.backgroundURL
+	}
+	if p.Has(ProfileSignature) {
+		u.Signature = p.Signature
+	}
+	if p.Has(ProfileEmail) && p.Email != u.Email {
+		u.Email = p.Email
+		u.EmailVerified = false
+	}
+	if p.Has(ProfilePhone) && p.Phone != u.Phone {
+		u.Phone = p.Phone
+		u.PhoneVerified = false
+	}
+	if p.Has(ProfileGender) {
+		u.Gender = p.Gender
+	}
+}
+
+// nextUpdatedAt 生成新的更新时间，数据库只保存到秒，
+// 同一秒内多次更新时顺延一秒，保证每次更新后的值都不同
+func nextUpdatedAt(prev, now time.Time) time.Time {
+	next := now.Truncate(time.Second)
+	if !next.After(prev) {
+		next = prev.Truncate(time.Second).Add(time.Second)
+	}
+	return next
+}
+
+// profileImageURLExpires 查询资料图片时附带的下载地址有效期，资料中只保存访问地址，下载地址不会被使用
+const profileImageURLExpires = time.Minute
+
+// getProfileImage 查询用户关联的已上传文件，用户没有关联该文件或文件未上传完成时返回 ErrInvalidProfileImage
+func getProfileImage(ctx context.Context, file FileClient, userID, fileID uint64) (*UserFile, error) {
+	files, err := file.ListUserFiles(ctx, userID, []uint64{fileID}, profileImageURLExpires)
+	if err != nil {
+		return nil, fmt.Errorf("list user files: %w", err)
+	}
+	for _, f := range files {
+		if f.ID == fileID {
+			return f, nil
+		}
+	}
+	return nil, ErrInvalidProfileImage
+}
+
+// checkProfileImage 校验文件属于用户且已上传到资料图片业务域，类型与大小符合要求，
+// 返回保存到资料中的访问地址，fileID 为 0 表示清空
+func checkProfileImage(ctx context.Context, file FileClient, userID, fileID uint64) (string, error) {
+	if fileID == 0 {
+		return "", nil
+	}
+	f, err := getProfileImage(ctx, file, userID, fileID)
+	if err != nil {
+		return "", err
+	}
+	if f.Domain != profileImageDomain || f.Size > maxProfileImageSize || !slices.Contains(profileImageTypes, f.ContentType) {
+		return "", ErrInvalidProfileImage
+	}
+	return f.AccessURL, nil
+}
+
+// profileImageIDs 返回资料引用的图片文件 ID
+func profileImageIDs(user *User) []uint64 {
+	var ids []uint64
+	for _, id := range []uint64{user.AvatarFileID, user.BackgroundFileID} {
+		if id != 0 && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// pinProfileImages 引用 ids 中不在 prev 中的图片，资料引用的图片不能被用户删除后回收
+func pinProfileImages(ctx context.Context, file FileClient, userID uint64, prev, ids []uint64) error {
+	for _, id := range ids {
+		if slices.Contains(prev, id) {
+			continue
+		}
+		found, err := file.PinUserFile(ctx, userID, id, true)
+		if err != nil {
+			return fmt.Errorf("pin file %d: %w", id, err)
+		}
+		if !found {
+			return ErrInvalidProfileImage
+		}
+	}
+	return nil
+}
+
+// uploadProfileImage 按文件内容识别类型并上传到资料图片业务域，不信任客户端声明的类型
+func uploadProfileImage(ctx context.Context, file FileClient, userID uint64, name string, data []byte) (*UserFile, error) {
+	if len(data) == 0 || len(data) > maxProfileImageSize {
+		return nil, ErrInvalidProfileImage
+	}
+	contentType := http.DetectContentType(data)
+	if !slices.Contains(profileImageTypes, contentType) {
+		return nil, ErrInvalidProfileImage
+	}
+	name = filepath.Base(strings.TrimSpace(name))
+	if name == "." || name == string(filepath.Separator) || utf8.RuneCountInString(name) > maxNicknameLen {
+		name = "image"
+	}
+	id, err := file.UploadUserFile(ctx, userID, profileImageDomain, name, contentType, data)
+	if err != nil {
+		return nil, fmt.Errorf("upload file: %w", err)
+	}
+	f, err := getProfileImage(ctx, file, userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("get uploaded file: %w", err)
+	}
+	return f, nil
+}
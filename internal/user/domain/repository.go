@@ -1,10 +1,145 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
 
 type UserRepository interface {
+	// CreateUser 创建用户，用户名已被占用时返回 ErrUserAlreadyExists
 	CreateUser(ctx context.Context, user *User) (*User, error)
-	UpdateUser(ctx context.Context, user *User) (*User, error)
+	// UpdateProfile 只写入 mask 中的字段和 user.UpdatedAt，当前更新时间不等于 prev 时返回 ErrUpdateConflict
+	UpdateProfile(ctx context.Context, user *User, mask []ProfileField, prev time.Time) error
+	UpdatePassword(ctx context.Context, id uint64, password Password) error
+	// VerifyEmail 将用户邮箱设置为 email 并标记为已验证
+	VerifyEmail(ctx context.Context, id uint64, email string, at time.Time) error
+	// GetUserByID 查询用户，结果会被缓存，不包含密码哈希、TOTP 密钥与恢复码，账号状态可能滞后于数据库，
+	// 校验账号状态或凭证时使用 GetUserWithCredentials
 	GetUserByID(ctx context.Context, id uint64) (*User, error)
+	// GetUserWithCredentials 与 GetUserByID 相同，但不经过缓存并包含全部凭证字段
+	GetUserWithCredentials(ctx context.Context, id uint64) (*User, error)
+	// GetUserByIDWithDeleted 与 GetUserByID 相同，但包含已软删除的账号
+	GetUserByIDWithDeleted(ctx context.Context, id uint64) (*User, error)
+	// UpdateStatus 保存账号状态，状态为 UserStatusDeleted 时软删除账号，变更为其他状态时取消软删除
+	UpdateStatus(ctx context.Context, user *User) error
+	// DeleteUser 永久删除账号，账号不存在时不返回错误
+	DeleteUser(ctx context.Context, id uint64) error
 	GetUser(ctx context.Context, user *User) (*User, error)
+	// ListUsers 按条件分页查询用户，返回当前页用户与满足条件的总数
+	ListUsers(ctx context.Context, filter UserFilter, sort UserSort, p page.Page) ([]*User, int64, error)
+	// GetUserByVerifiedEmail 查询已验证 email 的用户，未验证该邮箱的用户不会被返回
+	GetUserByVerifiedEmail(ctx context.Context, email string) (*User, error)
+	// GetUserByVerifiedPhone 查询已验证手机号 phone 的用户，phone 为 E.164 格式
+	GetUserByVerifiedPhone(ctx context.Context, phone string) (*User, error)
+	// ReleasePendingPhone 清除其他账号上尚未验证的手机号 phone，用于验证码证明手机号归属后
+	ReleasePendingPhone(ctx context.Context, phone string) error
+	UpdateTOTP(ctx context.Context, id uint64, totp TOTP) error
+	// ConsumeRecoveryCode 删除一个未使用的恢复码，恢复码不存在或已被并发使用时返回 false
+	ConsumeRecoveryCode(ctx context.Context, id uint64, hash string) (bool, error)
+}
+
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session *Session) error
+	// RotateSession 当会话当前的刷新令牌为 session.RefreshID 时将其替换为 refreshID，
+	// 同时更新最近使用时间与非空的客户端 IP，
+	// 会话不存在返回 ErrSessionNotFound，刷新令牌不匹配返回 ErrRefreshTokenReused
+	RotateSession(ctx context.Context, session *Session, refreshID string) error
+	GetSession(ctx context.Context, id string) (*Session, error)
+	DeleteSession(ctx context.Context, id string) error
+	DeleteUserSessions(ctx context.Context, userID uint64) error
+	// ListSessions 查询用户未过期的会话
+	ListSessions(ctx context.Context, userID uint64) ([]*Session, error)
+	// RememberDevice 记录用户登录过的设备，device 为客户端的设备ID，设备此前未出现且用户已有其他设备时返回 true
+	RememberDevice(ctx context.Context, userID uint64, device string) (bool, error)
+	DeleteUserDevices(ctx context.Context, userID uint64) error
+}
+
+type CodeRepository interface {
+	// SaveCode 保存验证码并覆盖同一接收方的旧验证码，冷却期内或超过每小时上限时返回 ErrCodeTooFrequent
+	SaveCode(ctx context.Context, code *Code) error
+	// VerifyCode 校验并消费验证码，失败次数达到上限后验证码作废，校验失败返回 ErrInvalidCode
+	VerifyCode(ctx context.Context, purpose CodePurpose, target, value string) error
+}
+
+type IdentityRepository interface {
+	CreateIdentity(ctx context.Context, identity *Identity) error
+	// GetIdentity 查询第三方身份，未绑定时返回 ErrIdentityNotFound
+	GetIdentity(ctx context.Context, provider, subject string) (*Identity, error)
+	ListIdentities(ctx context.Context, userID uint64) ([]*Identity, error)
+	// DeleteIdentity 解绑用户在 provider 下的身份，未绑定时返回 ErrIdentityNotFound
+	DeleteIdentity(ctx context.Context, userID uint64, provider string) error
+	DeleteUserIdentities(ctx context.Context, userID uint64) error
+}
+
+type OAuthStateRepository interface {
+	SaveState(ctx context.Context, state *OAuthState, ttl time.Duration) error
+	// TakeState 读取并删除授权请求，state 只能使用一次，不存在时返回 ErrInvalidOAuthState
+	TakeState(ctx context.Context, state string) (*OAuthState, error)
+}
+
+type MFARepository interface {
+	// UseTOTPCode 记录已使用的 TOTP 验证码，同一验证码在有效期内再次使用时返回 false
+	UseTOTPCode(ctx context.Context, userID uint64, code string, ttl time.Duration) (bool, error)
+	// FailChallenge 记录一次挑战令牌校验失败，返回累计失败次数
+	FailChallenge(ctx context.Context, challengeID string, ttl time.Duration) (int64, error)
+	// ChallengeFailures 返回挑战令牌当前累计的失败次数
+	ChallengeFailures(ctx context.Context, challengeID string) (int64, error)
+	// ConsumeChallenge 标记挑战令牌已使用，重复使用时返回 false
+	ConsumeChallenge(ctx context.Context, challengeID string, ttl time.Duration) (bool, error)
+}
+
+type AccessTokenRepository interface {
+	CreateAccessToken(ctx context.Context, token *AccessToken) error
+	ListAccessTokens(ctx context.Context, userID uint64) ([]*AccessToken, error)
+	CountAccessTokens(ctx context.Context, userID uint64) (int64, error)
+	// GetAccessTokenByHash 按令牌哈希查询，不存在时返回 ErrInvalidAccessToken
+	GetAccessTokenByHash(ctx context.Context, hash string) (*AccessToken, error)
+	// DeleteAccessToken 删除用户的令牌，不存在时返回 ErrAccessTokenNotFound
+	DeleteAccessToken(ctx context.Context, userID, id uint64) error
+	TouchAccessToken(ctx context.Context, id uint64, at time.Time) error
+	DeleteUserAccessTokens(ctx context.Context, userID uint64) error
+}
+
+type DataJobRepository interface {
+	CreateDataJob(ctx context.Context, job *DataJob) error
+	// GetDataJob 查询用户的任务，不存在时返回 ErrDataJobNotFound
+	GetDataJob(ctx context.Context, userID, id uint64) (*DataJob, error)
+	// GetActiveDataJob 查询用户未结束的 typ 类型任务，不存在时返回 ErrDataJobNotFound
+	GetActiveDataJob(ctx context.Context, userID uint64, typ DataJobType) (*DataJob, error)
+	// ClaimDataJobs 领取待执行的任务以及 staleBefore 之前中断的任务，领取后状态为执行中且执行次数加一
+	ClaimDataJobs(ctx context.Context, staleBefore time.Time, limit int) ([]*DataJob, error)
+	UpdateDataJob(ctx context.Context, job *DataJob) error
+}
+
+type AuditRepository interface {
+	// AppendAuditLog 追加审计日志，异步批量写入，不等待落库
+	AppendAuditLog(ctx context.Context, log *AuditLog)
+	// ListAuditLogs 按条件分页查询审计日志，按时间倒序返回当前页与满足条件的总数
+	ListAuditLogs(ctx context.Context, filter AuditFilter, p page.Page) ([]*AuditLog, int64, error)
+	// ListUserAuditLogs 查询用户的全部审计日志，按时间顺序返回，用于数据导出
+	ListUserAuditLogs(ctx context.Context, userID uint64) ([]*AuditLog, error)
+	// AnonymizeUserAuditLogs 清除用户作为事件所属用户或操作人的审计日志中的 IP 与 User-Agent，用于注销账号，可以重复调用
+	AnonymizeUserAuditLogs(ctx context.Context, userID uint64) error
+}
+
+// FileClient 文件服务中与用户相关的操作
+type FileClient interface {
+	// ListUserFiles 查询用户的已上传文件及有效期为 expires 的下载地址，fileIDs 非空时只返回其中的文件
+	ListUserFiles(ctx context.Context, userID uint64, fileIDs []uint64, expires time.Duration) ([]*UserFile, error)
+	// UploadUserFile 经文件服务上传文件并关联到用户，返回文件 ID
+	UploadUserFile(ctx context.Context, userID uint64, domain, name, contentType string, data []byte) (uint64, error)
+	// DetachUserFiles 解除用户与全部文件的关联，可以重复调用
+	DetachUserFiles(ctx context.Context, userID uint64) error
+	// PinUserFile 设置用户的文件是否被资料引用，被引用的文件不能删除，返回用户是否关联该文件
+	PinUserFile(ctx context.Context, userID, fileID uint64, pinned bool) (bool, error)
+}
+
+type PreferenceRepository interface {
+	// GetPreferences 查询用户保存的偏好与版本号，未保存过偏好时返回空偏好与版本号 0
+	GetPreferences(ctx context.Context, userID uint64) (Preferences, int64, error)
+	// SavePreferences 当保存的版本号仍为 version 时写入偏好并递增版本号，否则返回 ErrPreferenceConflict
+	SavePreferences(ctx context.Context, userID uint64, prefs Preferences, version int64) error
+	DeletePreferences(ctx context.Context, userID uint64) error
 }
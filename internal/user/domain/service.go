@@ -2,9 +2,21 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+	"github.com/Wenrh2004/lark-lite-server/pkg/clientinfo"
 	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/hasher"
+	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mail"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mfa"
+	"github.com/Wenrh2004/lark-lite-server/pkg/sms"
 )
 
 type UserService interface {
@@ -12,43 +24,404 @@ type UserService interface {
 	Register(ctx context.Context, user *User) (*User, error)
 	GetUserByID(ctx context.Context, id uint64) (*User, error)
 	GetUser(ctx context.Context, user *User) (*User, error)
-	UpdateUser(ctx context.Context, user *User) (*User, error)
+	// UpdateUser 按字段掩码部分更新资料，资料在读取后已被修改时返回 ErrUpdateConflict
+	UpdateUser(ctx context.Context, update *ProfileUpdate) (*User, error)
+	// UploadProfileImage 上传头像或背景图，返回的文件 ID 用于 UpdateUser
+	UploadProfileImage(ctx context.Context, userID uint64, name string, data []byte) (*UserFile, error)
+	Refresh(ctx context.Context, refreshToken string) (*CertificatePair, error)
+	Logout(ctx context.Context, accessToken, refreshToken string) error
+	LogoutAll(ctx context.Context, userID uint64) error
+	// SendPhoneCode 向手机号发送登录验证码
+	SendPhoneCode(ctx context.Context, phone string) error
+	// LoginByPhone 使用手机号与验证码登录，手机号未注册时自动注册
+	LoginByPhone(ctx context.Context, phone, code string) (*User, error)
+	// SignIn 为已通过认证的用户开启会话并签发令牌，用户启用二次验证时只返回挑战令牌，
+	// 结果以登录方式 method 记录到审计日志
+	SignIn(ctx context.Context, user *User, method string) (*User, error)
+	// VerifyMFA 使用挑战令牌与 TOTP 验证码或恢复码完成登录
+	VerifyMFA(ctx context.Context, mfaToken, code string) (*User, error)
 }
 
 type userService struct {
-	srv  *domain.Service
-	repo UserRepository
+	srv        *domain.Service
+	repo       UserRepository
+	session    SessionRepository
+	revocation jwt.Revocation
+	hasher     hasher.Hasher
+	code       CodeRepository
+	sms        sms.Sender
+	mail       mail.Sender
+	mfa        MFARepository
+	totp       *mfa.TOTP
+	enforcer   *authz.Enforcer
+	file       FileClient
+	audit      AuditService
+	// dummyHash 用于用户不存在时执行一次等价的哈希校验，避免通过响应时间枚举用户名
+	dummyHash Password
 }
 
 func (u *userService) Login(ctx context.Context, user *User) (*User, error) {
-	res, err := u.repo.GetUserByID(ctx, user.ID)
+	method := "password:" + user.Username.String()
+	res, err := u.checkPassword(ctx, user)
+	if err != nil {
+		var userID uint64
+		if res != nil {
+			userID = res.ID
+		}
+		u.audit.Record(ctx, NewAuditLog(AuditLogin, userID, method, err))
+		return nil, err
+	}
+	return u.SignIn(ctx, res, method)
+}
+
+// checkPassword 校验用户名与密码，用户存在但密码错误时同时返回该用户，用于记录审计日志
+func (u *userService) checkPassword(ctx context.Context, user *User) (*User, error) {
+	res, err := u.repo.GetUser(ctx, &User{Username: user.Username})
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			_, _, _ = u.dummyHash.Compare(u.hasher, user.Password)
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("[Domain.Service.User] get user by username: %w", err)
+	}
+	if !res.Password.IsSet() {
+		_, _, _ = u.dummyHash.Compare(u.hasher, user.Password)
+		return res, ErrInvalidCredentials
+	}
+	ok, rehash, err := res.Password.Compare(u.hasher, user.Password)
+	if err != nil {
+		if errors.Is(err, hasher.ErrUnknownHash) {
+			// 历史遗留的明文或损坏的哈希值无法校验，按密码错误处理，用户需通过找回密码重置
+			u.srv.Logger.WithContext(ctx).Warn("[Domain.Service.User] unknown password hash", zap.Uint64("user_id", res.ID), zap.Error(err))
+			return res, ErrInvalidCredentials
+		}
+		return res, fmt.Errorf("[Domain.Service.User] verify password: %w", err)
+	}
+	if !ok {
+		return res, ErrInvalidCredentials
+	}
+	if rehash {
+		u.rehashPassword(ctx, res.ID, user.Password)
+	}
+	return res, nil
+}
+
+func (u *userService) SignIn(ctx context.Context, user *User, method string) (res *User, err error) {
+	log := NewAuditLog(AuditLogin, user.ID, method, nil)
+	defer func() {
+		if err != nil {
+			log.Outcome, log.Reason = AuditFailure, err.Error()
+		}
+		u.audit.Record(ctx, log)
+	}()
+	if err := user.CheckActive(time.Now()); err != nil {
+		return nil, err
+	}
+	if user.TOTP.Enabled {
+		token, _, expiresAt, err := u.srv.Jwt.GenMFAToken(user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("[Domain.Service.User] generate mfa token: %w", err)
+		}
+		user.MFAChallenge = &Certificate{
+			Token:     token,
+			ExpiresIn: int64(time.Until(expiresAt).Seconds()),
+		}
+		log.Outcome = AuditMFARequired
+		return user, nil
+	}
+	pair, err := u.startSession(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	user.TokenPair = pair
+	return user, nil
+}
+
+func (u *userService) VerifyMFA(ctx context.Context, mfaToken, code string) (res *User, err error) {
+	var userID uint64
+	defer func() {
+		u.audit.Record(ctx, NewAuditLog(AuditLogin, userID, "mfa", err))
+	}()
+	claims, err := u.srv.Jwt.ParseToken(mfaToken)
+	if err != nil || claims.TokenType != jwt.TokenTypeMFA {
+		return nil, ErrInvalidMFAToken
+	}
+	userID = claims.UserId
+	revoked, err := u.revocation.IsRevoked(ctx, claims)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] check mfa token revocation: %w", err)
+	}
+	if revoked {
+		return nil, ErrInvalidMFAToken
+	}
+	ttl := time.Until(claims.ExpiresAt.Time)
+	user, err := u.repo.GetUserWithCredentials(ctx, claims.UserId)
 	if err != nil {
 		return nil, fmt.Errorf("[Domain.Service.User] get user by id: %w", err)
 	}
-	ack, rfk, err := u.srv.Jwt.GenTokenPair(res.ID)
+	if err := user.CheckActive(time.Now()); err != nil {
+		return nil, err
+	}
+	if !user.TOTP.Enabled {
+		return nil, ErrInvalidMFAToken
+	}
+	if err := verifySecondFactor(ctx, u.repo, u.mfa, u.totp, user, code); err != nil {
+		if !errors.Is(err, ErrInvalidMFACode) {
+			return nil, err
+		}
+		failures, ferr := u.mfa.FailChallenge(ctx, claims.ID, ttl)
+		if ferr != nil {
+			return nil, fmt.Errorf("[Domain.Service.User] record mfa failure: %w", ferr)
+		}
+		if failures >= mfaMaxAttempts {
+			if err := u.revocation.Revoke(ctx, claims); err != nil {
+				return nil, fmt.Errorf("[Domain.Service.User] revoke mfa token: %w", err)
+			}
+			return nil, ErrMFATooManyAttempts
+		}
+		return nil, err
+	}
+	fresh, err := u.mfa.ConsumeChallenge(ctx, claims.ID, ttl)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] consume mfa token: %w", err)
+	}
+	if !fresh {
+		return nil, ErrInvalidMFAToken
+	}
+	user.TokenPair, err = u.startSession(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// startSession 开启新的刷新令牌族并签发首个令牌对，会话来自新设备时发送登录提醒
+func (u *userService) startSession(ctx context.Context, user *User) (*CertificatePair, error) {
+	sessionID, err := jwt.NewSessionID()
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] generate session id: %w", err)
+	}
+	pair, err := u.srv.Jwt.GenTokenPair(user.ID, sessionID, u.enforcer.RolesForUser(user.ID, authz.DomainAll))
 	if err != nil {
 		return nil, fmt.Errorf("[Domain.Service.User] generate token pair: %w", err)
 	}
-	res.TokenPair = NewCertificatePair(ack, rfk, u.srv.Jwt.GetAckExpires(), u.srv.Jwt.GetRefreshExpires())
-	return res, nil
+	session := newSession(ctx, sessionID, user.ID, pair.RefreshID, pair.RefreshExpiresAt)
+	if err := u.session.CreateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] create session: %w", err)
+	}
+	notifyNewDevice(ctx, u.srv, u.session, u.mail, user, session)
+	return NewCertificatePair(pair.AccessToken, pair.RefreshToken, u.srv.Jwt.GetAckExpires(), u.srv.Jwt.GetRefreshExpires()), nil
+}
+
+// Refresh 轮换刷新令牌：签发新的令牌对并使旧刷新令牌失效，
+// 已被轮换过的刷新令牌再次出现时视为泄露，吊销整个令牌族
+func (u *userService) Refresh(ctx context.Context, refreshToken string) (res *CertificatePair, err error) {
+	var userID uint64
+	defer func() {
+		u.audit.Record(ctx, NewAuditLog(AuditRefresh, userID, "", err))
+	}()
+	claims, err := u.srv.Jwt.ParseToken(refreshToken)
+	if err != nil || claims.TokenType != jwt.TokenTypeRefresh || claims.SessionID == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+	userID = claims.UserId
+	user, err := u.repo.GetUserWithCredentials(ctx, claims.UserId)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("[Domain.Service.User] get user by id: %w", err)
+	}
+	if err := user.CheckActive(time.Now()); err != nil {
+		return nil, err
+	}
+	// 每次刷新时重新读取角色，角色变更在下一次刷新后生效
+	pair, err := u.srv.Jwt.GenTokenPair(claims.UserId, claims.SessionID, u.enforcer.RolesForUser(claims.UserId, authz.DomainAll))
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] generate token pair: %w", err)
+	}
+	err = u.session.RotateSession(ctx, &Session{
+		ID:         claims.SessionID,
+		UserID:     claims.UserId,
+		RefreshID:  claims.ID,
+		ExpiresAt:  pair.RefreshExpiresAt,
+		IP:         clientinfo.FromContext(ctx).IP,
+		LastSeenAt: time.Now(),
+	}, pair.RefreshID)
+	if err != nil {
+		if errors.Is(err, ErrRefreshTokenReused) {
+			u.srv.Logger.WithContext(ctx).Warn("[Domain.Service.User] refresh token reused, revoke session",
+				zap.Uint64("user_id", claims.UserId), zap.String("session_id", claims.SessionID))
+			// 先吊销令牌族已签发的访问令牌，再删除会话使刷新令牌失效
+			if err := u.revocation.RevokeSession(ctx, claims.SessionID); err != nil {
+				return nil, fmt.Errorf("[Domain.Service.User] revoke session tokens: %w", err)
+			}
+			if err := u.session.DeleteSession(ctx, claims.SessionID); err != nil {
+				return nil, fmt.Errorf("[Domain.Service.User] revoke session: %w", err)
+			}
+			return nil, ErrRefreshTokenReused
+		}
+		return nil, fmt.Errorf("[Domain.Service.User] rotate session: %w", err)
+	}
+	return NewCertificatePair(pair.AccessToken, pair.RefreshToken, u.srv.Jwt.GetAckExpires(), u.srv.Jwt.GetRefreshExpires()), nil
+}
+
+// rehashPassword 使用当前哈希参数重新生成密码哈希，失败不影响登录
+func (u *userService) rehashPassword(ctx context.Context, id uint64, plain Password) {
+	if err := plain.Encrypt(u.hasher); err != nil {
+		u.srv.Logger.WithContext(ctx).Warn("[Domain.Service.User] rehash password failed", zap.Uint64("user_id", id), zap.Error(err))
+		return
+	}
+	if err := u.repo.UpdatePassword(ctx, id, plain); err != nil {
+		u.srv.Logger.WithContext(ctx).Warn("[Domain.Service.User] update rehashed password failed", zap.Uint64("user_id", id), zap.Error(err))
+	}
 }
 
 func (u *userService) Register(ctx context.Context, user *User) (*User, error) {
+	res, err := u.register(ctx, user)
+	u.audit.Record(ctx, NewAuditLog(AuditRegister, user.ID, "password:"+user.Username.String(), err))
+	return res, err
+}
+
+func (u *userService) register(ctx context.Context, user *User) (*User, error) {
+	if err := user.Password.Validate(); err != nil {
+		return nil, err
+	}
+	if err := user.Password.Encrypt(u.hasher); err != nil {
+		return nil, err
+	}
 	uid, err := u.srv.Sid.GenUint64()
 	if err != nil {
 		return nil, fmt.Errorf("[Domain.Service.User] gen sid field: %w", err)
 	}
 	user.ID = uid
+	// 用户名唯一性由唯一索引保证，冲突时仓储返回 ErrUserAlreadyExists
 	res, err := u.repo.CreateUser(ctx, user)
 	if err != nil {
 		return nil, err
 	}
-	akc, rfk, err := u.srv.Jwt.GenTokenPair(res.ID)
+	res.TokenPair, err = u.startSession(ctx, res)
 	if err != nil {
 		return nil, err
 	}
-	res.TokenPair = NewCertificatePair(akc, rfk, u.srv.Jwt.GetAckExpires(), u.srv.Jwt.GetRefreshExpires())
-	return user, nil
+	return res, nil
+}
+
+// Logout 吊销当前访问令牌并结束刷新令牌所在的会话，已过期或无效的令牌直接忽略
+func (u *userService) Logout(ctx context.Context, accessToken, refreshToken string) (err error) {
+	var userID uint64
+	defer func() {
+		u.audit.Record(ctx, NewAuditLog(AuditLogout, userID, "", err))
+	}()
+	if claims, err := u.srv.Jwt.ParseToken(accessToken); err == nil && claims.TokenType == jwt.TokenTypeAccess {
+		userID = claims.UserId
+		if err := u.revocation.Revoke(ctx, claims); err != nil {
+			return fmt.Errorf("[Domain.Service.User] revoke access token: %w", err)
+		}
+	}
+	claims, err := u.srv.Jwt.ParseToken(refreshToken)
+	if err != nil || claims.TokenType != jwt.TokenTypeRefresh || claims.SessionID == "" {
+		return nil
+	}
+	if userID != 0 && claims.UserId != userID {
+		return ErrInvalidRefreshToken
+	}
+	userID = claims.UserId
+	if err := u.session.DeleteSession(ctx, claims.SessionID); err != nil {
+		return fmt.Errorf("[Domain.Service.User] delete session: %w", err)
+	}
+	return nil
+}
+
+// LogoutAll 吊销用户此前签发的全部访问令牌并结束其所有会话
+func (u *userService) LogoutAll(ctx context.Context, userID uint64) (err error) {
+	defer func() {
+		u.audit.Record(ctx, NewAuditLog(AuditLogoutAll, userID, "", err))
+	}()
+	if err := u.revocation.RevokeUser(ctx, userID, time.Now()); err != nil {
+		return fmt.Errorf("[Domain.Service.User] revoke user tokens: %w", err)
+	}
+	if err := u.session.DeleteUserSessions(ctx, userID); err != nil {
+		return fmt.Errorf("[Domain.Service.User] delete user sessions: %w", err)
+	}
+	return nil
+}
+
+func (u *userService) SendPhoneCode(ctx context.Context, phone string) error {
+	phone, err := NormalizePhone(phone)
+	if err != nil {
+		return err
+	}
+	code, err := NewCode(CodePurposePhoneLogin, phone)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.User] generate code: %w", err)
+	}
+	if err := u.code.SaveCode(ctx, code); err != nil {
+		return err
+	}
+	content, err := sms.Render(sms.VerificationTemplate, sms.TemplateData{
+		"Code":      code.Value,
+		"ExpiresIn": int(code.ExpiresIn.Minutes()),
+	})
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.User] render sms: %w", err)
+	}
+	if err := u.sms.Send(phone, content); err != nil {
+		return fmt.Errorf("[Domain.Service.User] send sms: %w", err)
+	}
+	return nil
+}
+
+func (u *userService) LoginByPhone(ctx context.Context, phone, code string) (*User, error) {
+	res, err := u.checkPhoneCode(ctx, phone, code)
+	if err != nil {
+		u.audit.Record(ctx, NewAuditLog(AuditLogin, 0, "phone:"+phone, err))
+		return nil, err
+	}
+	return u.SignIn(ctx, res, "phone:"+res.Phone)
+}
+
+// checkPhoneCode 校验手机验证码并返回手机号对应的用户，手机号未注册时自动注册
+func (u *userService) checkPhoneCode(ctx context.Context, phone, code string) (*User, error) {
+	phone, err := NormalizePhone(phone)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.code.VerifyCode(ctx, CodePurposePhoneLogin, phone, code); err != nil {
+		return nil, err
+	}
+	res, err := u.repo.GetUserByVerifiedPhone(ctx, phone)
+	if err != nil {
+		if !errors.Is(err, ErrUserNotFound) {
+			return nil, fmt.Errorf("[Domain.Service.User] get user by phone: %w", err)
+		}
+		if res, err = u.registerByPhone(ctx, phone); err != nil {
+			return nil, err
+		}
+		u.audit.Record(ctx, NewAuditLog(AuditRegister, res.ID, "phone:"+phone, nil))
+	}
+	return res, nil
+}
+
+// registerByPhone 使用已验证的手机号注册用户，用户名由用户 ID 生成且不设置密码，
+// 验证码已证明手机号归属，其他账号上尚未验证的同号绑定会被清除
+func (u *userService) registerByPhone(ctx context.Context, phone string) (*User, error) {
+	if err := u.repo.ReleasePendingPhone(ctx, phone); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] release pending phone: %w", err)
+	}
+	uid, err := u.srv.Sid.GenUint64()
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] gen sid field: %w", err)
+	}
+	user := &User{
+		ID:            uid,
+		Username:      NewUsername(fmt.Sprintf("user_%d", uid)),
+		Nickname:      NewUsername(MaskPhone(phone)),
+		Phone:         phone,
+		PhoneVerified: true,
+		Gender:        GenderUnknown,
+	}
+	return u.repo.CreateUser(ctx, user)
 }
 
 func (u *userService) GetUserByID(ctx context.Context, id uint64) (*User, error) {
@@ -60,25 +433,122 @@ func (u *userService) GetUserByID(ctx context.Context, id uint64) (*User, error)
 }
 
 func (u *userService) GetUser(ctx context.Context, user *User) (*User, error) {
-	// TODO implement me
-	panic("implement me")
+	res, err := u.repo.GetUser(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] get user: %w", err)
+	}
+	return res, nil
 }
 
-func (u *userService) UpdateUser(ctx context.Context, user *User) (*User, error) {
-	_, err := u.repo.GetUserByID(ctx, user.ID)
-	if err != nil {
+func (u *userService) UpdateUser(ctx context.Context, update *ProfileUpdate) (*User, error) {
+	if err := update.Validate(); err != nil {
 		return nil, err
 	}
-	updated, err := u.repo.UpdateUser(ctx, user)
+	user, err := u.repo.GetUserByID(ctx, update.UserID)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("[Domain.Service.User] get user by id: %w", err)
+	}
+	if !update.UpdatedAt.IsZero() && update.UpdatedAt.Unix() != user.UpdatedAt.Unix() {
+		return nil, ErrUpdateConflict
+	}
+	if update.Has(ProfilePhone) && update.Phone != "" && update.Phone != user.Phone {
+		if err := u.checkPhoneOwner(ctx, user.ID, update.Phone); err != nil {
+			return nil, err
+		}
+	}
+	if update.Has(ProfileAvatarFileID) {
+		if update.avatarURL, err = checkProfileImage(ctx, u.file, user.ID, update.AvatarFileID); err != nil {
+			return nil, fmt.Errorf("[Domain.Service.User] check %s: %w", ProfileAvatarFileID, err)
+		}
 	}
-	return updated, nil
+	if update.Has(ProfileBackgroundFileID) {
+		if update.backgroundURL, err = checkProfileImage(ctx, u.file, user.ID, update.BackgroundFileID); err != nil {
+			return nil, fmt.Errorf("[Domain.Service.User] check %s: %w", ProfileBackgroundFileID, err)
+		}
+	}
+	prevImages := profileImageIDs(user)
+	prev := user.UpdatedAt
+	user.ApplyProfile(update)
+	user.UpdatedAt = nextUpdatedAt(prev, time.Now())
+	images := profileImageIDs(user)
+	// 先引用新图片再更新资料，更新失败时多出的引用只会推迟文件回收
+	if err := pinProfileImages(ctx, u.file, user.ID, prevImages, images); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] pin profile images: %w", err)
+	}
+	if err := u.repo.UpdateProfile(ctx, user, update.Mask, prev); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] update profile: %w", err)
+	}
+	for _, id := range prevImages {
+		if slices.Contains(images, id) {
+			continue
+		}
+		if _, err := u.file.PinUserFile(ctx, user.ID, id, false); err != nil {
+			u.srv.Logger.WithContext(ctx).Warn("[Domain.Service.User] unpin profile image failed",
+				zap.Uint64("user_id", user.ID), zap.Uint64("file_id", id), zap.Error(err))
+		}
+	}
+	return user, nil
 }
 
-func NewUserService(srv *domain.Service, repo UserRepository) UserService {
+// checkPhoneOwner 手机号已被其他账号绑定时返回 ErrPhoneAlreadyUsed，尚未验证的绑定同样占用手机号
+func (u *userService) checkPhoneOwner(ctx context.Context, userID uint64, phone string) error {
+	owner, err := u.repo.GetUser(ctx, &User{Phone: phone})
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("[Domain.Service.User] get user by phone: %w", err)
+	}
+	if owner.ID != userID {
+		return ErrPhoneAlreadyUsed
+	}
+	return nil
+}
+
+func (u *userService) UploadProfileImage(ctx context.Context, userID uint64, name string, data []byte) (*UserFile, error) {
+	if _, err := u.repo.GetUserByID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] get user by id: %w", err)
+	}
+	f, err := uploadProfileImage(ctx, u.file, userID, name, data)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.User] upload profile image: %w", err)
+	}
+	return f, nil
+}
+
+func NewUserService(
+	srv *domain.Service,
+	repo UserRepository,
+	session SessionRepository,
+	revocation jwt.Revocation,
+	h hasher.Hasher,
+	code CodeRepository,
+	sender sms.Sender,
+	mailer mail.Sender,
+	mfaRepo MFARepository,
+	t *mfa.TOTP,
+	enforcer *authz.Enforcer,
+	file FileClient,
+	audit AuditService,
+) UserService {
+	dummy := NewPassword("dummy-password")
+	if err := dummy.Encrypt(h); err != nil {
+		panic(err)
+	}
 	return &userService{
-		srv:  srv,
-		repo: repo,
+		srv:        srv,
+		repo:       repo,
+		session:    session,
+		revocation: revocation,
+		hasher:     h,
+		code:       code,
+		sms:        sender,
+		mail:       mailer,
+		mfa:        mfaRepo,
+		totp:       t,
+		enforcer:   enforcer,
+		file:       file,
+		audit:      audit,
+		dummyHash:  dummy,
 	}
 }
@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestRefreshRotatesTokens(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	user := env.addUser(t, &User{ID: 1, Username: NewUsername("alice")})
+	first, err := env.service.startSession(ctx, user)
+	if err != nil {
+		t.Fatalf("start session: %v", err)
+	}
+	second, err := env.service.Refresh(ctx, first.RefreshToken.Token)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if second.RefreshToken.Token == first.RefreshToken.Token {
+		t.Fatal("refresh token was not rotated")
+	}
+	if !env.authenticated(t, first.AccessToken.Token) || !env.authenticated(t, second.AccessToken.Token) {
+		t.Fatal("access tokens of an intact session were rejected")
+	}
+	if _, err := env.service.Refresh(ctx, second.RefreshToken.Token); err != nil {
+		t.Fatalf("refresh with rotated token: %v", err)
+	}
+}
+
+func TestRefreshReuseRevokesFamily(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	user := env.addUser(t, &User{ID: 1, Username: NewUsername("alice")})
+	first, err := env.service.startSession(ctx, user)
+	if err != nil {
+		t.Fatalf("start session: %v", err)
+	}
+	second, err := env.service.Refresh(ctx, first.RefreshToken.Token)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	// 已轮换的刷新令牌再次出现，视为令牌族泄露
+	if _, err := env.service.Refresh(ctx, first.RefreshToken.Token); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("reuse err = %v, want ErrRefreshTokenReused", err)
+	}
+	if env.authenticated(t, first.AccessToken.Token) {
+		t.Fatal("access token issued before rotation is still accepted")
+	}
+	if env.authenticated(t, second.AccessToken.Token) {
+		t.Fatal("access token issued by the latest rotation is still accepted")
+	}
+	if _, err := env.service.Refresh(ctx, second.RefreshToken.Token); err == nil {
+		t.Fatal("latest refresh token still works after reuse was detected")
+	}
+	other, err := env.service.startSession(ctx, user)
+	if err != nil {
+		t.Fatalf("start session: %v", err)
+	}
+	if !env.authenticated(t, other.AccessToken.Token) {
+		t.Fatal("access token of another session was rejected")
+	}
+}
+
+var codePattern = regexp.MustCompile(`\d{6}`)
+
+// sentCode 读取最近一条发送到 phone 的短信中的验证码
+func sentCode(t *testing.T, env *testEnv, phone string) string {
+	t.Helper()
+	messages := env.sms.Messages()
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Phone != phone {
+			continue
+		}
+		if code := codePattern.FindString(messages[i].Content); code != "" {
+			return code
+		}
+	}
+	t.Fatalf("no code sent to %s", phone)
+	return ""
+}
+
+func TestLoginByPhoneRegistersAndNormalizes(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	// 其他账号上尚未验证的同号绑定在验证码证明归属后释放
+	pending := env.addUser(t, &User{ID: 1, Username: NewUsername("pending"), Phone: "+8613800138000"})
+	if err := env.service.SendPhoneCode(ctx, " 138 0013 8000 "); err != nil {
+		t.Fatalf("send phone code: %v", err)
+	}
+	code := sentCode(t, env, "+8613800138000")
+	user, err := env.service.LoginByPhone(ctx, "+86 138-0013-8000", code)
+	if err != nil {
+		t.Fatalf("login by phone: %v", err)
+	}
+	if user.Phone != "+8613800138000" || !user.PhoneVerified {
+		t.Fatalf("registered phone = %q verified=%v", user.Phone, user.PhoneVerified)
+	}
+	if user.TokenPair == nil || !env.authenticated(t, user.TokenPair.AccessToken.Token) {
+		t.Fatal("login did not issue a valid access token")
+	}
+	if p, _ := env.users.GetUserByID(ctx, pending.ID); p.Phone != "" {
+		t.Fatalf("pending phone binding was not released: %q", p.Phone)
+	}
+
+	env.code.advance(codeCooldown)
+	if err := env.service.SendPhoneCode(ctx, "13800138000"); err != nil {
+		t.Fatalf("send phone code: %v", err)
+	}
+	again, err := env.service.LoginByPhone(ctx, "0086 13800138000", sentCode(t, env, "+8613800138000"))
+	if err != nil {
+		t.Fatalf("second login by phone: %v", err)
+	}
+	if again.ID != user.ID {
+		t.Fatalf("second login user = %d, want %d", again.ID, user.ID)
+	}
+}
+
+func TestPhoneCodeRejectsInvalidNumbers(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	for _, phone := range []string{"", "12345", "not a phone", "+86 000 0000 0000"} {
+		if err := env.service.SendPhoneCode(ctx, phone); !errors.Is(err, ErrInvalidPhone) {
+			t.Errorf("send to %q err = %v, want ErrInvalidPhone", phone, err)
+		}
+		if _, err := env.service.LoginByPhone(ctx, phone, "123456"); !errors.Is(err, ErrInvalidPhone) {
+			t.Errorf("login with %q err = %v, want ErrInvalidPhone", phone, err)
+		}
+	}
+	if len(env.sms.Messages()) != 0 {
+		t.Fatal("sms sent to an invalid number")
+	}
+}
+
+func TestPhoneCodeLimits(t *testing.T) {
+	const phone = "+8613800138000"
+	ctx := context.Background()
+
+	t.Run("single use", func(t *testing.T) {
+		env := newTestEnv(t)
+		if err := env.service.SendPhoneCode(ctx, phone); err != nil {
+			t.Fatalf("send phone code: %v", err)
+		}
+		code := sentCode(t, env, phone)
+		if _, err := env.service.LoginByPhone(ctx, phone, code); err != nil {
+			t.Fatalf("login by phone: %v", err)
+		}
+		if _, err := env.service.LoginByPhone(ctx, phone, code); !errors.Is(err, ErrInvalidCode) {
+			t.Fatalf("reused code err = %v, want ErrInvalidCode", err)
+		}
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		env := newTestEnv(t)
+		if err := env.service.SendPhoneCode(ctx, phone); err != nil {
+			t.Fatalf("send phone code: %v", err)
+		}
+		env.code.advance(codeExpiresIn)
+		if _, err := env.service.LoginByPhone(ctx, phone, sentCode(t, env, phone)); !errors.Is(err, ErrInvalidCode) {
+			t.Fatalf("expired code err = %v, want ErrInvalidCode", err)
+		}
+	})
+
+	t.Run("attempt limit", func(t *testing.T) {
+		env := newTestEnv(t)
+		if err := env.service.SendPhoneCode(ctx, phone); err != nil {
+			t.Fatalf("send phone code: %v", err)
+		}
+		code := sentCode(t, env, phone)
+		wrong := "000000"
+		if code == wrong {
+			wrong = "111111"
+		}
+		for i := 0; i < codeMaxAttempts; i++ {
+			if _, err := env.service.LoginByPhone(ctx, phone, wrong); !errors.Is(err, ErrInvalidCode) {
+				t.Fatalf("wrong code err = %v, want ErrInvalidCode", err)
+			}
+		}
+		if _, err := env.service.LoginByPhone(ctx, phone, code); !errors.Is(err, ErrInvalidCode) {
+			t.Fatalf("code after too many attempts err = %v, want ErrInvalidCode", err)
+		}
+	})
+
+	t.Run("cooldown", func(t *testing.T) {
+		env := newTestEnv(t)
+		if err := env.service.SendPhoneCode(ctx, phone); err != nil {
+			t.Fatalf("send phone code: %v", err)
+		}
+		if err := env.service.SendPhoneCode(ctx, "13800138000"); !errors.Is(err, ErrCodeTooFrequent) {
+			t.Fatalf("resend err = %v, want ErrCodeTooFrequent", err)
+		}
+		env.code.advance(codeCooldown)
+		if err := env.service.SendPhoneCode(ctx, phone); err != nil {
+			t.Fatalf("resend after cooldown: %v", err)
+		}
+		if n := len(env.sms.Messages()); n != 2 {
+			t.Fatalf("sent %d messages, want 2", n)
+		}
+	})
+}
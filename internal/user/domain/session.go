@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/clientinfo"
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mail"
+)
+
+// unknownDevice 无法从 User-Agent 识别设备时使用的名称
+const unknownDevice = "Unknown device"
+
+// 按顺序匹配，Edge、Opera 等浏览器的 User-Agent 同时包含 Chrome 与 Safari
+var (
+	deviceBrowsers = []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"EdgiOS", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"FxiOS", "Firefox"},
+		{"CriOS", "Chrome"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+	deviceSystems = []struct{ token, name string }{
+		{"Windows", "Windows"},
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Android", "Android"},
+		{"CrOS", "ChromeOS"},
+		{"Macintosh", "macOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// DeviceName 由 User-Agent 解析出 "浏览器 on 操作系统" 形式的设备名称，
+// 非浏览器客户端使用 User-Agent 中的产品名称
+func DeviceName(userAgent string) string {
+	var browser, system string
+	for _, b := range deviceBrowsers {
+		if strings.Contains(userAgent, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	for _, s := range deviceSystems {
+		if strings.Contains(userAgent, s.token) {
+			system = s.name
+			break
+		}
+	}
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	}
+	product, _, _ := strings.Cut(strings.TrimSpace(userAgent), "/")
+	if product, _, _ = strings.Cut(product, " "); product == "" {
+		return unknownDevice
+	}
+	return truncate(product, 64)
+}
+
+// newSession 创建以当前请求的客户端信息作为设备信息的会话
+func newSession(ctx context.Context, id string, userID uint64, refreshID string, expiresAt time.Time) *Session {
+	info := clientinfo.FromContext(ctx)
+	now := time.Now()
+	return &Session{
+		ID:         id,
+		UserID:     userID,
+		RefreshID:  refreshID,
+		ExpiresAt:  expiresAt,
+		Device:     DeviceName(info.UserAgent),
+		UserAgent:  truncate(info.UserAgent, maxAuditFieldLength),
+		IP:         info.IP,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+}
+
+// notifyNewDevice 按客户端的设备ID记录会话的设备，设备此前未出现时向用户已验证的邮箱发送新设备登录提醒，
+// 用户首次登录的设备不发送提醒，邮件异步发送且失败只记录日志。
+// 上游未透传设备ID时退化为按设备名称识别
+func notifyNewDevice(ctx context.Context, srv *domain.Service, repo SessionRepository, sender mail.Sender, user *User, session *Session) {
+	device := clientinfo.FromContext(ctx).DeviceID
+	if device == "" {
+		device = session.Device
+	}
+	unseen, err := repo.RememberDevice(ctx, user.ID, device)
+	if err != nil {
+		srv.Logger.WithContext(ctx).Warn("[Domain.Service.Session] remember device failed",
+			zap.Uint64("user_id", user.ID), zap.Error(err))
+		return
+	}
+	if !unseen || user.Email == "" || !user.EmailVerified {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		body, err := mail.Render(mail.NewDeviceTemplate, mail.TemplateData{
+			"Nickname": user.Nickname.String(),
+			"Device":   session.Device,
+			"IP":       session.IP,
+			"Time":     session.CreatedAt.Format("2006-01-02 15:04:05 MST"),
+		})
+		if err == nil {
+			err = sender.Send([]string{user.Email}, "新设备登录提醒", body, true)
+		}
+		if err != nil {
+			srv.Logger.WithContext(ctx).Warn("[Domain.Service.Session] send new device mail failed",
+				zap.Uint64("user_id", user.ID), zap.Error(err))
+		}
+	}()
+}
+
+type SessionService interface {
+	// ListSessions 查询用户未过期的会话，按最近使用时间倒序返回
+	ListSessions(ctx context.Context, userID uint64) ([]*Session, error)
+	// RevokeSession 结束用户的会话，该会话签发的访问令牌与刷新令牌立即失效，
+	// 会话不存在或不属于该用户时返回 ErrUnknownSession
+	RevokeSession(ctx context.Context, userID uint64, sessionID string) error
+}
+
+type sessionService struct {
+	srv        *domain.Service
+	session    SessionRepository
+	revocation jwt.Revocation
+	audit      AuditService
+}
+
+func (s *sessionService) ListSessions(ctx context.Context, userID uint64) ([]*Session, error) {
+	sessions, err := s.session.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Session] list sessions: %w", err)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+	return sessions, nil
+}
+
+func (s *sessionService) RevokeSession(ctx context.Context, userID uint64, sessionID string) (err error) {
+	defer func() {
+		s.audit.Record(ctx, NewAuditLog(AuditSessionRevoke, userID, sessionID, err))
+	}()
+	session, err := s.session.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return ErrUnknownSession
+		}
+		return fmt.Errorf("[Domain.Service.Session] get session: %w", err)
+	}
+	if session.UserID != userID {
+		return ErrUnknownSession
+	}
+	// 先吊销访问令牌再删除会话，删除失败时重试仍然可以找到会话
+	if err := s.revocation.RevokeSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("[Domain.Service.Session] revoke session tokens: %w", err)
+	}
+	if err := s.session.DeleteSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("[Domain.Service.Session] delete session: %w", err)
+	}
+	return nil
+}
+
+func NewSessionService(srv *domain.Service, session SessionRepository, revocation jwt.Revocation, audit AuditService) SessionService {
+	return &sessionService{
+		srv:        srv,
+		session:    session,
+		revocation: revocation,
+		audit:      audit,
+	}
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"slices"
+	"time"
+	"unicode/utf8"
+)
+
+// UserStatus 账号状态
+type UserStatus string
+
+const (
+	UserStatusActive UserStatus = "active"
+	// UserStatusSuspended 暂停至 SuspendedUntil，到期后自动恢复为正常
+	UserStatusSuspended UserStatus = "suspended"
+	UserStatusBanned    UserStatus = "banned"
+	// UserStatusDeleted 软删除，restoreGracePeriod 内可以恢复
+	UserStatusDeleted UserStatus = "deleted"
+)
+
+const (
+	// restoreGracePeriod 软删除账号的可恢复期限
+	restoreGracePeriod = 30 * 24 * time.Hour
+	maxStatusReasonLen = 255
+)
+
+// userStatusTransitions 账号状态机，键为当前状态，值为允许变更到的状态
+var userStatusTransitions = map[UserStatus][]UserStatus{
+	UserStatusActive:    {UserStatusSuspended, UserStatusBanned, UserStatusDeleted},
+	UserStatusSuspended: {UserStatusActive, UserStatusSuspended, UserStatusBanned, UserStatusDeleted},
+	UserStatusBanned:    {UserStatusActive, UserStatusDeleted},
+	UserStatusDeleted:   {UserStatusActive},
+}
+
+// StatusAt 返回账号在 now 时刻的实际状态，暂停到期后视为正常
+func (u *User) StatusAt(now time.Time) UserStatus {
+	if u.Status == UserStatusSuspended && !now.Before(u.SuspendedUntil) {
+		return UserStatusActive
+	}
+	if u.Status == "" {
+		return UserStatusActive
+	}
+	return u.Status
+}
+
+// CheckActive 校验账号在 now 时刻是否可以登录和使用令牌
+func (u *User) CheckActive(now time.Time) error {
+	switch u.StatusAt(now) {
+	case UserStatusActive:
+		return nil
+	case UserStatusSuspended:
+		return ErrUserSuspended
+	case UserStatusBanned:
+		return ErrUserBanned
+	default:
+		return ErrUserNotFound
+	}
+}
+
+// ChangeStatus 按状态机将账号变更为 to，暂停时 until 为截止时间，
+// 已删除的账号只能在 restoreGracePeriod 内恢复为正常
+func (u *User) ChangeStatus(to UserStatus, until time.Time, reason string, now time.Time) error {
+	if reason == "" || utf8.RuneCountInString(reason) > maxStatusReasonLen {
+		return ErrInvalidStatusReason
+	}
+	from := u.StatusAt(now)
+	allowed, ok := userStatusTransitions[from]
+	if !ok {
+		return ErrInvalidUserStatus
+	}
+	if _, ok := userStatusTransitions[to]; !ok {
+		return ErrInvalidUserStatus
+	}
+	if !slices.Contains(allowed, to) {
+		return ErrInvalidStatusTransition
+	}
+	if from == UserStatusDeleted && now.Sub(u.StatusChangedAt) > restoreGracePeriod {
+		return ErrRestoreExpired
+	}
+	if to == UserStatusSuspended {
+		if !until.After(now) {
+			return ErrInvalidSuspendUntil
+		}
+		u.SuspendedUntil = until
+	} else {
+		u.SuspendedUntil = time.Time{}
+	}
+	u.Status = to
+	u.StatusReason = reason
+	u.StatusChangedAt = now
+	return nil
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mail"
+)
+
+// CodePurpose 验证码用途，不同用途的验证码互不通用
+type CodePurpose string
+
+const (
+	CodePurposeVerifyEmail   CodePurpose = "VERIFY_EMAIL"
+	CodePurposeResetPassword CodePurpose = "RESET_PASSWORD"
+	CodePurposePhoneLogin    CodePurpose = "PHONE_LOGIN"
+)
+
+const (
+	codeLength      = 6
+	codeExpiresIn   = 10 * time.Minute
+	codeCooldown    = time.Minute
+	codeHourlyLimit = 5
+	codeMaxAttempts = 5
+)
+
+// Code 一次性验证码及其发送频率限制
+type Code struct {
+	Purpose CodePurpose
+	// Target 验证码接收方，邮箱或手机号
+	Target    string
+	Value     string
+	ExpiresIn time.Duration
+	// Cooldown 同一接收方两次发送之间的最短间隔
+	Cooldown time.Duration
+	// HourlyLimit 同一接收方每小时最多发送次数
+	HourlyLimit int
+	// MaxAttempts 最多允许校验失败的次数
+	MaxAttempts int
+}
+
+// NewCode 生成指定用途的随机数字验证码
+func NewCode(purpose CodePurpose, target string) (*Code, error) {
+	max := big.NewInt(10)
+	value := make([]byte, codeLength)
+	for i := range value {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return nil, err
+		}
+		value[i] = byte('0' + n.Int64())
+	}
+	return &Code{
+		Purpose:     purpose,
+		Target:      target,
+		Value:       string(value),
+		ExpiresIn:   codeExpiresIn,
+		Cooldown:    codeCooldown,
+		HourlyLimit: codeHourlyLimit,
+		MaxAttempts: codeMaxAttempts,
+	}, nil
+}
+
+type VerificationService interface {
+	// SendEmailCode 向 email 发送邮箱验证码，email 为空时使用用户当前邮箱
+	SendEmailCode(ctx context.Context, userID uint64, email string) error
+	// VerifyEmail 校验验证码，通过后将 email 设为用户邮箱并标记为已验证
+	VerifyEmail(ctx context.Context, userID uint64, email, code string) error
+}
+
+type verificationService struct {
+	srv    *domain.Service
+	repo   UserRepository
+	code   CodeRepository
+	sender mail.Sender
+}
+
+func (v *verificationService) SendEmailCode(ctx context.Context, userID uint64, email string) error {
+	user, err := v.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Verification] get user by id: %w", err)
+	}
+	if email == "" {
+		email = user.Email
+	}
+	email = NormalizeEmail(email)
+	if err := ValidateEmail(email); err != nil {
+		return err
+	}
+	if user.EmailVerified && user.Email == email {
+		return ErrEmailAlreadyVerified
+	}
+	if err := v.checkEmailOwner(ctx, userID, email); err != nil {
+		return err
+	}
+	code, err := NewCode(CodePurposeVerifyEmail, email)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Verification] generate code: %w", err)
+	}
+	if err := v.code.SaveCode(ctx, code); err != nil {
+		return err
+	}
+	return sendCodeMail(v.sender, email, "邮箱验证", code)
+}
+
+func (v *verificationService) VerifyEmail(ctx context.Context, userID uint64, email, code string) error {
+	email = NormalizeEmail(email)
+	if err := ValidateEmail(email); err != nil {
+		return err
+	}
+	if err := v.code.VerifyCode(ctx, CodePurposeVerifyEmail, email, code); err != nil {
+		return err
+	}
+	// 发送验证码后邮箱可能已被其他账号验证，落库前需再次检查
+	if err := v.checkEmailOwner(ctx, userID, email); err != nil {
+		return err
+	}
+	if err := v.repo.VerifyEmail(ctx, userID, email, time.Now()); err != nil {
+		return fmt.Errorf("[Domain.Service.Verification] verify email: %w", err)
+	}
+	return nil
+}
+
+// checkEmailOwner 邮箱已被其他账号验证时返回 ErrEmailAlreadyUsed
+func (v *verificationService) checkEmailOwner(ctx context.Context, userID uint64, email string) error {
+	owner, err := v.repo.GetUserByVerifiedEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil
+		}
+		return fmt.Errorf("[Domain.Service.Verification] get user by email: %w", err)
+	}
+	if owner.ID != userID {
+		return ErrEmailAlreadyUsed
+	}
+	return nil
+}
+
+// sendCodeMail 使用验证码模板向 email 发送验证码邮件
+func sendCodeMail(sender mail.Sender, email, subject string, code *Code) error {
+	body, err := mail.Render(mail.VerificationTemplate, mail.TemplateData{
+		"Code":      code.Value,
+		"ExpiresIn": int(code.ExpiresIn.Minutes()),
+	})
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Verification] render mail: %w", err)
+	}
+	if err := sender.Send([]string{email}, subject, body, true); err != nil {
+		return fmt.Errorf("[Domain.Service.Verification] send mail: %w", err)
+	}
+	return nil
+}
+
+func NewVerificationService(srv *domain.Service, repo UserRepository, code CodeRepository, sender mail.Sender) VerificationService {
+	return &verificationService{
+		srv:    srv,
+		repo:   repo,
+		code:   code,
+		sender: sender,
+	}
+}
@@ -14,19 +14,30 @@ const TableNameUser = "users"
 
 // User mapped from table <users>
 type User struct {
-	ID            uint64         `gorm:"column:id;type:bigint unsigned;primaryKey" json:"id"`
-	Username      string         `gorm:"column:username;type:varchar(64);not null;comment:登录用户名，唯一" json:"username"`         // 登录用户名，唯一
-	Password      string         `gorm:"column:password;type:varchar(255);not null;comment:哈希后的密码" json:"password"`          // 哈希后的密码
-	Nickname      string         `gorm:"column:nickname;type:varchar(64);not null;comment:昵称，默认同 username" json:"nickname"`  // 昵称，默认同 username
-	AvatarURL     *string        `gorm:"column:avatar_url;type:varchar(255);comment:头像地址" json:"avatar_url"`                 // 头像地址
-	BackgroundURL *string        `gorm:"column:background_url;type:varchar(255);comment:背景图地址" json:"background_url"`        // 背景图地址
-	Signature     *string        `gorm:"column:signature;type:varchar(255);comment:个性签名" json:"signature"`                   // 个性签名
-	Email         *string        `gorm:"column:email;type:varchar(128);comment:邮箱，可用于找回密码" json:"email"`                     // 邮箱，可用于找回密码
-	Phone         *string        `gorm:"column:phone;type:varchar(32);comment:手机号" json:"phone"`                             // 手机号
-	Gender        byte           `gorm:"column:gender;type:tinyint unsigned;not null;comment:性别：0 未知，1 男，2 女" json:"gender"` // 性别：0 未知，1 男，2 女
-	CreatedAt     time.Time      `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP" json:"created_at"`
-	UpdatedAt     time.Time      `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP" json:"updated_at"`
-	DeletedAt     gorm.DeletedAt `gorm:"column:deleted_at;type:datetime" json:"deleted_at"`
+	ID               uint64         `gorm:"column:id;type:bigint unsigned;primaryKey" json:"id"`
+	Username         string         `gorm:"column:username;type:varchar(64);not null;comment:登录用户名，唯一" json:"username"`                                        // 登录用户名，唯一
+	Password         string         `gorm:"column:password;type:varchar(255);not null;comment:哈希后的密码" json:"password"`                                         // 哈希后的密码
+	Nickname         string         `gorm:"column:nickname;type:varchar(64);not null;comment:昵称，默认同 username" json:"nickname"`                                 // 昵称，默认同 username
+	AvatarURL        *string        `gorm:"column:avatar_url;type:varchar(255);comment:头像地址" json:"avatar_url"`                                                // 头像地址
+	BackgroundURL    *string        `gorm:"column:background_url;type:varchar(255);comment:背景图地址" json:"background_url"`                                       // 背景图地址
+	AvatarFileID     *uint64        `gorm:"column:avatar_file_id;type:bigint unsigned;comment:头像文件 ID，非空时优先于 avatar_url" json:"avatar_file_id"`                // 头像文件 ID，非空时优先于 avatar_url
+	BackgroundFileID *uint64        `gorm:"column:background_file_id;type:bigint unsigned;comment:背景图文件 ID，非空时优先于 background_url" json:"background_file_id"`   // 背景图文件 ID，非空时优先于 background_url
+	Signature        *string        `gorm:"column:signature;type:varchar(255);comment:个性签名" json:"signature"`                                                  // 个性签名
+	Email            *string        `gorm:"column:email;type:varchar(128);comment:邮箱，可用于找回密码" json:"email"`                                                    // 邮箱，可用于找回密码
+	Phone            *string        `gorm:"column:phone;type:varchar(32);comment:手机号" json:"phone"`                                                            // 手机号
+	Gender           byte           `gorm:"column:gender;type:tinyint unsigned;not null;comment:性别：0 未知，1 男，2 女" json:"gender"`                                // 性别：0 未知，1 男，2 女
+	EmailVerifiedAt  *time.Time     `gorm:"column:email_verified_at;type:datetime;comment:邮箱验证时间，为空表示未验证" json:"email_verified_at"`                            // 邮箱验证时间，为空表示未验证
+	PhoneVerifiedAt  *time.Time     `gorm:"column:phone_verified_at;type:datetime;comment:手机号验证时间，为空表示未验证" json:"phone_verified_at"`                           // 手机号验证时间，为空表示未验证
+	TotpSecret       *string        `gorm:"column:totp_secret;type:varchar(64);comment:TOTP 密钥，启用或待确认时非空" json:"totp_secret"`                                  // TOTP 密钥，启用或待确认时非空
+	TotpEnabledAt    *time.Time     `gorm:"column:totp_enabled_at;type:datetime;comment:TOTP 启用时间，为空表示未启用" json:"totp_enabled_at"`                             // TOTP 启用时间，为空表示未启用
+	RecoveryCodes    *string        `gorm:"column:recovery_codes;type:varchar(1024);comment:二次验证恢复码哈希，JSON 数组" json:"recovery_codes"`                          // 二次验证恢复码哈希，JSON 数组
+	Status           string         `gorm:"column:status;type:varchar(16);not null;default:active;comment:账号状态：active、suspended、banned、deleted" json:"status"` // 账号状态：active、suspended、banned、deleted
+	SuspendedUntil   *time.Time     `gorm:"column:suspended_until;type:datetime;comment:封禁截止时间，仅 suspended 状态有效" json:"suspended_until"`                       // 封禁截止时间，仅 suspended 状态有效
+	StatusReason     *string        `gorm:"column:status_reason;type:varchar(255);comment:最近一次状态变更原因" json:"status_reason"`                                    // 最近一次状态变更原因
+	StatusChangedAt  *time.Time     `gorm:"column:status_changed_at;type:datetime;comment:最近一次状态变更时间" json:"status_changed_at"`                                // 最近一次状态变更时间
+	CreatedAt        time.Time      `gorm:"column:created_at;type:datetime;not null;default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt        time.Time      `gorm:"column:updated_at;type:datetime;not null;default:CURRENT_TIMESTAMP" json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"column:deleted_at;type:datetime" json:"deleted_at"`
 }
 
 // TableName User's table name
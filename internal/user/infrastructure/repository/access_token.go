@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/model"
+)
+
+type AccessTokenRepository struct {
+	repo *Repository
+}
+
+func (a *AccessTokenRepository) CreateAccessToken(ctx context.Context, token *domain.AccessToken) error {
+	m := &model.PersonalAccessToken{
+		ID:          token.ID,
+		UserID:      token.UserID,
+		Name:        token.Name,
+		TokenHash:   token.Hash,
+		TokenPrefix: token.Prefix,
+		Scopes:      strings.Join(token.Scopes, ","),
+		CreatedAt:   token.CreatedAt,
+		UpdatedAt:   token.CreatedAt,
+	}
+	if !token.ExpiresAt.IsZero() {
+		m.ExpiresAt = &token.ExpiresAt
+	}
+	if err := a.repo.query.PersonalAccessToken.WithContext(ctx).Create(m); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.AccessToken]failed to create token: %w", err)
+	}
+	return nil
+}
+
+func (a *AccessTokenRepository) ListAccessTokens(ctx context.Context, userID uint64) ([]*domain.AccessToken, error) {
+	q := a.repo.query.PersonalAccessToken
+	res, err := q.WithContext(ctx).
+		Where(q.UserID.Eq(userID)).
+		Order(q.CreatedAt.Desc()).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.AccessToken]failed to list tokens: %w", err)
+	}
+	tokens := make([]*domain.AccessToken, 0, len(res))
+	for _, m := range res {
+		tokens = append(tokens, toDomainAccessToken(m))
+	}
+	return tokens, nil
+}
+
+func (a *AccessTokenRepository) CountAccessTokens(ctx context.Context, userID uint64) (int64, error) {
+	q := a.repo.query.PersonalAccessToken
+	count, err := q.WithContext(ctx).Where(q.UserID.Eq(userID)).Count()
+	if err != nil {
+		return 0, fmt.Errorf("[Infrastructure.Repository.AccessToken]failed to count tokens: %w", err)
+	}
+	return count, nil
+}
+
+func (a *AccessTokenRepository) GetAccessTokenByHash(ctx context.Context, hash string) (*domain.AccessToken, error) {
+	q := a.repo.query.PersonalAccessToken
+	res, err := q.WithContext(ctx).Where(q.TokenHash.Eq(hash)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrInvalidAccessToken
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.AccessToken]failed to get token: %w", err)
+	}
+	return toDomainAccessToken(res), nil
+}
+
+func (a *AccessTokenRepository) DeleteAccessToken(ctx context.Context, userID, id uint64) error {
+	q := a.repo.query.PersonalAccessToken
+	info, err := q.WithContext(ctx).Where(q.ID.Eq(id), q.UserID.Eq(userID)).Delete()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.AccessToken]failed to delete token: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		return domain.ErrAccessTokenNotFound
+	}
+	return nil
+}
+
+func (a *AccessTokenRepository) TouchAccessToken(ctx context.Context, id uint64, at time.Time) error {
+	q := a.repo.query.PersonalAccessToken
+	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).UpdateSimple(q.LastUsedAt.Value(at))
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.AccessToken]failed to touch token: %w", err)
+	}
+	return nil
+}
+
+func (a *AccessTokenRepository) DeleteUserAccessTokens(ctx context.Context, userID uint64) error {
+	q := a.repo.query.PersonalAccessToken
+	if _, err := q.WithContext(ctx).Where(q.UserID.Eq(userID)).Delete(); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.AccessToken]failed to delete tokens of user %d: %w", userID, err)
+	}
+	return nil
+}
+
+func toDomainAccessToken(m *model.PersonalAccessToken) *domain.AccessToken {
+	t := &domain.AccessToken{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Name:      m.Name,
+		Hash:      m.TokenHash,
+		Prefix:    m.TokenPrefix,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.Scopes != "" {
+		t.Scopes = strings.Split(m.Scopes, ",")
+	}
+	if m.ExpiresAt != nil {
+		t.ExpiresAt = *m.ExpiresAt
+	}
+	if m.LastUsedAt != nil {
+		t.LastUsedAt = *m.LastUsedAt
+	}
+	return t
+}
+
+func NewAccessTokenRepository(repo *Repository) domain.AccessTokenRepository {
+	return &AccessTokenRepository{
+		repo: repo,
+	}
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/model"
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
+
+const (
+	defaultAuditBufferSize = 4096
+	auditBatchSize         = 100
+	auditFlushInterval     = time.Second
+	auditFlushTimeout      = 5 * time.Second
+)
+
+// AuditRepository 审计日志先写入内存缓冲区，由后台协程批量落库，登录等接口的延迟不受数据库写入影响。
+// 缓冲区已满时丢弃新日志并记录错误日志，Stop 时写入缓冲区中剩余的日志
+type AuditRepository struct {
+	repo     *Repository
+	logs     chan *model.UserAuditLog
+	quit     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func (a *AuditRepository) AppendAuditLog(ctx context.Context, log *domain.AuditLog) {
+	select {
+	case a.logs <- toModelAuditLog(log):
+	default:
+		a.repo.logger.WithContext(ctx).Error("[Infrastructure.Repository.Audit]buffer full, audit log dropped",
+			zap.Uint64("id", log.ID), zap.String("action", string(log.Action)), zap.Uint64("user_id", log.UserID))
+	}
+}
+
+// Start 启动后台批量写入协程
+func (a *AuditRepository) Start() {
+	a.wg.Add(1)
+	go a.run()
+}
+
+// Stop 停止后台协程并写入缓冲区中剩余的日志，需要在 RPC 服务停止后调用
+func (a *AuditRepository) Stop() {
+	a.stopOnce.Do(func() {
+		close(a.quit)
+	})
+	a.wg.Wait()
+}
+
+func (a *AuditRepository) run() {
+	defer a.wg.Done()
+	ticker := time.NewTicker(auditFlushInterval)
+	defer ticker.Stop()
+	batch := make([]*model.UserAuditLog, 0, auditBatchSize)
+	for {
+		select {
+		case log := <-a.logs:
+			batch = append(batch, log)
+			if len(batch) >= auditBatchSize {
+				a.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				a.flush(batch)
+				batch = batch[:0]
+			}
+		case <-a.quit:
+			for {
+				select {
+				case log := <-a.logs:
+					batch = append(batch, log)
+				default:
+					if len(batch) > 0 {
+						a.flush(batch)
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+func (a *AuditRepository) flush(batch []*model.UserAuditLog) {
+	ctx, cancel := context.WithTimeout(context.Background(), auditFlushTimeout)
+	defer cancel()
+	if err := a.repo.query.UserAuditLog.WithContext(ctx).CreateInBatches(batch, auditBatchSize); err != nil {
+		a.repo.logger.Error("[Infrastructure.Repository.Audit]failed to write audit logs",
+			zap.Int("count", len(batch)), zap.Error(err))
+	}
+}
+
+func (a *AuditRepository) ListAuditLogs(ctx context.Context, filter domain.AuditFilter, p page.Page) ([]*domain.AuditLog, int64, error) {
+	q := a.repo.query.UserAuditLog
+	do := q.WithContext(ctx)
+	if filter.UserID != 0 {
+		do = do.Where(q.UserID.Eq(filter.UserID))
+	}
+	if filter.ActorID != 0 {
+		do = do.Where(q.ActorID.Eq(filter.ActorID))
+	}
+	if filter.Action != "" {
+		do = do.Where(q.Action.Eq(string(filter.Action)))
+	}
+	if filter.Outcome != "" {
+		do = do.Where(q.Outcome.Eq(string(filter.Outcome)))
+	}
+	if filter.IP != "" {
+		do = do.Where(q.IP.Eq(filter.IP))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		do = do.Where(q.CreatedAt.Gte(filter.CreatedFrom))
+	}
+	if !filter.CreatedTo.IsZero() {
+		do = do.Where(q.CreatedAt.Lt(filter.CreatedTo))
+	}
+	res, total, err := do.Order(q.CreatedAt.Desc(), q.ID.Desc()).FindByPage(p.Offset, p.Limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("[Infrastructure.Repository.Audit]failed to list audit logs: %w", err)
+	}
+	logs := make([]*domain.AuditLog, 0, len(res))
+	for _, m := range res {
+		logs = append(logs, toDomainAuditLog(m))
+	}
+	return logs, total, nil
+}
+
+func (a *AuditRepository) ListUserAuditLogs(ctx context.Context, userID uint64) ([]*domain.AuditLog, error) {
+	q := a.repo.query.UserAuditLog
+	res, err := q.WithContext(ctx).Where(q.UserID.Eq(userID)).Order(q.CreatedAt, q.ID).Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Audit]failed to list audit logs of user %d: %w", userID, err)
+	}
+	logs := make([]*domain.AuditLog, 0, len(res))
+	for _, m := range res {
+		logs = append(logs, toDomainAuditLog(m))
+	}
+	return logs, nil
+}
+
+// AnonymizeUserAuditLogs 审计日志只追加不修改，注销账号时是唯一的例外，事件本身保留
+func (a *AuditRepository) AnonymizeUserAuditLogs(ctx context.Context, userID uint64) error {
+	q := a.repo.query.UserAuditLog
+	if _, err := q.WithContext(ctx).
+		Where(q.WithContext(ctx).Where(q.UserID.Eq(userID)).Or(q.ActorID.Eq(userID))).
+		Where(q.WithContext(ctx).Where(q.IP.Neq("")).Or(q.UserAgent.Neq(""))).
+		UpdateSimple(q.IP.Value(""), q.UserAgent.Value("")); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Audit]failed to anonymize audit logs of user %d: %w", userID, err)
+	}
+	return nil
+}
+
+func toModelAuditLog(log *domain.AuditLog) *model.UserAuditLog {
+	return &model.UserAuditLog{
+		ID:        log.ID,
+		UserID:    log.UserID,
+		ActorID:   log.ActorID,
+		Action:    string(log.Action),
+		Outcome:   string(log.Outcome),
+		Reason:    nullableString(log.Reason),
+		Detail:    nullableString(log.Detail),
+		IP:        log.IP,
+		UserAgent: log.UserAgent,
+		RequestID: log.RequestID,
+		CreatedAt: log.CreatedAt,
+	}
+}
+
+func toDomainAuditLog(m *model.UserAuditLog) *domain.AuditLog {
+	log := &domain.AuditLog{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		ActorID:   m.ActorID,
+		Action:    domain.AuditAction(m.Action),
+		Outcome:   domain.AuditOutcome(m.Outcome),
+		IP:        m.IP,
+		UserAgent: m.UserAgent,
+		RequestID: m.RequestID,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.Reason != nil {
+		log.Reason = *m.Reason
+	}
+	if m.Detail != nil {
+		log.Detail = *m.Detail
+	}
+	return log
+}
+
+func NewAuditRepository(conf *viper.Viper, repo *Repository) *AuditRepository {
+	size := conf.GetInt("app.audit.buffer_size")
+	if size <= 0 {
+		size = defaultAuditBufferSize
+	}
+	return &AuditRepository{
+		repo: repo,
+		logs: make(chan *model.UserAuditLog, size),
+		quit: make(chan struct{}),
+	}
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+)
+
+// saveCodeScript 检查冷却期与每小时发送上限后写入验证码，返回 0 表示发送过于频繁
+var saveCodeScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[2]) == 1 then
+	return 0
+end
+local sent = redis.call('INCR', KEYS[3])
+if sent == 1 then
+	redis.call('PEXPIRE', KEYS[3], 3600000)
+end
+if sent > tonumber(ARGV[4]) then
+	return 0
+end
+redis.call('SET', KEYS[2], 1, 'PX', ARGV[3])
+redis.call('DEL', KEYS[1])
+redis.call('HSET', KEYS[1], 'value', ARGV[1], 'attempts', 0, 'max_attempts', ARGV[5])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return 1
+`)
+
+// verifyCodeScript 校验通过时删除验证码，失败次数达到上限时同样删除，返回 1 表示校验通过
+var verifyCodeScript = redis.NewScript(`
+local value = redis.call('HGET', KEYS[1], 'value')
+if not value then
+	return 0
+end
+if value == ARGV[1] then
+	redis.call('DEL', KEYS[1])
+	return 1
+end
+local attempts = redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+if attempts >= tonumber(redis.call('HGET', KEYS[1], 'max_attempts')) then
+	redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type CodeRepository struct {
+	repo *Repository
+}
+
+func codeKey(purpose domain.CodePurpose, target string) string {
+	return fmt.Sprintf("USER:CODE:%s:%s", purpose, target)
+}
+
+func codeCooldownKey(purpose domain.CodePurpose, target string) string {
+	return fmt.Sprintf("USER:CODE:COOLDOWN:%s:%s", purpose, target)
+}
+
+func codeLimitKey(purpose domain.CodePurpose, target string) string {
+	return fmt.Sprintf("USER:CODE:LIMIT:%s:%s", purpose, target)
+}
+
+func (c *CodeRepository) SaveCode(ctx context.Context, code *domain.Code) error {
+	res, err := saveCodeScript.Run(ctx, c.repo.rdb,
+		[]string{
+			codeKey(code.Purpose, code.Target),
+			codeCooldownKey(code.Purpose, code.Target),
+			codeLimitKey(code.Purpose, code.Target),
+		},
+		code.Value, code.ExpiresIn.Milliseconds(), code.Cooldown.Milliseconds(), code.HourlyLimit, code.MaxAttempts,
+	).Int()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Code]failed to save code: %w", err)
+	}
+	if res == 0 {
+		return domain.ErrCodeTooFrequent
+	}
+	return nil
+}
+
+func (c *CodeRepository) VerifyCode(ctx context.Context, purpose domain.CodePurpose, target, value string) error {
+	if value == "" {
+		return domain.ErrInvalidCode
+	}
+	res, err := verifyCodeScript.Run(ctx, c.repo.rdb, []string{codeKey(purpose, target)}, value).Int()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Code]failed to verify code: %w", err)
+	}
+	if res != 1 {
+		return domain.ErrInvalidCode
+	}
+	return nil
+}
+
+func NewCodeRepository(repo *Repository) domain.CodeRepository {
+	return &CodeRepository{
+		repo: repo,
+	}
+}
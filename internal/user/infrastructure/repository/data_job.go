@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/model"
+)
+
+type DataJobRepository struct {
+	repo *Repository
+}
+
+func (d *DataJobRepository) CreateDataJob(ctx context.Context, job *domain.DataJob) error {
+	if err := d.repo.query.UserDataJob.WithContext(ctx).Create(toModelDataJob(job)); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.DataJob]failed to create job: %w", err)
+	}
+	return nil
+}
+
+func (d *DataJobRepository) GetDataJob(ctx context.Context, userID, id uint64) (*domain.DataJob, error) {
+	q := d.repo.query.UserDataJob
+	res, err := q.WithContext(ctx).Where(q.ID.Eq(id), q.UserID.Eq(userID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrDataJobNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.DataJob]failed to get job %d: %w", id, err)
+	}
+	return toDomainDataJob(res), nil
+}
+
+func (d *DataJobRepository) GetActiveDataJob(ctx context.Context, userID uint64, typ domain.DataJobType) (*domain.DataJob, error) {
+	q := d.repo.query.UserDataJob
+	res, err := q.WithContext(ctx).
+		Where(q.UserID.Eq(userID), q.Type.Eq(string(typ)),
+			q.Status.In(string(domain.DataJobPending), string(domain.DataJobRunning))).
+		Order(q.CreatedAt.Desc()).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrDataJobNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.DataJob]failed to get active job: %w", err)
+	}
+	return toDomainDataJob(res), nil
+}
+
+func (d *DataJobRepository) ClaimDataJobs(ctx context.Context, staleBefore time.Time, limit int) ([]*domain.DataJob, error) {
+	q := d.repo.query.UserDataJob
+	candidates, err := q.WithContext(ctx).
+		Where(q.WithContext(ctx).
+			Where(q.Status.Eq(string(domain.DataJobPending))).
+			Or(q.Status.Eq(string(domain.DataJobRunning)), q.UpdatedAt.Lt(staleBefore))).
+		Order(q.CreatedAt).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.DataJob]failed to find pending jobs: %w", err)
+	}
+	now := time.Now()
+	jobs := make([]*domain.DataJob, 0, len(candidates))
+	for _, m := range candidates {
+		// 以读取到的状态与更新时间为条件更新，多个实例同时领取时只有一个成功
+		info, err := q.WithContext(ctx).
+			Where(q.ID.Eq(m.ID), q.Status.Eq(m.Status), q.UpdatedAt.Eq(m.UpdatedAt)).
+			UpdateSimple(q.Status.Value(string(domain.DataJobRunning)), q.Attempts.Add(1), q.UpdatedAt.Value(now))
+		if err != nil {
+			return nil, fmt.Errorf("[Infrastructure.Repository.DataJob]failed to claim job %d: %w", m.ID, err)
+		}
+		if info.RowsAffected == 0 {
+			continue
+		}
+		m.Status = string(domain.DataJobRunning)
+		m.Attempts++
+		m.UpdatedAt = now
+		jobs = append(jobs, toDomainDataJob(m))
+	}
+	return jobs, nil
+}
+
+func (d *DataJobRepository) UpdateDataJob(ctx context.Context, job *domain.DataJob) error {
+	q := d.repo.query.UserDataJob
+	m := toModelDataJob(job)
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(job.ID)).Updates(map[string]interface{}{
+		"status":      m.Status,
+		"step":        m.Step,
+		"file_id":     m.FileID,
+		"error":       m.Error,
+		"finished_at": m.FinishedAt,
+		"updated_at":  m.UpdatedAt,
+	}); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.DataJob]failed to update job %d: %w", job.ID, err)
+	}
+	return nil
+}
+
+func toModelDataJob(job *domain.DataJob) *model.UserDataJob {
+	m := &model.UserDataJob{
+		ID:        job.ID,
+		UserID:    job.UserID,
+		Type:      string(job.Type),
+		Status:    string(job.Status),
+		Step:      job.Step,
+		Attempts:  int32(job.Attempts),
+		FileID:    job.FileID,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+	if job.Error != "" {
+		// 错误信息只用于排查，超出列宽时截断
+		e := job.Error
+		if len(e) > 255 {
+			e = e[:255]
+		}
+		m.Error = &e
+	}
+	if !job.FinishedAt.IsZero() {
+		m.FinishedAt = &job.FinishedAt
+	}
+	return m
+}
+
+func toDomainDataJob(m *model.UserDataJob) *domain.DataJob {
+	return &domain.DataJob{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		Type:       domain.DataJobType(m.Type),
+		Status:     domain.DataJobStatus(m.Status),
+		Step:       m.Step,
+		Attempts:   int(m.Attempts),
+		FileID:     m.FileID,
+		Error:      deref(m.Error),
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+		FinishedAt: derefTime(m.FinishedAt),
+	}
+}
+
+func NewDataJobRepository(repo *Repository) domain.DataJobRepository {
+	return &DataJobRepository{
+		repo: repo,
+	}
+}
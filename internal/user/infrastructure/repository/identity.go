@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/model"
+)
+
+type IdentityRepository struct {
+	repo *Repository
+}
+
+func (i *IdentityRepository) CreateIdentity(ctx context.Context, identity *domain.Identity) error {
+	m := &model.UserIdentity{
+		ID:       identity.ID,
+		UserID:   identity.UserID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	}
+	if identity.Email != "" {
+		m.Email = &identity.Email
+	}
+	if err := i.repo.query.UserIdentity.WithContext(ctx).Create(m); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Identity]failed to create identity: %w", err)
+	}
+	return nil
+}
+
+func (i *IdentityRepository) GetIdentity(ctx context.Context, provider, subject string) (*domain.Identity, error) {
+	q := i.repo.query.UserIdentity
+	res, err := q.WithContext(ctx).
+		Where(q.Provider.Eq(provider), q.Subject.Eq(subject)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrIdentityNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.Identity]failed to get identity: %w", err)
+	}
+	return toDomainIdentity(res), nil
+}
+
+func (i *IdentityRepository) ListIdentities(ctx context.Context, userID uint64) ([]*domain.Identity, error) {
+	q := i.repo.query.UserIdentity
+	res, err := q.WithContext(ctx).
+		Where(q.UserID.Eq(userID)).
+		Order(q.CreatedAt).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Identity]failed to list identities: %w", err)
+	}
+	identities := make([]*domain.Identity, 0, len(res))
+	for _, m := range res {
+		identities = append(identities, toDomainIdentity(m))
+	}
+	return identities, nil
+}
+
+func (i *IdentityRepository) DeleteIdentity(ctx context.Context, userID uint64, provider string) error {
+	q := i.repo.query.UserIdentity
+	info, err := q.WithContext(ctx).
+		Where(q.UserID.Eq(userID), q.Provider.Eq(provider)).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Identity]failed to delete identity: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		return domain.ErrIdentityNotFound
+	}
+	return nil
+}
+
+func (i *IdentityRepository) DeleteUserIdentities(ctx context.Context, userID uint64) error {
+	q := i.repo.query.UserIdentity
+	if _, err := q.WithContext(ctx).Where(q.UserID.Eq(userID)).Delete(); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Identity]failed to delete identities of user %d: %w", userID, err)
+	}
+	return nil
+}
+
+func toDomainIdentity(m *model.UserIdentity) *domain.Identity {
+	return &domain.Identity{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Provider:  m.Provider,
+		Subject:   m.Subject,
+		Email:     deref(m.Email),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func NewIdentityRepository(repo *Repository) domain.IdentityRepository {
+	return &IdentityRepository{
+		repo: repo,
+	}
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+)
+
+type MFARepository struct {
+	repo *Repository
+}
+
+func mfaUsedCodeKey(userID uint64, code string) string {
+	return fmt.Sprintf("USER:MFA:USED:%d:%s", userID, code)
+}
+
+func mfaFailKey(challengeID string) string {
+	return fmt.Sprintf("USER:MFA:FAIL:%s", challengeID)
+}
+
+func mfaChallengeKey(challengeID string) string {
+	return fmt.Sprintf("USER:MFA:CHALLENGE:%s", challengeID)
+}
+
+func (m *MFARepository) UseTOTPCode(ctx context.Context, userID uint64, code string, ttl time.Duration) (bool, error) {
+	ok, err := m.repo.rdb.SetNX(ctx, mfaUsedCodeKey(userID, code), 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.Repository.MFA]failed to record totp code: %w", err)
+	}
+	return ok, nil
+}
+
+func (m *MFARepository) FailChallenge(ctx context.Context, challengeID string, ttl time.Duration) (int64, error) {
+	key := mfaFailKey(challengeID)
+	pipe := m.repo.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("[Infrastructure.Repository.MFA]failed to record challenge failure: %w", err)
+	}
+	return incr.Val(), nil
+}
+
+func (m *MFARepository) ChallengeFailures(ctx context.Context, challengeID string) (int64, error) {
+	n, err := m.repo.rdb.Get(ctx, mfaFailKey(challengeID)).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("[Infrastructure.Repository.MFA]failed to get challenge failures: %w", err)
+	}
+	return n, nil
+}
+
+func (m *MFARepository) ConsumeChallenge(ctx context.Context, challengeID string, ttl time.Duration) (bool, error) {
+	if ttl <= 0 {
+		return false, nil
+	}
+	ok, err := m.repo.rdb.SetNX(ctx, mfaChallengeKey(challengeID), 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.Repository.MFA]failed to consume challenge: %w", err)
+	}
+	return ok, nil
+}
+
+func NewMFARepository(repo *Repository) domain.MFARepository {
+	return &MFARepository{
+		repo: repo,
+	}
+}
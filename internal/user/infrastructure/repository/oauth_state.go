@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+)
+
+type OAuthStateRepository struct {
+	repo *Repository
+}
+
+func oauthStateKey(state string) string {
+	return fmt.Sprintf("USER:OAUTH:STATE:%s", state)
+}
+
+func (o *OAuthStateRepository) SaveState(ctx context.Context, state *domain.OAuthState, ttl time.Duration) error {
+	key := oauthStateKey(state.State)
+	pipe := o.repo.rdb.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		"provider": state.Provider,
+		"verifier": state.Verifier,
+		"user_id":  state.UserID,
+	})
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.OAuthState]failed to save state: %w", err)
+	}
+	return nil
+}
+
+func (o *OAuthStateRepository) TakeState(ctx context.Context, state string) (*domain.OAuthState, error) {
+	if state == "" {
+		return nil, domain.ErrInvalidOAuthState
+	}
+	key := oauthStateKey(state)
+	pipe := o.repo.rdb.TxPipeline()
+	get := pipe.HGetAll(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("[Infrastructure.Repository.OAuthState]failed to take state: %w", err)
+	}
+	values := get.Val()
+	if len(values) == 0 {
+		return nil, domain.ErrInvalidOAuthState
+	}
+	userID, err := strconv.ParseUint(values["user_id"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.OAuthState]invalid state user id: %w", err)
+	}
+	return &domain.OAuthState{
+		State:    state,
+		Provider: values["provider"],
+		Verifier: values["verifier"],
+		UserID:   userID,
+	}, nil
+}
+
+func NewOAuthStateRepository(repo *Repository) domain.OAuthStateRepository {
+	return &OAuthStateRepository{
+		repo: repo,
+	}
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/model"
+	"github.com/Wenrh2004/lark-lite-server/pkg/cache"
+	"github.com/Wenrh2004/lark-lite-server/pkg/cache/client"
+)
+
+const preferenceCacheExpire = 10 * time.Minute
+
+// preferenceCacheEntry 偏好缓存条目，Preference 为空表示用户未保存过偏好
+type preferenceCacheEntry struct {
+	Preference *model.UserPreference `json:"preference,omitempty"`
+}
+
+type PreferenceRepository struct {
+	repo  *Repository
+	cache cache.MultiCache[*preferenceCacheEntry]
+}
+
+func preferenceCacheKey(userID uint64) string {
+	return fmt.Sprintf("user:preferences:%d", userID)
+}
+
+// invalidate 删除偏好缓存，失败只记录日志，由缓存过期兜底
+func (p *PreferenceRepository) invalidate(ctx context.Context, userID uint64) {
+	if err := p.cache.Del(ctx, preferenceCacheKey(userID)); err != nil {
+		p.repo.logger.WithContext(ctx).Warn("[Infrastructure.Repository.Preference]failed to invalidate preference cache",
+			zap.Uint64("user_id", userID), zap.Error(err))
+	}
+}
+
+// GetPreferences 先读缓存，未命中时回源数据库，用户未保存过偏好的结果同样会被缓存
+func (p *PreferenceRepository) GetPreferences(ctx context.Context, userID uint64) (domain.Preferences, int64, error) {
+	entry, err := p.cache.GetAndSingleSet(ctx, preferenceCacheKey(userID), preferenceCacheExpire, func() (*preferenceCacheEntry, error) {
+		res, err := p.repo.query.UserPreference.WithContext(ctx).
+			Where(p.repo.query.UserPreference.UserID.Eq(userID)).
+			First()
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &preferenceCacheEntry{}, nil
+			}
+			return nil, err
+		}
+		return &preferenceCacheEntry{Preference: res}, nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("[Infrastructure.Repository.Preference]failed to get preferences: %w", err)
+	}
+	if entry == nil || entry.Preference == nil {
+		return nil, 0, nil
+	}
+	var prefs domain.Preferences
+	if err := json.Unmarshal([]byte(entry.Preference.Data), &prefs); err != nil {
+		return nil, 0, fmt.Errorf("[Infrastructure.Repository.Preference]invalid preference data: %w", err)
+	}
+	return prefs, entry.Preference.Version, nil
+}
+
+func (p *PreferenceRepository) SavePreferences(ctx context.Context, userID uint64, prefs domain.Preferences, version int64) error {
+	data, err := json.Marshal(prefs)
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Preference]failed to encode preferences: %w", err)
+	}
+	defer p.invalidate(ctx, userID)
+	q := p.repo.query.UserPreference
+	if version == 0 {
+		now := time.Now()
+		err := q.WithContext(ctx).Create(&model.UserPreference{
+			UserID:    userID,
+			Data:      string(data),
+			Version:   1,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if err == nil {
+			return nil
+		}
+		// 并发请求已先创建了该用户的偏好
+		if count, cerr := q.WithContext(ctx).Where(q.UserID.Eq(userID)).Count(); cerr == nil && count > 0 {
+			return domain.ErrPreferenceConflict
+		}
+		return fmt.Errorf("[Infrastructure.Repository.Preference]failed to create preferences: %w", err)
+	}
+	info, err := q.WithContext(ctx).
+		Where(q.UserID.Eq(userID), q.Version.Eq(version)).
+		Updates(map[string]interface{}{
+			"data":       string(data),
+			"version":    version + 1,
+			"updated_at": time.Now(),
+		})
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Preference]failed to update preferences: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		return domain.ErrPreferenceConflict
+	}
+	return nil
+}
+
+func (p *PreferenceRepository) DeletePreferences(ctx context.Context, userID uint64) error {
+	_, err := p.repo.query.UserPreference.WithContext(ctx).
+		Where(p.repo.query.UserPreference.UserID.Eq(userID)).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Preference]failed to delete preferences: %w", err)
+	}
+	p.invalidate(ctx, userID)
+	return nil
+}
+
+func NewPreferenceRepository(conf *viper.Viper, repo *Repository, caches []client.Cache) domain.PreferenceRepository {
+	return &PreferenceRepository{
+		repo:  repo,
+		cache: cache.NewMultiCache[*preferenceCacheEntry](conf, caches),
+	}
+}
@@ -16,34 +16,59 @@ import (
 )
 
 var (
-	Q    = new(Query)
-	User *user
+	Q                   = new(Query)
+	PersonalAccessToken *personalAccessToken
+	User                *user
+	UserAuditLog        *userAuditLog
+	UserDataJob         *userDataJob
+	UserPreference      *userPreference
+	UserIdentity        *userIdentity
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	PersonalAccessToken = &Q.PersonalAccessToken
 	User = &Q.User
+	UserAuditLog = &Q.UserAuditLog
+	UserDataJob = &Q.UserDataJob
+	UserPreference = &Q.UserPreference
+	UserIdentity = &Q.UserIdentity
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:   db,
-		User: newUser(db, opts...),
+		db:                  db,
+		PersonalAccessToken: newPersonalAccessToken(db, opts...),
+		User:                newUser(db, opts...),
+		UserAuditLog:        newUserAuditLog(db, opts...),
+		UserDataJob:         newUserDataJob(db, opts...),
+		UserPreference:      newUserPreference(db, opts...),
+		UserIdentity:        newUserIdentity(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	User user
+	PersonalAccessToken personalAccessToken
+	User                user
+	UserAuditLog        userAuditLog
+	UserDataJob         userDataJob
+	UserPreference      userPreference
+	UserIdentity        userIdentity
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		User: q.User.clone(db),
+		db:                  db,
+		PersonalAccessToken: q.PersonalAccessToken.clone(db),
+		User:                q.User.clone(db),
+		UserAuditLog:        q.UserAuditLog.clone(db),
+		UserDataJob:         q.UserDataJob.clone(db),
+		UserPreference:      q.UserPreference.clone(db),
+		UserIdentity:        q.UserIdentity.clone(db),
 	}
 }
 
@@ -57,18 +82,33 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		User: q.User.replaceDB(db),
+		db:                  db,
+		PersonalAccessToken: q.PersonalAccessToken.replaceDB(db),
+		User:                q.User.replaceDB(db),
+		UserAuditLog:        q.UserAuditLog.replaceDB(db),
+		UserDataJob:         q.UserDataJob.replaceDB(db),
+		UserPreference:      q.UserPreference.replaceDB(db),
+		UserIdentity:        q.UserIdentity.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	User IUserDo
+	PersonalAccessToken IPersonalAccessTokenDo
+	User                IUserDo
+	UserAuditLog        IUserAuditLogDo
+	UserDataJob         IUserDataJobDo
+	UserPreference      IUserPreferenceDo
+	UserIdentity        IUserIdentityDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		User: q.User.WithContext(ctx),
+		PersonalAccessToken: q.PersonalAccessToken.WithContext(ctx),
+		User:                q.User.WithContext(ctx),
+		UserAuditLog:        q.UserAuditLog.WithContext(ctx),
+		UserDataJob:         q.UserDataJob.WithContext(ctx),
+		UserPreference:      q.UserPreference.WithContext(ctx),
+		UserIdentity:        q.UserIdentity.WithContext(ctx),
 	}
 }
 
@@ -34,10 +34,21 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 	_user.Nickname = field.NewString(tableName, "nickname")
 	_user.AvatarURL = field.NewString(tableName, "avatar_url")
 	_user.BackgroundURL = field.NewString(tableName, "background_url")
+	_user.AvatarFileID = field.NewUint64(tableName, "avatar_file_id")
+	_user.BackgroundFileID = field.NewUint64(tableName, "background_file_id")
 	_user.Signature = field.NewString(tableName, "signature")
 	_user.Email = field.NewString(tableName, "email")
 	_user.Phone = field.NewString(tableName, "phone")
 	_user.Gender = field.NewField(tableName, "gender")
+	_user.EmailVerifiedAt = field.NewTime(tableName, "email_verified_at")
+	_user.PhoneVerifiedAt = field.NewTime(tableName, "phone_verified_at")
+	_user.TotpSecret = field.NewString(tableName, "totp_secret")
+	_user.TotpEnabledAt = field.NewTime(tableName, "totp_enabled_at")
+	_user.RecoveryCodes = field.NewString(tableName, "recovery_codes")
+	_user.Status = field.NewString(tableName, "status")
+	_user.SuspendedUntil = field.NewTime(tableName, "suspended_until")
+	_user.StatusReason = field.NewString(tableName, "status_reason")
+	_user.StatusChangedAt = field.NewTime(tableName, "status_changed_at")
 	_user.CreatedAt = field.NewTime(tableName, "created_at")
 	_user.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_user.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -50,20 +61,31 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 type user struct {
 	userDo
 
-	ALL           field.Asterisk
-	ID            field.Uint64
-	Username      field.String // 登录用户名，唯一
-	Password      field.String // 哈希后的密码
-	Nickname      field.String // 昵称，默认同 username
-	AvatarURL     field.String // 头像地址
-	BackgroundURL field.String // 背景图地址
-	Signature     field.String // 个性签名
-	Email         field.String // 邮箱，可用于找回密码
-	Phone         field.String // 手机号
-	Gender        field.Field  // 性别：0 未知，1 男，2 女
-	CreatedAt     field.Time
-	UpdatedAt     field.Time
-	DeletedAt     field.Field
+	ALL              field.Asterisk
+	ID               field.Uint64
+	Username         field.String // 登录用户名，唯一
+	Password         field.String // 哈希后的密码
+	Nickname         field.String // 昵称，默认同 username
+	AvatarURL        field.String // 头像地址
+	BackgroundURL    field.String // 背景图地址
+	AvatarFileID     field.Uint64 // 头像文件 ID，非空时优先于 avatar_url
+	BackgroundFileID field.Uint64 // 背景图文件 ID，非空时优先于 background_url
+	Signature        field.String // 个性签名
+	Email            field.String // 邮箱，可用于找回密码
+	Phone            field.String // 手机号
+	Gender           field.Field  // 性别：0 未知，1 男，2 女
+	EmailVerifiedAt  field.Time   // 邮箱验证时间，为空表示未验证
+	PhoneVerifiedAt  field.Time   // 手机号验证时间，为空表示未验证
+	TotpSecret       field.String // TOTP 密钥，启用或待确认时非空
+	TotpEnabledAt    field.Time   // TOTP 启用时间，为空表示未启用
+	RecoveryCodes    field.String // 二次验证恢复码哈希，JSON 数组
+	Status           field.String // 账号状态：active、suspended、banned、deleted
+	SuspendedUntil   field.Time   // 封禁截止时间，仅 suspended 状态有效
+	StatusReason     field.String // 最近一次状态变更原因
+	StatusChangedAt  field.Time   // 最近一次状态变更时间
+	CreatedAt        field.Time
+	UpdatedAt        field.Time
+	DeletedAt        field.Field
 
 	fieldMap map[string]field.Expr
 }
@@ -86,10 +108,21 @@ func (u *user) updateTableName(table string) *user {
 	u.Nickname = field.NewString(table, "nickname")
 	u.AvatarURL = field.NewString(table, "avatar_url")
 	u.BackgroundURL = field.NewString(table, "background_url")
+	u.AvatarFileID = field.NewUint64(table, "avatar_file_id")
+	u.BackgroundFileID = field.NewUint64(table, "background_file_id")
 	u.Signature = field.NewString(table, "signature")
 	u.Email = field.NewString(table, "email")
 	u.Phone = field.NewString(table, "phone")
 	u.Gender = field.NewField(table, "gender")
+	u.EmailVerifiedAt = field.NewTime(table, "email_verified_at")
+	u.PhoneVerifiedAt = field.NewTime(table, "phone_verified_at")
+	u.TotpSecret = field.NewString(table, "totp_secret")
+	u.TotpEnabledAt = field.NewTime(table, "totp_enabled_at")
+	u.RecoveryCodes = field.NewString(table, "recovery_codes")
+	u.Status = field.NewString(table, "status")
+	u.SuspendedUntil = field.NewTime(table, "suspended_until")
+	u.StatusReason = field.NewString(table, "status_reason")
+	u.StatusChangedAt = field.NewTime(table, "status_changed_at")
 	u.CreatedAt = field.NewTime(table, "created_at")
 	u.UpdatedAt = field.NewTime(table, "updated_at")
 	u.DeletedAt = field.NewField(table, "deleted_at")
@@ -109,17 +142,28 @@ func (u *user) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *user) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 13)
+	u.fieldMap = make(map[string]field.Expr, 24)
 	u.fieldMap["id"] = u.ID
 	u.fieldMap["username"] = u.Username
 	u.fieldMap["password"] = u.Password
 	u.fieldMap["nickname"] = u.Nickname
 	u.fieldMap["avatar_url"] = u.AvatarURL
 	u.fieldMap["background_url"] = u.BackgroundURL
+	u.fieldMap["avatar_file_id"] = u.AvatarFileID
+	u.fieldMap["background_file_id"] = u.BackgroundFileID
 	u.fieldMap["signature"] = u.Signature
 	u.fieldMap["email"] = u.Email
 	u.fieldMap["phone"] = u.Phone
 	u.fieldMap["gender"] = u.Gender
+	u.fieldMap["email_verified_at"] = u.EmailVerifiedAt
+	u.fieldMap["phone_verified_at"] = u.PhoneVerifiedAt
+	u.fieldMap["totp_secret"] = u.TotpSecret
+	u.fieldMap["totp_enabled_at"] = u.TotpEnabledAt
+	u.fieldMap["recovery_codes"] = u.RecoveryCodes
+	u.fieldMap["status"] = u.Status
+	u.fieldMap["suspended_until"] = u.SuspendedUntil
+	u.fieldMap["status_reason"] = u.StatusReason
+	u.fieldMap["status_changed_at"] = u.StatusChangedAt
 	u.fieldMap["created_at"] = u.CreatedAt
 	u.fieldMap["updated_at"] = u.UpdatedAt
 	u.fieldMap["deleted_at"] = u.DeletedAt
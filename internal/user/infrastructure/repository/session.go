@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+)
+
+// rotateSessionScript 原子地比较并替换会话当前的刷新令牌 jti
+// 返回 0 表示会话不存在，-1 表示刷新令牌不匹配，1 表示轮换成功
+var rotateSessionScript = redis.NewScript(`
+local current = redis.call('HGET', KEYS[1], 'refresh_id')
+if not current then
+	return 0
+end
+if current ~= ARGV[1] then
+	return -1
+end
+redis.call('HSET', KEYS[1], 'refresh_id', ARGV[2], 'last_seen_at', ARGV[4])
+if ARGV[5] ~= '' then
+	redis.call('HSET', KEYS[1], 'ip', ARGV[5])
+end
+redis.call('PEXPIREAT', KEYS[1], ARGV[3])
+redis.call('PEXPIREAT', KEYS[2], ARGV[3])
+return 1
+`)
+
+// userDevicesExpire 设备记录的保留时长，超过该时长未登录的设备再次登录时视为新设备
+const userDevicesExpire = 180 * 24 * time.Hour
+
+type SessionRepository struct {
+	repo *Repository
+}
+
+func sessionKey(id string) string {
+	return fmt.Sprintf("USER:SESSION:%s", id)
+}
+
+// userSessionsKey 用户的会话索引，成员为会话 ID，可能包含已过期的会话
+func userSessionsKey(userID uint64) string {
+	return fmt.Sprintf("USER:SESSIONS:%d", userID)
+}
+
+// userDevicesKey 用户登录过的设备名称集合
+func userDevicesKey(userID uint64) string {
+	return fmt.Sprintf("USER:DEVICES:%d", userID)
+}
+
+func (s *SessionRepository) CreateSession(ctx context.Context, session *domain.Session) error {
+	key := sessionKey(session.ID)
+	pipe := s.repo.rdb.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		"user_id":      session.UserID,
+		"refresh_id":   session.RefreshID,
+		"device":       session.Device,
+		"user_agent":   session.UserAgent,
+		"ip":           session.IP,
+		"created_at":   session.CreatedAt.UnixMilli(),
+		"last_seen_at": session.LastSeenAt.UnixMilli(),
+	})
+	pipe.ExpireAt(ctx, key, session.ExpiresAt)
+	pipe.SAdd(ctx, userSessionsKey(session.UserID), session.ID)
+	pipe.ExpireAt(ctx, userSessionsKey(session.UserID), session.ExpiresAt)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Session]failed to create session: %w", err)
+	}
+	return nil
+}
+
+func (s *SessionRepository) RotateSession(ctx context.Context, session *domain.Session, refreshID string) error {
+	res, err := rotateSessionScript.Run(ctx, s.repo.rdb,
+		[]string{sessionKey(session.ID), userSessionsKey(session.UserID)},
+		session.RefreshID, refreshID, session.ExpiresAt.UnixMilli(), session.LastSeenAt.UnixMilli(), session.IP,
+	).Int()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Session]failed to rotate session: %w", err)
+	}
+	switch res {
+	case 0:
+		return domain.ErrSessionNotFound
+	case -1:
+		return domain.ErrRefreshTokenReused
+	default:
+		return nil
+	}
+}
+
+func (s *SessionRepository) GetSession(ctx context.Context, id string) (*domain.Session, error) {
+	values, err := s.repo.rdb.HGetAll(ctx, sessionKey(id)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Session]failed to get session: %w", err)
+	}
+	if len(values) == 0 {
+		return nil, domain.ErrSessionNotFound
+	}
+	return toDomainSession(id, values)
+}
+
+func (s *SessionRepository) ListSessions(ctx context.Context, userID uint64) ([]*domain.Session, error) {
+	ids, err := s.repo.rdb.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Session]failed to list user sessions: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	pipe := s.repo.rdb.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(ids))
+	for _, id := range ids {
+		cmds = append(cmds, pipe.HGetAll(ctx, sessionKey(id)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Session]failed to get sessions: %w", err)
+	}
+	sessions := make([]*domain.Session, 0, len(ids))
+	var expired []interface{}
+	for i, cmd := range cmds {
+		values := cmd.Val()
+		if len(values) == 0 {
+			expired = append(expired, ids[i])
+			continue
+		}
+		session, err := toDomainSession(ids[i], values)
+		if err != nil {
+			return nil, err
+		}
+		if session.UserID != userID {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	// 清理索引中已过期的会话，失败不影响查询结果
+	if len(expired) > 0 {
+		_ = s.repo.rdb.SRem(ctx, userSessionsKey(userID), expired...).Err()
+	}
+	return sessions, nil
+}
+
+func toDomainSession(id string, values map[string]string) (*domain.Session, error) {
+	userID, err := strconv.ParseUint(values["user_id"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Session]invalid session user id: %w", err)
+	}
+	return &domain.Session{
+		ID:         id,
+		UserID:     userID,
+		RefreshID:  values["refresh_id"],
+		Device:     values["device"],
+		UserAgent:  values["user_agent"],
+		IP:         values["ip"],
+		CreatedAt:  parseUnixMilli(values["created_at"]),
+		LastSeenAt: parseUnixMilli(values["last_seen_at"]),
+	}, nil
+}
+
+// parseUnixMilli 解析会话中保存的毫秒时间戳，值缺失或无法解析时返回零值
+func parseUnixMilli(v string) time.Time {
+	ms, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || ms <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+func (s *SessionRepository) RememberDevice(ctx context.Context, userID uint64, device string) (bool, error) {
+	key := userDevicesKey(userID)
+	pipe := s.repo.rdb.TxPipeline()
+	count := pipe.SCard(ctx, key)
+	added := pipe.SAdd(ctx, key, device)
+	pipe.Expire(ctx, key, userDevicesExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, fmt.Errorf("[Infrastructure.Repository.Session]failed to remember device: %w", err)
+	}
+	return added.Val() > 0 && count.Val() > 0, nil
+}
+
+func (s *SessionRepository) DeleteUserDevices(ctx context.Context, userID uint64) error {
+	if err := s.repo.rdb.Del(ctx, userDevicesKey(userID)).Err(); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Session]failed to delete user devices: %w", err)
+	}
+	return nil
+}
+
+func (s *SessionRepository) DeleteSession(ctx context.Context, id string) error {
+	session, err := s.GetSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return nil
+		}
+		return err
+	}
+	pipe := s.repo.rdb.TxPipeline()
+	pipe.Del(ctx, sessionKey(id))
+	pipe.SRem(ctx, userSessionsKey(session.UserID), id)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Session]failed to delete session: %w", err)
+	}
+	return nil
+}
+
+func (s *SessionRepository) DeleteUserSessions(ctx context.Context, userID uint64) error {
+	ids, err := s.repo.rdb.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Session]failed to list user sessions: %w", err)
+	}
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, sessionKey(id))
+	}
+	keys = append(keys, userSessionsKey(userID))
+	if err := s.repo.rdb.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Session]failed to delete user sessions: %w", err)
+	}
+	return nil
+}
+
+func NewSessionRepository(repo *Repository) domain.SessionRepository {
+	return &SessionRepository{
+		repo: repo,
+	}
+}
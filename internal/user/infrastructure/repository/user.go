@@ -2,59 +2,560 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
 
 	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
 	"github.com/Wenrh2004/lark-lite-server/internal/user/infrastructure/model"
+	"github.com/Wenrh2004/lark-lite-server/pkg/cache"
+	"github.com/Wenrh2004/lark-lite-server/pkg/cache/client"
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
+
+const (
+	userCacheExpire = 10 * time.Minute
+	// userNotFoundCacheExpire 不存在的用户只短暂缓存，用于吸收按 ID 探测的流量，同时不影响新注册用户的可见性
+	userNotFoundCacheExpire = 30 * time.Second
 )
 
+// userCacheEntry 用户缓存条目，User 为空表示该 ID 的用户不存在，
+// User 不包含密码哈希、TOTP 密钥与恢复码，见 sanitizeUser
+type userCacheEntry struct {
+	User *model.User `json:"user,omitempty"`
+}
+
+// sanitizeUser 返回去除凭证字段后的用户副本用于写入缓存，TOTP 只保留是否启用
+func sanitizeUser(m *model.User) *model.User {
+	res := *m
+	res.Password = ""
+	res.TotpSecret = nil
+	res.RecoveryCodes = nil
+	return &res
+}
+
 type UserRepository struct {
-	repo *Repository
+	repo  *Repository
+	cache cache.MultiCache[*userCacheEntry]
+}
+
+func userCacheKey(id uint64) string {
+	return fmt.Sprintf("user:%d", id)
+}
+
+// invalidate 删除用户缓存，用户行的每次写入都需要调用，失败只记录日志，由缓存过期兜底
+func (u *UserRepository) invalidate(ctx context.Context, id uint64) {
+	if err := u.cache.Del(ctx, userCacheKey(id)); err != nil {
+		u.repo.logger.WithContext(ctx).Warn("[Infrastructure.Repository.User]failed to invalidate user cache",
+			zap.Uint64("user_id", id), zap.Error(err))
+	}
 }
 
 func (u *UserRepository) CreateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
-	if err := u.repo.query.User.WithContext(ctx).Create(&model.User{
+	m := &model.User{
 		ID:       user.ID,
 		Username: user.Username.String(),
 		Password: user.Password.String(),
 		Nickname: user.Nickname.String(),
-	}); err != nil {
+		Status:   string(domain.UserStatusActive),
+	}
+	now := time.Now()
+	if user.AvatarURL != "" {
+		m.AvatarURL = &user.AvatarURL
+	}
+	if user.Email != "" {
+		m.Email = &user.Email
+		if user.EmailVerified {
+			m.EmailVerifiedAt = &now
+		}
+	}
+	if user.Phone != "" {
+		m.Phone = &user.Phone
+		if user.PhoneVerified {
+			m.PhoneVerifiedAt = &now
+		}
+	}
+	if err := u.repo.query.User.WithContext(ctx).Create(m); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, domain.ErrUserAlreadyExists
+		}
 		return nil, fmt.Errorf("[Infrastructure.Repository.User]failed to create user: %w", err)
 	}
+	// 清除注册前可能缓存的不存在结果
+	u.invalidate(ctx, user.ID)
 	return user, nil
 }
 
-func (u *UserRepository) UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
+func (u *UserRepository) UpdateProfile(ctx context.Context, user *domain.User, mask []domain.ProfileField, prev time.Time) error {
 	update := map[string]interface{}{
-		"nickname":       user.Nickname.String(),
-		"avatar_url":     user.AvatarURL,
-		"background_url": user.BackgroundURL,
-		"signature":      user.Signature,
-		"email":          user.Email,
-		"phone":          user.Phone,
-		"gender":         byte(user.Gender),
+		"updated_at": user.UpdatedAt,
+	}
+	for _, f := range mask {
+		switch f {
+		case domain.ProfileNickname:
+			update["nickname"] = user.Nickname.String()
+		case domain.ProfileAvatarFileID:
+			update["avatar_file_id"] = nullableID(user.AvatarFileID)
+			update["avatar_url"] = nullableString(user.AvatarURL)
+		case domain.ProfileBackgroundFileID:
+			update["background_file_id"] = nullableID(user.BackgroundFileID)
+			update["background_url"] = nullableString(user.BackgroundURL)
+		case domain.ProfileSignature:
+			update["signature"] = nullableString(user.Signature)
+		case domain.ProfileEmail:
+			update["email"] = nullableString(user.Email)
+			if !user.EmailVerified {
+				update["email_verified_at"] = nil
+			}
+		case domain.ProfilePhone:
+			update["phone"] = nullableString(user.Phone)
+			if !user.PhoneVerified {
+				update["phone_verified_at"] = nil
+			}
+		case domain.ProfileGender:
+			update["gender"] = byte(user.Gender)
+		}
 	}
+	q := u.repo.query.User
+	info, err := q.WithContext(ctx).
+		Where(q.ID.Eq(user.ID), q.UpdatedAt.Eq(prev)).
+		Updates(update)
+	// 更新冲突说明缓存中的版本可能已过期，同样需要清除
+	u.invalidate(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.User]failed to update user: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		return domain.ErrUpdateConflict
+	}
+	return nil
+}
+
+func (u *UserRepository) UpdatePassword(ctx context.Context, id uint64, password domain.Password) error {
 	_, err := u.repo.query.User.WithContext(ctx).
-		Where(u.repo.query.User.ID.Eq(user.ID)).
+		Where(u.repo.query.User.ID.Eq(id)).
+		Update(u.repo.query.User.Password, password.String())
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.User]failed to update password: %w", err)
+	}
+	u.invalidate(ctx, id)
+	return nil
+}
+
+func (u *UserRepository) VerifyEmail(ctx context.Context, id uint64, email string, at time.Time) error {
+	_, err := u.repo.query.User.WithContext(ctx).
+		Where(u.repo.query.User.ID.Eq(id)).
+		Updates(map[string]interface{}{
+			"email":             email,
+			"email_verified_at": at,
+		})
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.User]failed to verify email: %w", err)
+	}
+	u.invalidate(ctx, id)
+	return nil
+}
+
+func (u *UserRepository) UpdateTOTP(ctx context.Context, id uint64, totp domain.TOTP) error {
+	update := map[string]interface{}{
+		"totp_secret":     nil,
+		"totp_enabled_at": nil,
+		"recovery_codes":  nil,
+	}
+	if totp.Secret != "" {
+		update["totp_secret"] = totp.Secret
+	}
+	if totp.Enabled {
+		update["totp_enabled_at"] = time.Now()
+	}
+	if len(totp.RecoveryCodes) > 0 {
+		codes, err := json.Marshal(totp.RecoveryCodes)
+		if err != nil {
+			return fmt.Errorf("[Infrastructure.Repository.User]failed to marshal recovery codes: %w", err)
+		}
+		update["recovery_codes"] = string(codes)
+	}
+	_, err := u.repo.query.User.WithContext(ctx).
+		Where(u.repo.query.User.ID.Eq(id)).
 		Updates(update)
 	if err != nil {
-		return nil, fmt.Errorf("[Infrastructure.Repository.User]failed to update user: %w", err)
+		return fmt.Errorf("[Infrastructure.Repository.User]failed to update totp: %w", err)
 	}
-	return user, nil
+	u.invalidate(ctx, id)
+	return nil
 }
 
+// ConsumeRecoveryCode 以读取到的恢复码列表为条件更新，并发使用同一恢复码时只有一个请求成功
+func (u *UserRepository) ConsumeRecoveryCode(ctx context.Context, id uint64, hash string) (bool, error) {
+	q := u.repo.query.User
+	res, err := q.WithContext(ctx).
+		Select(q.RecoveryCodes).
+		Where(q.ID.Eq(id), q.TotpEnabledAt.IsNotNull()).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("[Infrastructure.Repository.User]failed to get recovery codes: %w", err)
+	}
+	if res.RecoveryCodes == nil {
+		return false, nil
+	}
+	codes := parseRecoveryCodes(res.RecoveryCodes)
+	remaining := make([]string, 0, len(codes))
+	found := false
+	for _, c := range codes {
+		if !found && c == hash {
+			found = true
+			continue
+		}
+		remaining = append(remaining, c)
+	}
+	if !found {
+		return false, nil
+	}
+	var value interface{}
+	if len(remaining) > 0 {
+		b, err := json.Marshal(remaining)
+		if err != nil {
+			return false, fmt.Errorf("[Infrastructure.Repository.User]failed to marshal recovery codes: %w", err)
+		}
+		value = string(b)
+	}
+	info, err := q.WithContext(ctx).
+		Where(q.ID.Eq(id), q.RecoveryCodes.Eq(*res.RecoveryCodes)).
+		Update(q.RecoveryCodes, value)
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.Repository.User]failed to consume recovery code: %w", err)
+	}
+	u.invalidate(ctx, id)
+	return info.RowsAffected == 1, nil
+}
+
+func (u *UserRepository) GetUserByVerifiedEmail(ctx context.Context, email string) (*domain.User, error) {
+	res, err := u.repo.query.User.WithContext(ctx).
+		Where(u.repo.query.User.Email.Eq(email), u.repo.query.User.EmailVerifiedAt.IsNotNull()).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.User]failed to get user by email: %w", err)
+	}
+	return toDomainUser(res), nil
+}
+
+func (u *UserRepository) GetUserByVerifiedPhone(ctx context.Context, phone string) (*domain.User, error) {
+	res, err := u.repo.query.User.WithContext(ctx).
+		Where(u.repo.query.User.Phone.Eq(phone), u.repo.query.User.PhoneVerifiedAt.IsNotNull()).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.User]failed to get user by phone: %w", err)
+	}
+	return toDomainUser(res), nil
+}
+
+func (u *UserRepository) ReleasePendingPhone(ctx context.Context, phone string) error {
+	q := u.repo.query.User
+	var ids []uint64
+	if err := q.WithContext(ctx).
+		Where(q.Phone.Eq(phone), q.PhoneVerifiedAt.IsNull()).
+		Pluck(q.ID, &ids); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.User]failed to find pending phone: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := q.WithContext(ctx).
+		Where(q.ID.In(ids...), q.Phone.Eq(phone), q.PhoneVerifiedAt.IsNull()).
+		Update(q.Phone, nil); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.User]failed to release pending phone: %w", err)
+	}
+	for _, id := range ids {
+		u.invalidate(ctx, id)
+	}
+	return nil
+}
+
+// UpdateStatus 保存账号状态，状态为 deleted 时同时软删除账号，其余状态会清除软删除标记
+func (u *UserRepository) UpdateStatus(ctx context.Context, user *domain.User) error {
+	update := map[string]interface{}{
+		"status":            string(user.Status),
+		"suspended_until":   nil,
+		"status_reason":     user.StatusReason,
+		"status_changed_at": user.StatusChangedAt,
+		"deleted_at":        nil,
+	}
+	if user.Status == domain.UserStatusSuspended {
+		update["suspended_until"] = user.SuspendedUntil
+	}
+	if user.Status == domain.UserStatusDeleted {
+		update["deleted_at"] = user.StatusChangedAt
+	}
+	q := u.repo.query.User
+	if _, err := q.WithContext(ctx).Unscoped().Where(q.ID.Eq(user.ID)).Updates(update); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.User]failed to update status of user %d: %w", user.ID, err)
+	}
+	u.invalidate(ctx, user.ID)
+	return nil
+}
+
+func (u *UserRepository) DeleteUser(ctx context.Context, id uint64) error {
+	q := u.repo.query.User
+	if _, err := q.WithContext(ctx).Unscoped().Where(q.ID.Eq(id)).Delete(); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.User]failed to delete user %d: %w", id, err)
+	}
+	u.invalidate(ctx, id)
+	return nil
+}
+
+func (u *UserRepository) GetUserByIDWithDeleted(ctx context.Context, id uint64) (*domain.User, error) {
+	q := u.repo.query.User
+	res, err := q.WithContext(ctx).Unscoped().Where(q.ID.Eq(id)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.User]failed to get user %d: %w", id, err)
+	}
+	return toDomainUser(res), nil
+}
+
+// GetUserByID 先读缓存，未命中时回源数据库并写入缓存，同一 ID 的并发回源只会查询一次
 func (u *UserRepository) GetUserByID(ctx context.Context, id uint64) (*domain.User, error) {
-	// TODO implement me
-	panic("implement me")
+	key := userCacheKey(id)
+	entry, err := u.cache.GetAndSingleSet(ctx, key, userCacheExpire, func() (*userCacheEntry, error) {
+		res, err := u.repo.query.User.WithContext(ctx).
+			Where(u.repo.query.User.ID.Eq(id)).
+			First()
+		if err != nil {
+			return nil, err
+		}
+		return &userCacheEntry{User: sanitizeUser(res)}, nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := u.cache.Set(ctx, key, &userCacheEntry{}, userNotFoundCacheExpire); err != nil {
+				u.repo.logger.WithContext(ctx).Warn("[Infrastructure.Repository.User]failed to cache missing user",
+					zap.Uint64("user_id", id), zap.Error(err))
+			}
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.User]failed to get user %d: %w", id, err)
+	}
+	if entry == nil || entry.User == nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return toDomainUser(entry.User), nil
+}
+
+// GetUserWithCredentials 不经过缓存直接查询数据库，账号状态与凭证以数据库为准
+func (u *UserRepository) GetUserWithCredentials(ctx context.Context, id uint64) (*domain.User, error) {
+	q := u.repo.query.User
+	res, err := q.WithContext(ctx).Where(q.ID.Eq(id)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.User]failed to get user %d: %w", id, err)
+	}
+	return toDomainUser(res), nil
 }
 
+// GetUser 按 user 中的非零字段（ID、用户名、邮箱、手机号）查询用户
 func (u *UserRepository) GetUser(ctx context.Context, user *domain.User) (*domain.User, error) {
-	// TODO implement me
-	panic("implement me")
+	q := u.repo.query.User
+	do := q.WithContext(ctx)
+	conditions := 0
+	if user.ID != 0 {
+		do = do.Where(q.ID.Eq(user.ID))
+		conditions++
+	}
+	if user.Username != "" {
+		do = do.Where(q.Username.Eq(user.Username.String()))
+		conditions++
+	}
+	if user.Email != "" {
+		do = do.Where(q.Email.Eq(user.Email))
+		conditions++
+	}
+	if user.Phone != "" {
+		do = do.Where(q.Phone.Eq(user.Phone))
+		conditions++
+	}
+	if conditions == 0 {
+		return nil, errors.New("[Infrastructure.Repository.User]get user without conditions")
+	}
+	res, err := do.First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.User]failed to get user: %w", err)
+	}
+	return toDomainUser(res), nil
+}
+
+func (u *UserRepository) ListUsers(ctx context.Context, filter domain.UserFilter, sort domain.UserSort, p page.Page) ([]*domain.User, int64, error) {
+	q := u.repo.query.User
+	do := q.WithContext(ctx)
+	now := time.Now()
+	switch filter.Status {
+	case domain.UserStatusActive:
+		do = do.Where(q.WithContext(ctx).
+			Where(q.Status.Eq(string(domain.UserStatusActive))).
+			Or(q.Status.Eq(string(domain.UserStatusSuspended)), q.SuspendedUntil.Lte(now)))
+	case domain.UserStatusSuspended:
+		do = do.Where(q.Status.Eq(string(domain.UserStatusSuspended)), q.SuspendedUntil.Gt(now))
+	case domain.UserStatusBanned:
+		do = do.Where(q.Status.Eq(string(domain.UserStatusBanned)))
+	case domain.UserStatusDeleted:
+		do = do.Unscoped().Where(q.DeletedAt.IsNotNull())
+	}
+	if filter.UsernamePrefix != "" {
+		do = do.Where(q.Username.Like(escapeLike(filter.UsernamePrefix) + "%"))
+	}
+	if filter.NicknamePrefix != "" {
+		do = do.Where(q.Nickname.Like(escapeLike(filter.NicknamePrefix) + "%"))
+	}
+	if filter.Email != "" {
+		do = do.Where(q.Email.Eq(filter.Email))
+	}
+	if filter.Phone != "" {
+		do = do.Where(q.Phone.Eq(filter.Phone))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		do = do.Where(q.CreatedAt.Gte(filter.CreatedFrom))
+	}
+	if !filter.CreatedTo.IsZero() {
+		do = do.Where(q.CreatedAt.Lt(filter.CreatedTo))
+	}
+	col, ok := q.GetFieldByName(string(sort.Field))
+	if !ok {
+		return nil, 0, fmt.Errorf("[Infrastructure.Repository.User]unknown sort field %q", sort.Field)
+	}
+	// 以 ID 作为次级排序保证分页结果稳定
+	if sort.Desc {
+		do = do.Order(col.Desc(), q.ID.Desc())
+	} else {
+		do = do.Order(col, q.ID)
+	}
+	res, total, err := do.FindByPage(p.Offset, p.Limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("[Infrastructure.Repository.User]failed to list users: %w", err)
+	}
+	users := make([]*domain.User, 0, len(res))
+	for _, m := range res {
+		users = append(users, toDomainUser(m))
+	}
+	return users, total, nil
+}
+
+// escapeLike 转义 LIKE 模式中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+func toDomainUser(m *model.User) *domain.User {
+	return &domain.User{
+		ID:               m.ID,
+		Username:         domain.Username(m.Username),
+		Password:         domain.Password(m.Password),
+		Nickname:         domain.Username(m.Nickname),
+		AvatarURL:        deref(m.AvatarURL),
+		BackgroundURL:    deref(m.BackgroundURL),
+		AvatarFileID:     derefID(m.AvatarFileID),
+		BackgroundFileID: derefID(m.BackgroundFileID),
+		Signature:        deref(m.Signature),
+		Email:            deref(m.Email),
+		EmailVerified:    m.EmailVerifiedAt != nil,
+		Phone:            deref(m.Phone),
+		PhoneVerified:    m.PhoneVerifiedAt != nil,
+		Gender:           domain.Gender(m.Gender),
+		TOTP: domain.TOTP{
+			Secret:        deref(m.TotpSecret),
+			Enabled:       m.TotpEnabledAt != nil,
+			RecoveryCodes: parseRecoveryCodes(m.RecoveryCodes),
+		},
+		Status:          toDomainUserStatus(m),
+		SuspendedUntil:  derefTime(m.SuspendedUntil),
+		StatusReason:    deref(m.StatusReason),
+		StatusChangedAt: derefTime(m.StatusChangedAt),
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+func toDomainUserStatus(m *model.User) domain.UserStatus {
+	if m.DeletedAt.Valid {
+		return domain.UserStatusDeleted
+	}
+	if m.Status == "" {
+		return domain.UserStatusActive
+	}
+	return domain.UserStatus(m.Status)
+}
+
+func parseRecoveryCodes(s *string) []string {
+	if s == nil {
+		return nil
+	}
+	var codes []string
+	if err := json.Unmarshal([]byte(*s), &codes); err != nil {
+		return nil
+	}
+	return codes
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// nullableString 空字符串保存为 NULL
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// nullableID 为 0 的 ID 保存为 NULL
+func nullableID(id uint64) *uint64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func derefID(id *uint64) uint64 {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
+
+func derefTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
 }
 
-func NewUserRepository(repo *Repository) domain.UserRepository {
+func NewUserRepository(conf *viper.Viper, repo *Repository, caches []client.Cache) domain.UserRepository {
 	return &UserRepository{
-		repo: repo,
+		repo:  repo,
+		cache: cache.NewMultiCache[*userCacheEntry](conf, caches),
 	}
 }
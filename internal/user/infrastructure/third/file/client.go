@@ -0,0 +1,154 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/discovery"
+	"github.com/spf13/viper"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/user/domain"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/file"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/file/fileservice"
+)
+
+// NewFileServiceClient 创建文件服务客户端，未配置注册中心时使用 app.client.file.addrs 中的固定地址
+func NewFileServiceClient(conf *viper.Viper, resolver discovery.Resolver) fileservice.Client {
+	name := conf.GetString("app.client.file.name")
+	if name == "" {
+		name = "file"
+	}
+	var opts []client.Option
+	if resolver != nil {
+		opts = append(opts, client.WithResolver(resolver))
+	} else {
+		opts = append(opts, client.WithHostPorts(conf.GetStringSlice("app.client.file.addrs")...))
+	}
+	cli, err := fileservice.NewClient(name, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return cli
+}
+
+type Client struct {
+	cli  fileservice.Client
+	http *http.Client
+}
+
+func (c *Client) ListUserFiles(ctx context.Context, userID uint64, fileIDs []uint64, expires time.Duration) ([]*domain.UserFile, error) {
+	resp, err := c.cli.ListUserFiles(ctx, &file.ListUserFilesReq{
+		UserId:       userID,
+		FileIds:      fileIDs,
+		UrlExpiresIn: int64(expires / time.Second),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Third.File]failed to list user files: %w", err)
+	}
+	files := make([]*domain.UserFile, 0, len(resp.GetFiles()))
+	for _, f := range resp.GetFiles() {
+		files = append(files, &domain.UserFile{
+			ID:          f.GetFileId(),
+			Domain:      f.GetDomain(),
+			Name:        f.GetFileName(),
+			Size:        f.GetSize(),
+			ContentType: f.GetContentType(),
+			MD5:         f.GetMd5(),
+			CreatedAt:   time.Unix(f.GetCreatedAt(), 0),
+			DownloadURL: f.GetDownloadUrl(),
+			AccessURL:   f.GetAccessUrl(),
+		})
+	}
+	return files, nil
+}
+
+// UploadUserFile 通过预签名地址上传文件并确认，文件已存在时直接关联到用户
+func (c *Client) UploadUserFile(ctx context.Context, userID uint64, fileDomain, name, contentType string, data []byte) (uint64, error) {
+	sum := md5.Sum(data)
+	pre, err := c.cli.PrepareUpload(ctx, &file.PrepareUploadReq{
+		Domain:      fileDomain,
+		FileName:    name,
+		Size:        int64(len(data)),
+		Md5:         hex.EncodeToString(sum[:]),
+		ContentType: contentType,
+		UploadBy:    userID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("[Infrastructure.Third.File]failed to prepare upload: %w", err)
+	}
+	complete := &file.CompleteUploadReq{
+		FileId:   pre.GetFileId(),
+		UploadBy: userID,
+	}
+	if pre.GetExists() {
+		// 秒传需要提交挑战范围内容的 SHA-256 证明持有文件
+		offset, length := pre.GetChallengeOffset(), pre.GetChallengeLength()
+		if offset < 0 || length < 0 || offset+length > int64(len(data)) {
+			return 0, fmt.Errorf("[Infrastructure.Third.File]invalid challenge for file %d", pre.GetFileId())
+		}
+		proof := sha256.Sum256(data[offset : offset+length])
+		complete.ChallengeId = pre.GetChallengeId()
+		complete.Proof = hex.EncodeToString(proof[:])
+	} else {
+		if pre.GetUploadUrl() == "" {
+			return 0, fmt.Errorf("[Infrastructure.Third.File]file %d is being uploaded", pre.GetFileId())
+		}
+		if err := c.put(ctx, pre.GetUploadUrl(), contentType, data); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := c.cli.CompleteUpload(ctx, complete); err != nil {
+		return 0, fmt.Errorf("[Infrastructure.Third.File]failed to complete upload %d: %w", pre.GetFileId(), err)
+	}
+	return pre.GetFileId(), nil
+}
+
+func (c *Client) put(ctx context.Context, url, contentType string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Third.File]failed to build upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Third.File]failed to upload: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("[Infrastructure.Third.File]upload failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (c *Client) DetachUserFiles(ctx context.Context, userID uint64) error {
+	if _, err := c.cli.DetachUserFiles(ctx, &file.DetachUserFilesReq{UserId: userID}); err != nil {
+		return fmt.Errorf("[Infrastructure.Third.File]failed to detach user files: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) PinUserFile(ctx context.Context, userID, fileID uint64, pinned bool) (bool, error) {
+	resp, err := c.cli.PinFile(ctx, &file.PinFileReq{
+		FileId: fileID,
+		UserId: userID,
+		Pinned: pinned,
+	})
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.Third.File]failed to pin file %d: %w", fileID, err)
+	}
+	return resp.GetStatus() != file.PinFileResp_NOT_FOUND, nil
+}
+
+func NewFileClient(cli fileservice.Client) domain.FileClient {
+	return &Client{
+		cli:  cli,
+		http: &http.Client{Timeout: time.Minute},
+	}
+}
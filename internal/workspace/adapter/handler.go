@@ -0,0 +1,411 @@
+package adapter
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"go.uber.org/zap"
+
+	v1 "github.com/Wenrh2004/lark-lite-server/common/api/v1"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/common"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/workspace"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/workspace/workspaceservice"
+	"github.com/Wenrh2004/lark-lite-server/pkg/adapter"
+)
+
+// WorkspaceHandler 网关中的工作空间接口，需要在用户认证中间件之后使用，调用方令牌随请求透传给工作空间服务
+type WorkspaceHandler struct {
+	srv *adapter.Service
+	cli workspaceservice.Client
+}
+
+func NewWorkspaceHandler(srv *adapter.Service, cli workspaceservice.Client) *WorkspaceHandler {
+	return &WorkspaceHandler{
+		srv: srv,
+		cli: cli,
+	}
+}
+
+func (h *WorkspaceHandler) CreateWorkspace(ctx context.Context, c *app.RequestContext) {
+	userID, ok := h.caller(ctx, c)
+	if !ok {
+		return
+	}
+	var req v1.CreateWorkspaceRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.CreateWorkspace(ctx, &workspace.CreateWorkspaceRequest{
+		UserId: userID,
+		Name:   req.Name,
+	})
+	if !h.handleBaseResponse(ctx, c, "CreateWorkspace", resp.GetResp(), err) {
+		return
+	}
+	v1.HandlerSuccess(c, toWorkspaceResponseBody(resp.GetWorkspace()))
+}
+
+func (h *WorkspaceHandler) ListWorkspaces(ctx context.Context, c *app.RequestContext) {
+	userID, ok := h.caller(ctx, c)
+	if !ok {
+		return
+	}
+	resp, err := h.cli.ListWorkspaces(ctx, &workspace.ListWorkspacesRequest{UserId: userID})
+	if !h.handleBaseResponse(ctx, c, "ListWorkspaces", resp.GetResp(), err) {
+		return
+	}
+	body := &v1.ListWorkspacesResponseBody{Workspaces: make([]v1.WorkspaceResponseBody, 0, len(resp.GetWorkspaces()))}
+	for _, ws := range resp.GetWorkspaces() {
+		body.Workspaces = append(body.Workspaces, *toWorkspaceResponseBody(ws))
+	}
+	v1.HandlerSuccess(c, body)
+}
+
+func (h *WorkspaceHandler) GetWorkspace(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	resp, err := h.cli.GetWorkspace(ctx, &workspace.GetWorkspaceRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+	})
+	if !h.handleBaseResponse(ctx, c, "GetWorkspace", resp.GetResp(), err) {
+		return
+	}
+	v1.HandlerSuccess(c, toWorkspaceResponseBody(resp.GetWorkspace()))
+}
+
+func (h *WorkspaceHandler) RenameWorkspace(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	var req v1.RenameWorkspaceRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RenameWorkspace(ctx, &workspace.RenameWorkspaceRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+		Name:        req.Name,
+	})
+	if !h.handleBaseResponse(ctx, c, "RenameWorkspace", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *WorkspaceHandler) DeleteWorkspace(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	resp, err := h.cli.DeleteWorkspace(ctx, &workspace.DeleteWorkspaceRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+	})
+	if !h.handleBaseResponse(ctx, c, "DeleteWorkspace", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *WorkspaceHandler) ListMembers(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	var req v1.ListMembersRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ListMembers(ctx, &workspace.ListMembersRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+		Page: &common.PageRequest{
+			Offset: int32(req.Offset),
+			Limit:  int32(req.Limit),
+		},
+	})
+	if !h.handleBaseResponse(ctx, c, "ListMembers", resp.GetResp(), err) {
+		return
+	}
+	body := &v1.ListMembersResponseBody{
+		Members: make([]v1.MemberResponseBody, 0, len(resp.GetMembers())),
+		Total:   resp.GetTotal(),
+	}
+	for _, m := range resp.GetMembers() {
+		body.Members = append(body.Members, v1.MemberResponseBody{
+			UserID:   strconv.FormatInt(m.GetUserId(), 10),
+			Role:     m.GetRole(),
+			JoinedAt: m.GetJoinedAt(),
+		})
+	}
+	v1.HandlerSuccess(c, body)
+}
+
+func (h *WorkspaceHandler) ChangeMemberRole(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	memberID, err := strconv.ParseInt(c.Param("member_id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	var req v1.ChangeMemberRoleRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.ChangeMemberRole(ctx, &workspace.ChangeMemberRoleRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+		MemberId:    memberID,
+		Role:        req.Role,
+	})
+	if !h.handleBaseResponse(ctx, c, "ChangeMemberRole", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+// RemoveMember 移除成员，member_id 为当前用户本人时表示退出工作空间
+func (h *WorkspaceHandler) RemoveMember(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	memberID, err := strconv.ParseInt(c.Param("member_id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RemoveMember(ctx, &workspace.RemoveMemberRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+		MemberId:    memberID,
+	})
+	if !h.handleBaseResponse(ctx, c, "RemoveMember", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *WorkspaceHandler) TransferOwnership(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	var req v1.TransferOwnershipRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	newOwnerID, err := strconv.ParseInt(req.NewOwnerID, 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.TransferOwnership(ctx, &workspace.TransferOwnershipRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+		NewOwnerId:  newOwnerID,
+	})
+	if !h.handleBaseResponse(ctx, c, "TransferOwnership", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+func (h *WorkspaceHandler) CreateInviteLink(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	var req v1.CreateInviteLinkRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.CreateInviteLink(ctx, &workspace.CreateInviteLinkRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+		Role:        req.Role,
+		ExpiresIn:   req.ExpiresIn,
+		MaxUses:     req.MaxUses,
+	})
+	if !h.handleBaseResponse(ctx, c, "CreateInviteLink", resp.GetResp(), err) {
+		return
+	}
+	v1.HandlerSuccess(c, &v1.CreateInviteLinkResponseBody{
+		Invitation: toInvitationResponseBody(resp.GetInvitation()),
+		Token:      resp.GetToken(),
+		URL:        resp.GetUrl(),
+	})
+}
+
+func (h *WorkspaceHandler) SendEmailInvitations(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	var req v1.SendEmailInvitationsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.SendEmailInvitations(ctx, &workspace.SendEmailInvitationsRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+		Role:        req.Role,
+		Emails:      req.Emails,
+	})
+	if !h.handleBaseResponse(ctx, c, "SendEmailInvitations", resp.GetResp(), err) {
+		return
+	}
+	v1.HandlerSuccess(c, toListInvitationsResponseBody(resp.GetInvitations()))
+}
+
+func (h *WorkspaceHandler) ListInvitations(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	resp, err := h.cli.ListInvitations(ctx, &workspace.ListInvitationsRequest{
+		UserId:      userID,
+		WorkspaceId: workspaceID,
+	})
+	if !h.handleBaseResponse(ctx, c, "ListInvitations", resp.GetResp(), err) {
+		return
+	}
+	v1.HandlerSuccess(c, toListInvitationsResponseBody(resp.GetInvitations()))
+}
+
+func (h *WorkspaceHandler) RevokeInvitation(ctx context.Context, c *app.RequestContext) {
+	userID, workspaceID, ok := h.workspaceCaller(ctx, c)
+	if !ok {
+		return
+	}
+	invitationID, err := strconv.ParseInt(c.Param("invitation_id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.RevokeInvitation(ctx, &workspace.RevokeInvitationRequest{
+		UserId:       userID,
+		WorkspaceId:  workspaceID,
+		InvitationId: invitationID,
+	})
+	if !h.handleBaseResponse(ctx, c, "RevokeInvitation", resp, err) {
+		return
+	}
+	v1.HandlerSuccess(c, nil)
+}
+
+// AcceptInvitation 使用邀请链接或邮件中的邀请令牌加入工作空间
+func (h *WorkspaceHandler) AcceptInvitation(ctx context.Context, c *app.RequestContext) {
+	userID, ok := h.caller(ctx, c)
+	if !ok {
+		return
+	}
+	var req v1.AcceptInvitationRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return
+	}
+	resp, err := h.cli.AcceptInvitation(ctx, &workspace.AcceptInvitationRequest{
+		UserId: userID,
+		Token:  req.Token,
+	})
+	if !h.handleBaseResponse(ctx, c, "AcceptInvitation", resp.GetResp(), err) {
+		return
+	}
+	v1.HandlerSuccess(c, toWorkspaceResponseBody(resp.GetWorkspace()))
+}
+
+// caller 读取认证中间件写入的当前用户 ID
+func (h *WorkspaceHandler) caller(ctx context.Context, c *app.RequestContext) (int64, bool) {
+	userID, err := strconv.ParseInt(c.GetString("user_id"), 10, 64)
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.Workspace] invalid user_id", zap.Error(err))
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return 0, false
+	}
+	return userID, true
+}
+
+// workspaceCaller 读取当前用户 ID 与路径中的工作空间 ID
+func (h *WorkspaceHandler) workspaceCaller(ctx context.Context, c *app.RequestContext) (int64, int64, bool) {
+	userID, ok := h.caller(ctx, c)
+	if !ok {
+		return 0, 0, false
+	}
+	workspaceID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		v1.HandlerError(c, v1.ErrBadRequest)
+		return 0, 0, false
+	}
+	return userID, workspaceID, true
+}
+
+func (h *WorkspaceHandler) handleBaseResponse(ctx context.Context, c *app.RequestContext, op string, resp *common.BaseResponse, err error) bool {
+	if err != nil {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.Workspace] "+op+" failed", zap.Error(err))
+		v1.HandlerError(c, v1.ErrInternalServerError)
+		return false
+	}
+	if resp == nil || resp.Code != 0 {
+		h.srv.Logger.WithContext(ctx).Error("[Adapter.Workspace] "+op+" business error", zap.Any("resp", resp))
+		msg := "internal error"
+		code := 500
+		if resp != nil {
+			msg = resp.Message
+			code = int(resp.Code)
+		}
+		v1.HandlerError(c, v1.Error{
+			Code:    code,
+			Message: msg,
+		})
+		return false
+	}
+	return true
+}
+
+func toWorkspaceResponseBody(ws *workspace.Workspace) *v1.WorkspaceResponseBody {
+	return &v1.WorkspaceResponseBody{
+		ID:        strconv.FormatInt(ws.GetId(), 10),
+		Name:      ws.GetName(),
+		OwnerID:   strconv.FormatInt(ws.GetOwnerId(), 10),
+		Role:      ws.GetRole(),
+		CreatedAt: ws.GetCreatedAt(),
+		UpdatedAt: ws.GetUpdatedAt(),
+	}
+}
+
+func toInvitationResponseBody(i *workspace.Invitation) v1.InvitationResponseBody {
+	return v1.InvitationResponseBody{
+		ID:        strconv.FormatInt(i.GetId(), 10),
+		InviterID: strconv.FormatInt(i.GetInviterId(), 10),
+		Role:      i.GetRole(),
+		Email:     i.GetEmail(),
+		MaxUses:   i.GetMaxUses(),
+		Uses:      i.GetUses(),
+		ExpiresAt: i.GetExpiresAt(),
+		CreatedAt: i.GetCreatedAt(),
+	}
+}
+
+func toListInvitationsResponseBody(invitations []*workspace.Invitation) *v1.ListInvitationsResponseBody {
+	body := &v1.ListInvitationsResponseBody{Invitations: make([]v1.InvitationResponseBody, 0, len(invitations))}
+	for _, i := range invitations {
+		body.Invitations = append(body.Invitations, toInvitationResponseBody(i))
+	}
+	return body
+}
@@ -0,0 +1,269 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/domain"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/common"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/workspace"
+	"github.com/Wenrh2004/lark-lite-server/pkg/adapter"
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
+
+type WorkspaceServiceImpl struct {
+	srv               *adapter.Service
+	workspaceService  domain.WorkspaceService
+	memberService     domain.MemberService
+	invitationService domain.InvitationService
+	notifier          domain.InvitationNotifier
+}
+
+// errorCodes 领域错误与业务响应码的映射
+var errorCodes = []struct {
+	err  error
+	code int32
+}{
+	{domain.ErrInvalidWorkspaceName, 400},
+	{domain.ErrInvalidRole, 400},
+	{domain.ErrInvalidEmail, 400},
+	{domain.ErrTooManyEmails, 400},
+	{domain.ErrInvalidInviteExpiry, 400},
+	{domain.ErrInvalidInviteMaxUses, 400},
+	{domain.ErrPermissionDenied, 403},
+	{domain.ErrInvitationEmailMismatch, 403},
+	{domain.ErrWorkspaceNotFound, 404},
+	{domain.ErrMemberNotFound, 404},
+	{domain.ErrInvitationNotFound, 404},
+	{domain.ErrAlreadyMember, 409},
+	{domain.ErrOwnerCannotLeave, 409},
+	{domain.ErrTooManyInvitations, 409},
+	{domain.ErrTooManyWorkspaces, 409},
+	{domain.ErrInvitationUnusable, 410},
+}
+
+// errorResponse 将领域错误转换为业务响应，未知错误记录日志后统一返回内部错误
+func (w *WorkspaceServiceImpl) errorResponse(ctx context.Context, err error) *common.BaseResponse {
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			return &common.BaseResponse{Code: e.code, Message: e.err.Error()}
+		}
+	}
+	w.srv.Logger.WithContext(ctx).Error("[Adapter.WorkspaceService] internal error", zap.Error(err))
+	return &common.BaseResponse{Code: 500, Message: "internal error"}
+}
+
+func toWorkspaceMessage(ws *domain.Workspace) *workspace.Workspace {
+	return &workspace.Workspace{
+		Id:        int64(ws.ID),
+		Name:      ws.Name,
+		OwnerId:   int64(ws.OwnerID),
+		Role:      string(ws.Role),
+		CreatedAt: ws.CreatedAt.Unix(),
+		UpdatedAt: ws.UpdatedAt.Unix(),
+	}
+}
+
+func toInvitationMessages(invitations []*domain.Invitation) []*workspace.Invitation {
+	res := make([]*workspace.Invitation, 0, len(invitations))
+	for _, i := range invitations {
+		res = append(res, &workspace.Invitation{
+			Id:          int64(i.ID),
+			WorkspaceId: int64(i.WorkspaceID),
+			InviterId:   int64(i.InviterID),
+			Role:        string(i.Role),
+			Email:       i.Email,
+			MaxUses:     int32(i.MaxUses),
+			Uses:        int32(i.Uses),
+			ExpiresAt:   i.ExpiresAt.Unix(),
+			CreatedAt:   i.CreatedAt.Unix(),
+		})
+	}
+	return res
+}
+
+func (w *WorkspaceServiceImpl) CreateWorkspace(ctx context.Context, req *workspace.CreateWorkspaceRequest) (res *workspace.WorkspaceResponse, err error) {
+	ws, err := w.workspaceService.CreateWorkspace(ctx, uint64(req.GetUserId()), req.GetName())
+	if err != nil {
+		return &workspace.WorkspaceResponse{Resp: w.errorResponse(ctx, err)}, nil
+	}
+	return &workspace.WorkspaceResponse{
+		Resp:      &common.BaseResponse{Code: 0, Message: "success"},
+		Workspace: toWorkspaceMessage(ws),
+	}, nil
+}
+
+func (w *WorkspaceServiceImpl) GetWorkspace(ctx context.Context, req *workspace.GetWorkspaceRequest) (res *workspace.WorkspaceResponse, err error) {
+	ws, err := w.workspaceService.GetWorkspace(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()))
+	if err != nil {
+		return &workspace.WorkspaceResponse{Resp: w.errorResponse(ctx, err)}, nil
+	}
+	return &workspace.WorkspaceResponse{
+		Resp:      &common.BaseResponse{Code: 0, Message: "success"},
+		Workspace: toWorkspaceMessage(ws),
+	}, nil
+}
+
+func (w *WorkspaceServiceImpl) ListWorkspaces(ctx context.Context, req *workspace.ListWorkspacesRequest) (res *workspace.ListWorkspacesResponse, err error) {
+	workspaces, err := w.workspaceService.ListWorkspaces(ctx, uint64(req.GetUserId()))
+	if err != nil {
+		return &workspace.ListWorkspacesResponse{Resp: w.errorResponse(ctx, err)}, nil
+	}
+	res = &workspace.ListWorkspacesResponse{
+		Resp:       &common.BaseResponse{Code: 0, Message: "success"},
+		Workspaces: make([]*workspace.Workspace, 0, len(workspaces)),
+	}
+	for _, ws := range workspaces {
+		res.Workspaces = append(res.Workspaces, toWorkspaceMessage(ws))
+	}
+	return res, nil
+}
+
+func (w *WorkspaceServiceImpl) RenameWorkspace(ctx context.Context, req *workspace.RenameWorkspaceRequest) (res *common.BaseResponse, err error) {
+	if err := w.workspaceService.RenameWorkspace(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()), req.GetName()); err != nil {
+		return w.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (w *WorkspaceServiceImpl) DeleteWorkspace(ctx context.Context, req *workspace.DeleteWorkspaceRequest) (res *common.BaseResponse, err error) {
+	if err := w.workspaceService.DeleteWorkspace(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId())); err != nil {
+		return w.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (w *WorkspaceServiceImpl) ListMembers(ctx context.Context, req *workspace.ListMembersRequest) (res *workspace.ListMembersResponse, err error) {
+	members, total, err := w.memberService.ListMembers(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()),
+		page.Page{Offset: int(req.GetPage().GetOffset()), Limit: int(req.GetPage().GetLimit())})
+	if err != nil {
+		return &workspace.ListMembersResponse{Resp: w.errorResponse(ctx, err)}, nil
+	}
+	res = &workspace.ListMembersResponse{
+		Resp:    &common.BaseResponse{Code: 0, Message: "success"},
+		Members: make([]*workspace.Member, 0, len(members)),
+		Total:   total,
+	}
+	for _, m := range members {
+		res.Members = append(res.Members, &workspace.Member{
+			UserId:   int64(m.UserID),
+			Role:     string(m.Role),
+			JoinedAt: m.CreatedAt.Unix(),
+		})
+	}
+	return res, nil
+}
+
+func (w *WorkspaceServiceImpl) ChangeMemberRole(ctx context.Context, req *workspace.ChangeMemberRoleRequest) (res *common.BaseResponse, err error) {
+	err = w.memberService.ChangeMemberRole(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()),
+		uint64(req.GetMemberId()), domain.Role(req.GetRole()))
+	if err != nil {
+		return w.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (w *WorkspaceServiceImpl) RemoveMember(ctx context.Context, req *workspace.RemoveMemberRequest) (res *common.BaseResponse, err error) {
+	if err := w.memberService.RemoveMember(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()), uint64(req.GetMemberId())); err != nil {
+		return w.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (w *WorkspaceServiceImpl) TransferOwnership(ctx context.Context, req *workspace.TransferOwnershipRequest) (res *common.BaseResponse, err error) {
+	if err := w.memberService.TransferOwnership(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()), uint64(req.GetNewOwnerId())); err != nil {
+		return w.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (w *WorkspaceServiceImpl) CreateInviteLink(ctx context.Context, req *workspace.CreateInviteLinkRequest) (res *workspace.CreateInviteLinkResponse, err error) {
+	invitation, token, err := w.invitationService.CreateInviteLink(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()),
+		domain.Role(req.GetRole()), time.Duration(req.GetExpiresIn())*time.Second, int(req.GetMaxUses()))
+	if err != nil {
+		return &workspace.CreateInviteLinkResponse{Resp: w.errorResponse(ctx, err)}, nil
+	}
+	return &workspace.CreateInviteLinkResponse{
+		Resp:       &common.BaseResponse{Code: 0, Message: "success"},
+		Invitation: toInvitationMessages([]*domain.Invitation{invitation})[0],
+		Token:      token,
+		Url:        w.notifier.InviteURL(token),
+	}, nil
+}
+
+func (w *WorkspaceServiceImpl) SendEmailInvitations(ctx context.Context, req *workspace.SendEmailInvitationsRequest) (res *workspace.ListInvitationsResponse, err error) {
+	invitations, err := w.invitationService.SendEmailInvitations(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()),
+		domain.Role(req.GetRole()), req.GetEmails())
+	if err != nil {
+		return &workspace.ListInvitationsResponse{Resp: w.errorResponse(ctx, err)}, nil
+	}
+	return &workspace.ListInvitationsResponse{
+		Resp:        &common.BaseResponse{Code: 0, Message: "success"},
+		Invitations: toInvitationMessages(invitations),
+	}, nil
+}
+
+func (w *WorkspaceServiceImpl) ListInvitations(ctx context.Context, req *workspace.ListInvitationsRequest) (res *workspace.ListInvitationsResponse, err error) {
+	invitations, err := w.invitationService.ListInvitations(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()))
+	if err != nil {
+		return &workspace.ListInvitationsResponse{Resp: w.errorResponse(ctx, err)}, nil
+	}
+	return &workspace.ListInvitationsResponse{
+		Resp:        &common.BaseResponse{Code: 0, Message: "success"},
+		Invitations: toInvitationMessages(invitations),
+	}, nil
+}
+
+func (w *WorkspaceServiceImpl) RevokeInvitation(ctx context.Context, req *workspace.RevokeInvitationRequest) (res *common.BaseResponse, err error) {
+	err = w.invitationService.RevokeInvitation(ctx, uint64(req.GetUserId()), uint64(req.GetWorkspaceId()), uint64(req.GetInvitationId()))
+	if err != nil {
+		return w.errorResponse(ctx, err), nil
+	}
+	return &common.BaseResponse{Code: 0, Message: "success"}, nil
+}
+
+func (w *WorkspaceServiceImpl) AcceptInvitation(ctx context.Context, req *workspace.AcceptInvitationRequest) (res *workspace.WorkspaceResponse, err error) {
+	ws, err := w.invitationService.AcceptInvitation(ctx, uint64(req.GetUserId()), req.GetToken())
+	if err != nil {
+		return &workspace.WorkspaceResponse{Resp: w.errorResponse(ctx, err)}, nil
+	}
+	return &workspace.WorkspaceResponse{
+		Resp:      &common.BaseResponse{Code: 0, Message: "success"},
+		Workspace: toWorkspaceMessage(ws),
+	}, nil
+}
+
+func (w *WorkspaceServiceImpl) CheckMembership(ctx context.Context, req *workspace.CheckMembershipRequest) (res *workspace.CheckMembershipResponse, err error) {
+	member, allowed, err := w.memberService.CheckMembership(ctx, uint64(req.GetWorkspaceId()), uint64(req.GetUserId()), domain.Role(req.GetMinRole()))
+	if err != nil {
+		return &workspace.CheckMembershipResponse{Resp: w.errorResponse(ctx, err)}, nil
+	}
+	res = &workspace.CheckMembershipResponse{
+		Resp:    &common.BaseResponse{Code: 0, Message: "success"},
+		Allowed: allowed,
+	}
+	if member != nil {
+		res.Member = true
+		res.Role = string(member.Role)
+	}
+	return res, nil
+}
+
+func NewWorkspaceServiceImpl(
+	srv *adapter.Service,
+	workspaceService domain.WorkspaceService,
+	memberService domain.MemberService,
+	invitationService domain.InvitationService,
+	notifier domain.InvitationNotifier,
+) *WorkspaceServiceImpl {
+	return &WorkspaceServiceImpl{
+		srv:               srv,
+		workspaceService:  workspaceService,
+		memberService:     memberService,
+		invitationService: invitationService,
+		notifier:          notifier,
+	}
+}
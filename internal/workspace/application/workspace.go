@@ -0,0 +1,24 @@
+package application
+
+import (
+	kitexregistry "github.com/cloudwego/kitex/pkg/registry"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/server"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/adapter"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/workspace/workspaceservice"
+	"github.com/Wenrh2004/lark-lite-server/pkg/application/server/rpc"
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+	"github.com/Wenrh2004/lark-lite-server/pkg/log"
+)
+
+// NewWorkspaceRPCApplication 创建工作空间 RPC 服务，注册到注册中心供其他服务校验成员关系，
+// 所有方法都要求上游透传调用方令牌，且请求中的 user_id 必须为调用方本人
+func NewWorkspaceRPCApplication(logger *log.Logger, r kitexregistry.Registry, handler *adapter.WorkspaceServiceImpl, auth authz.Authenticator) *rpc.Server {
+	svr := workspaceservice.NewServer(handler,
+		server.WithRegistry(r),
+		server.WithMetaHandler(transmeta.ServerTTHeaderHandler),
+		server.WithMiddleware(authz.KitexMiddleware(auth)),
+	)
+	return rpc.NewServer(svr, logger)
+}
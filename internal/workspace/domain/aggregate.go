@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const maxWorkspaceNameLength = 64
+
+// Role 成员在工作空间中的角色
+type Role string
+
+const (
+	// RoleOwner 所有者，每个工作空间有且只有一个，可以删除工作空间与转让所有权
+	RoleOwner Role = "owner"
+	// RoleAdmin 管理员，可以重命名工作空间、邀请与管理角色低于自己的成员
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+	// RoleViewer 只读成员
+	RoleViewer Role = "viewer"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleMember: 2,
+	RoleAdmin:  3,
+	RoleOwner:  4,
+}
+
+// Valid 是否为已知角色
+func (r Role) Valid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// AtLeast 角色是否不低于 min
+func (r Role) AtLeast(min Role) bool {
+	return r.Valid() && roleRanks[r] >= roleRanks[min]
+}
+
+// CanGrant 拥有该角色的成员能否授予或收回角色 target：所有者可以管理除所有者以外的全部角色，
+// 管理员只能管理低于自己的角色
+func (r Role) CanGrant(target Role) bool {
+	if !target.Valid() || target == RoleOwner {
+		return false
+	}
+	if r == RoleOwner {
+		return true
+	}
+	return r.AtLeast(RoleAdmin) && roleRanks[r] > roleRanks[target]
+}
+
+// Workspace 工作空间
+type Workspace struct {
+	ID      uint64
+	Name    string
+	OwnerID uint64
+	// Role 查询用户在工作空间中的角色，只在按用户查询时填充
+	Role      Role
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NormalizeWorkspaceName 去除首尾空白并校验长度
+func NormalizeWorkspaceName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if n := utf8.RuneCountInString(name); n == 0 || n > maxWorkspaceNameLength {
+		return "", ErrInvalidWorkspaceName
+	}
+	return name, nil
+}
+
+// Member 工作空间成员
+type Member struct {
+	WorkspaceID uint64
+	UserID      uint64
+	Role        Role
+	CreatedAt   time.Time
+}
+
+// Invitation 工作空间邀请，只保存令牌的 SHA-256 哈希。
+// 邀请链接可以被多人使用，邮件邀请只能使用一次
+type Invitation struct {
+	ID          uint64
+	WorkspaceID uint64
+	InviterID   uint64
+	Role        Role
+	// Email 邮件邀请的收件人，邀请链接为空
+	Email     string
+	TokenHash string
+	// MaxUses 最大使用次数，0 表示不限
+	MaxUses   int
+	Uses      int
+	ExpiresAt time.Time
+	RevokedAt time.Time
+	CreatedAt time.Time
+}
+
+// Usable 邀请在 now 时是否未撤销、未过期且未用完
+func (i *Invitation) Usable(now time.Time) bool {
+	if !i.RevokedAt.IsZero() || !now.Before(i.ExpiresAt) {
+		return false
+	}
+	return i.MaxUses == 0 || i.Uses < i.MaxUses
+}
+
+// HashInvitationToken 计算邀请令牌哈希，令牌为高熵随机值，使用 SHA-256 即可
+func HashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// newInvitationToken 生成 256 位随机邀请令牌
+func newInvitationToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidWorkspaceName = errors.New("workspace name length must be between 1 and 64")
+	ErrInvalidRole          = errors.New("invalid role")
+	ErrInvalidEmail         = errors.New("invalid email")
+	ErrTooManyEmails        = errors.New("email invitations must contain between 1 and 20 addresses")
+	ErrInvalidInviteExpiry  = errors.New("invitation expiry must be between 1 hour and 30 days")
+	ErrInvalidInviteMaxUses = errors.New("invitation max uses must be between 0 and 1000")
+
+	// ErrWorkspaceNotFound 工作空间不存在或调用方不是成员，不区分两者以免泄露工作空间是否存在
+	ErrWorkspaceNotFound  = errors.New("workspace not found")
+	ErrMemberNotFound     = errors.New("member not found")
+	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrPermissionDenied   = errors.New("permission denied")
+	// ErrInvitationEmailMismatch 邮件邀请只能由已验证收件邮箱的用户接受
+	ErrInvitationEmailMismatch = errors.New("invitation was sent to a different email address, verify that email address first")
+
+	ErrAlreadyMember      = errors.New("user is already a member of the workspace")
+	ErrOwnerCannotLeave   = errors.New("owner cannot leave the workspace, transfer ownership or delete it instead")
+	ErrInvitationUnusable = errors.New("invitation expired, revoked or used up")
+	ErrTooManyInvitations = errors.New("too many pending invitations")
+	ErrTooManyWorkspaces  = errors.New("too many workspaces owned by the user")
+)
@@ -0,0 +1,263 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/mail"
+)
+
+const (
+	defaultInviteExpiry = 7 * 24 * time.Hour
+	minInviteExpiry     = time.Hour
+	maxInviteExpiry     = 30 * 24 * time.Hour
+	maxInviteUses       = 1000
+	// maxEmailsPerRequest 单次邮件邀请的最大收件人数量
+	maxEmailsPerRequest = 20
+	// maxUsableInvitations 每个工作空间同时可用的邀请数量上限
+	maxUsableInvitations = 100
+)
+
+type InvitationService interface {
+	// CreateInviteLink 创建邀请链接，ttl 为 0 时使用默认有效期，maxUses 为 0 表示不限使用次数，
+	// 返回的令牌只在创建时可见
+	CreateInviteLink(ctx context.Context, userID, workspaceID uint64, role Role, ttl time.Duration, maxUses int) (*Invitation, string, error)
+	// SendEmailInvitations 向 emails 发送只能使用一次的邀请，邮件异步发送且失败只记录日志
+	SendEmailInvitations(ctx context.Context, userID, workspaceID uint64, role Role, emails []string) ([]*Invitation, error)
+	// ListInvitations 查询工作空间仍可使用的邀请，需要管理员及以上角色
+	ListInvitations(ctx context.Context, userID, workspaceID uint64) ([]*Invitation, error)
+	// RevokeInvitation 撤销邀请，需要管理员及以上角色
+	RevokeInvitation(ctx context.Context, userID, workspaceID, invitationID uint64) error
+	// AcceptInvitation 使用邀请令牌加入工作空间，用户已是成员时直接返回工作空间且不消耗邀请，
+	// 邮件邀请要求用户已验证的邮箱与收件邮箱一致
+	AcceptInvitation(ctx context.Context, userID uint64, token string) (*Workspace, error)
+}
+
+type invitationService struct {
+	srv        *domain.Service
+	workspace  WorkspaceRepository
+	member     MemberRepository
+	invitation InvitationRepository
+	notifier   InvitationNotifier
+	user       UserClient
+}
+
+// checkInvite 校验调用方可以邀请 role 角色的成员，且工作空间可用的邀请未超过上限
+func (i *invitationService) checkInvite(ctx context.Context, userID, workspaceID uint64, role Role, count int) error {
+	if !role.Valid() || role == RoleOwner {
+		return ErrInvalidRole
+	}
+	actor, err := requireRole(ctx, i.member, workspaceID, userID, RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if !actor.Role.CanGrant(role) {
+		return ErrPermissionDenied
+	}
+	usable, err := i.invitation.CountUsableInvitations(ctx, workspaceID, time.Now())
+	if err != nil {
+		return fmt.Errorf("count invitations: %w", err)
+	}
+	if usable+int64(count) > maxUsableInvitations {
+		return ErrTooManyInvitations
+	}
+	return nil
+}
+
+// newInvitation 创建邀请及其令牌
+func (i *invitationService) newInvitation(userID, workspaceID uint64, role Role, email string, ttl time.Duration, maxUses int, now time.Time) (*Invitation, string, error) {
+	id, err := i.srv.Sid.GenUint64()
+	if err != nil {
+		return nil, "", fmt.Errorf("gen sid field: %w", err)
+	}
+	token, err := newInvitationToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("generate token: %w", err)
+	}
+	return &Invitation{
+		ID:          id,
+		WorkspaceID: workspaceID,
+		InviterID:   userID,
+		Role:        role,
+		Email:       email,
+		TokenHash:   HashInvitationToken(token),
+		MaxUses:     maxUses,
+		ExpiresAt:   now.Add(ttl),
+		CreatedAt:   now,
+	}, token, nil
+}
+
+func (i *invitationService) CreateInviteLink(ctx context.Context, userID, workspaceID uint64, role Role, ttl time.Duration, maxUses int) (*Invitation, string, error) {
+	if ttl == 0 {
+		ttl = defaultInviteExpiry
+	}
+	if ttl < minInviteExpiry || ttl > maxInviteExpiry {
+		return nil, "", ErrInvalidInviteExpiry
+	}
+	if maxUses < 0 || maxUses > maxInviteUses {
+		return nil, "", ErrInvalidInviteMaxUses
+	}
+	if err := i.checkInvite(ctx, userID, workspaceID, role, 1); err != nil {
+		return nil, "", fmt.Errorf("[Domain.Service.Invitation] %w", err)
+	}
+	invitation, token, err := i.newInvitation(userID, workspaceID, role, "", ttl, maxUses, time.Now())
+	if err != nil {
+		return nil, "", fmt.Errorf("[Domain.Service.Invitation] %w", err)
+	}
+	if err := i.invitation.CreateInvitations(ctx, []*Invitation{invitation}); err != nil {
+		return nil, "", fmt.Errorf("[Domain.Service.Invitation] create invitation: %w", err)
+	}
+	return invitation, token, nil
+}
+
+func (i *invitationService) SendEmailInvitations(ctx context.Context, userID, workspaceID uint64, role Role, emails []string) ([]*Invitation, error) {
+	seen := make(map[string]struct{}, len(emails))
+	normalized := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = mail.NormalizeAddress(email)
+		if !mail.ValidAddress(email) {
+			return nil, ErrInvalidEmail
+		}
+		if _, ok := seen[email]; ok {
+			continue
+		}
+		seen[email] = struct{}{}
+		normalized = append(normalized, email)
+	}
+	if len(normalized) == 0 || len(normalized) > maxEmailsPerRequest {
+		return nil, ErrTooManyEmails
+	}
+	if err := i.checkInvite(ctx, userID, workspaceID, role, len(normalized)); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Invitation] %w", err)
+	}
+	workspace, err := i.workspace.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Invitation] get workspace: %w", err)
+	}
+	now := time.Now()
+	invitations := make([]*Invitation, 0, len(normalized))
+	tokens := make([]string, 0, len(normalized))
+	for _, email := range normalized {
+		invitation, token, err := i.newInvitation(userID, workspaceID, role, email, defaultInviteExpiry, 1, now)
+		if err != nil {
+			return nil, fmt.Errorf("[Domain.Service.Invitation] %w", err)
+		}
+		invitations = append(invitations, invitation)
+		tokens = append(tokens, token)
+	}
+	if err := i.invitation.CreateInvitations(ctx, invitations); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Invitation] create invitations: %w", err)
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		for n, invitation := range invitations {
+			if err := i.notifier.SendInvitation(ctx, workspace, invitation, tokens[n]); err != nil {
+				i.srv.Logger.WithContext(ctx).Warn("[Domain.Service.Invitation] send invitation mail failed",
+					zap.Uint64("invitation_id", invitation.ID), zap.Error(err))
+			}
+		}
+	}()
+	return invitations, nil
+}
+
+func (i *invitationService) ListInvitations(ctx context.Context, userID, workspaceID uint64) ([]*Invitation, error) {
+	if _, err := requireRole(ctx, i.member, workspaceID, userID, RoleAdmin); err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Invitation] %w", err)
+	}
+	invitations, err := i.invitation.ListUsableInvitations(ctx, workspaceID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Invitation] list invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+func (i *invitationService) RevokeInvitation(ctx context.Context, userID, workspaceID, invitationID uint64) error {
+	if _, err := requireRole(ctx, i.member, workspaceID, userID, RoleAdmin); err != nil {
+		return fmt.Errorf("[Domain.Service.Invitation] %w", err)
+	}
+	if err := i.invitation.RevokeInvitation(ctx, workspaceID, invitationID, time.Now()); err != nil {
+		return fmt.Errorf("[Domain.Service.Invitation] revoke invitation: %w", err)
+	}
+	return nil
+}
+
+func (i *invitationService) AcceptInvitation(ctx context.Context, userID uint64, token string) (*Workspace, error) {
+	if token == "" {
+		return nil, ErrInvitationNotFound
+	}
+	invitation, err := i.invitation.GetInvitationByTokenHash(ctx, HashInvitationToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Invitation] get invitation: %w", err)
+	}
+	now := time.Now()
+	if !invitation.Usable(now) {
+		return nil, ErrInvitationUnusable
+	}
+	if invitation.Email != "" {
+		email, err := i.user.VerifiedEmail(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("[Domain.Service.Invitation] get user email: %w", err)
+		}
+		if mail.NormalizeAddress(email) != invitation.Email {
+			return nil, ErrInvitationEmailMismatch
+		}
+	}
+	workspace, err := i.workspace.GetWorkspace(ctx, invitation.WorkspaceID)
+	if err != nil {
+		if errors.Is(err, ErrWorkspaceNotFound) {
+			return nil, ErrInvitationUnusable
+		}
+		return nil, fmt.Errorf("[Domain.Service.Invitation] get workspace: %w", err)
+	}
+	member, err := i.member.GetMember(ctx, invitation.WorkspaceID, userID)
+	if err == nil {
+		workspace.Role = member.Role
+		return workspace, nil
+	}
+	if !errors.Is(err, ErrMemberNotFound) {
+		return nil, fmt.Errorf("[Domain.Service.Invitation] get member: %w", err)
+	}
+	err = i.srv.Tx.Transaction(ctx, func(ctx context.Context) error {
+		ok, err := i.invitation.ConsumeInvitation(ctx, invitation.ID, now)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrInvitationUnusable
+		}
+		return i.member.AddMember(ctx, &Member{
+			WorkspaceID: invitation.WorkspaceID,
+			UserID:      userID,
+			Role:        invitation.Role,
+			CreatedAt:   now,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Invitation] accept invitation: %w", err)
+	}
+	workspace.Role = invitation.Role
+	return workspace, nil
+}
+
+func NewInvitationService(
+	srv *domain.Service,
+	workspace WorkspaceRepository,
+	member MemberRepository,
+	invitation InvitationRepository,
+	notifier InvitationNotifier,
+	user UserClient,
+) InvitationService {
+	return &invitationService{
+		srv:        srv,
+		workspace:  workspace,
+		member:     member,
+		invitation: invitation,
+		notifier:   notifier,
+		user:       user,
+	}
+}
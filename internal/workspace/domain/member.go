@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
+
+const (
+	defaultMemberPageSize = 50
+	maxMemberPageSize     = 200
+)
+
+// requireRole 校验用户是工作空间成员且角色不低于 min，不是成员时返回 ErrWorkspaceNotFound
+func requireRole(ctx context.Context, members MemberRepository, workspaceID, userID uint64, min Role) (*Member, error) {
+	member, err := members.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			return nil, ErrWorkspaceNotFound
+		}
+		return nil, fmt.Errorf("get member: %w", err)
+	}
+	if !member.Role.AtLeast(min) {
+		return nil, ErrPermissionDenied
+	}
+	return member, nil
+}
+
+type MemberService interface {
+	// ListMembers 分页查询成员，任意成员都可以查询
+	ListMembers(ctx context.Context, userID, workspaceID uint64, p page.Page) ([]*Member, int64, error)
+	// ChangeMemberRole 修改其他成员的角色，调用方需要能够管理成员的当前角色与目标角色
+	ChangeMemberRole(ctx context.Context, userID, workspaceID, memberID uint64, role Role) error
+	// RemoveMember 移除成员，memberID 为调用方本人时表示退出，所有者不能退出
+	RemoveMember(ctx context.Context, userID, workspaceID, memberID uint64) error
+	// TransferOwnership 所有者将所有权转让给其他成员，原所有者成为管理员
+	TransferOwnership(ctx context.Context, userID, workspaceID, newOwnerID uint64) error
+	// CheckMembership 查询用户在工作空间中的角色，不是成员时返回 nil，
+	// minRole 为空时只校验是否为成员，返回的 allowed 表示角色是否不低于 minRole
+	CheckMembership(ctx context.Context, workspaceID, userID uint64, minRole Role) (member *Member, allowed bool, err error)
+}
+
+type memberService struct {
+	srv       *domain.Service
+	workspace WorkspaceRepository
+	member    MemberRepository
+}
+
+func (m *memberService) ListMembers(ctx context.Context, userID, workspaceID uint64, p page.Page) ([]*Member, int64, error) {
+	if _, err := requireRole(ctx, m.member, workspaceID, userID, RoleViewer); err != nil {
+		return nil, 0, fmt.Errorf("[Domain.Service.Member] %w", err)
+	}
+	members, total, err := m.member.ListMembers(ctx, workspaceID, p.Normalize(defaultMemberPageSize, maxMemberPageSize))
+	if err != nil {
+		return nil, 0, fmt.Errorf("[Domain.Service.Member] list members: %w", err)
+	}
+	return members, total, nil
+}
+
+func (m *memberService) ChangeMemberRole(ctx context.Context, userID, workspaceID, memberID uint64, role Role) error {
+	if !role.Valid() || role == RoleOwner {
+		return ErrInvalidRole
+	}
+	actor, err := requireRole(ctx, m.member, workspaceID, userID, RoleAdmin)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Member] %w", err)
+	}
+	if memberID == userID {
+		return ErrPermissionDenied
+	}
+	target, err := m.member.GetMember(ctx, workspaceID, memberID)
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Member] get member: %w", err)
+	}
+	if !actor.Role.CanGrant(target.Role) || !actor.Role.CanGrant(role) {
+		return ErrPermissionDenied
+	}
+	if target.Role == role {
+		return nil
+	}
+	if err := m.member.UpdateMemberRole(ctx, workspaceID, memberID, role); err != nil {
+		return fmt.Errorf("[Domain.Service.Member] update member role: %w", err)
+	}
+	return nil
+}
+
+func (m *memberService) RemoveMember(ctx context.Context, userID, workspaceID, memberID uint64) error {
+	if memberID == userID {
+		actor, err := requireRole(ctx, m.member, workspaceID, userID, RoleViewer)
+		if err != nil {
+			return fmt.Errorf("[Domain.Service.Member] %w", err)
+		}
+		if actor.Role == RoleOwner {
+			return ErrOwnerCannotLeave
+		}
+	} else {
+		actor, err := requireRole(ctx, m.member, workspaceID, userID, RoleAdmin)
+		if err != nil {
+			return fmt.Errorf("[Domain.Service.Member] %w", err)
+		}
+		target, err := m.member.GetMember(ctx, workspaceID, memberID)
+		if err != nil {
+			return fmt.Errorf("[Domain.Service.Member] get member: %w", err)
+		}
+		if !actor.Role.CanGrant(target.Role) {
+			return ErrPermissionDenied
+		}
+	}
+	if err := m.member.RemoveMember(ctx, workspaceID, memberID); err != nil {
+		return fmt.Errorf("[Domain.Service.Member] remove member: %w", err)
+	}
+	return nil
+}
+
+func (m *memberService) TransferOwnership(ctx context.Context, userID, workspaceID, newOwnerID uint64) error {
+	if _, err := requireRole(ctx, m.member, workspaceID, userID, RoleOwner); err != nil {
+		return fmt.Errorf("[Domain.Service.Member] %w", err)
+	}
+	if newOwnerID == userID {
+		return nil
+	}
+	if _, err := m.member.GetMember(ctx, workspaceID, newOwnerID); err != nil {
+		return fmt.Errorf("[Domain.Service.Member] get member: %w", err)
+	}
+	err := m.srv.Tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := m.workspace.TransferOwnership(ctx, workspaceID, newOwnerID, time.Now()); err != nil {
+			return err
+		}
+		if err := m.member.UpdateMemberRole(ctx, workspaceID, newOwnerID, RoleOwner); err != nil {
+			return err
+		}
+		return m.member.UpdateMemberRole(ctx, workspaceID, userID, RoleAdmin)
+	})
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Member] transfer ownership: %w", err)
+	}
+	return nil
+}
+
+func (m *memberService) CheckMembership(ctx context.Context, workspaceID, userID uint64, minRole Role) (*Member, bool, error) {
+	if minRole == "" {
+		minRole = RoleViewer
+	}
+	if !minRole.Valid() {
+		return nil, false, ErrInvalidRole
+	}
+	member, err := m.member.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("[Domain.Service.Member] get member: %w", err)
+	}
+	return member, member.Role.AtLeast(minRole), nil
+}
+
+func NewMemberService(srv *domain.Service, workspace WorkspaceRepository, member MemberRepository) MemberService {
+	return &memberService{
+		srv:       srv,
+		workspace: workspace,
+		member:    member,
+	}
+}
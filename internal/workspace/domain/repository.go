@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
+
+type WorkspaceRepository interface {
+	CreateWorkspace(ctx context.Context, workspace *Workspace) error
+	// GetWorkspace 查询未删除的工作空间，不存在时返回 ErrWorkspaceNotFound
+	GetWorkspace(ctx context.Context, id uint64) (*Workspace, error)
+	RenameWorkspace(ctx context.Context, id uint64, name string, at time.Time) error
+	// TransferOwnership 更新工作空间的所有者，成员角色由 MemberRepository 更新
+	TransferOwnership(ctx context.Context, id, ownerID uint64, at time.Time) error
+	DeleteWorkspace(ctx context.Context, id uint64) error
+	// ListUserWorkspaces 查询用户加入的未删除的工作空间，填充用户在其中的角色
+	ListUserWorkspaces(ctx context.Context, userID uint64) ([]*Workspace, error)
+	// CountOwnedWorkspaces 统计用户拥有的未删除的工作空间数量
+	CountOwnedWorkspaces(ctx context.Context, userID uint64) (int64, error)
+}
+
+type MemberRepository interface {
+	// AddMember 添加成员，用户已是成员时返回 ErrAlreadyMember
+	AddMember(ctx context.Context, member *Member) error
+	// GetMember 查询成员，结果会被缓存，不是成员时返回 ErrMemberNotFound
+	GetMember(ctx context.Context, workspaceID, userID uint64) (*Member, error)
+	// ListMembers 按加入时间分页查询成员，返回当前页与成员总数
+	ListMembers(ctx context.Context, workspaceID uint64, p page.Page) ([]*Member, int64, error)
+	// UpdateMemberRole 更新成员角色，不是成员时返回 ErrMemberNotFound
+	UpdateMemberRole(ctx context.Context, workspaceID, userID uint64, role Role) error
+	// RemoveMember 移除成员，不是成员时返回 ErrMemberNotFound
+	RemoveMember(ctx context.Context, workspaceID, userID uint64) error
+	DeleteWorkspaceMembers(ctx context.Context, workspaceID uint64) error
+}
+
+type InvitationRepository interface {
+	CreateInvitations(ctx context.Context, invitations []*Invitation) error
+	// GetInvitationByTokenHash 按令牌哈希查询邀请，不存在时返回 ErrInvitationNotFound
+	GetInvitationByTokenHash(ctx context.Context, hash string) (*Invitation, error)
+	// ListUsableInvitations 查询工作空间在 now 时仍可使用的邀请，按创建时间倒序返回
+	ListUsableInvitations(ctx context.Context, workspaceID uint64, now time.Time) ([]*Invitation, error)
+	// CountUsableInvitations 统计工作空间在 now 时仍可使用的邀请数量
+	CountUsableInvitations(ctx context.Context, workspaceID uint64, now time.Time) (int64, error)
+	// RevokeInvitation 撤销邀请，不存在或已撤销时返回 ErrInvitationNotFound
+	RevokeInvitation(ctx context.Context, workspaceID, id uint64, at time.Time) error
+	// ConsumeInvitation 在 now 时邀请仍可使用的条件下将使用次数加一，邀请不可用时返回 false
+	ConsumeInvitation(ctx context.Context, id uint64, now time.Time) (bool, error)
+	DeleteWorkspaceInvitations(ctx context.Context, workspaceID uint64) error
+}
+
+// UserClient 用户服务中与工作空间相关的操作
+type UserClient interface {
+	// VerifiedEmail 查询用户已验证的邮箱，邮箱未验证时返回空字符串
+	VerifiedEmail(ctx context.Context, userID uint64) (string, error)
+}
+
+// InvitationNotifier 发送邀请通知
+type InvitationNotifier interface {
+	// InviteURL 返回接受邀请的页面地址
+	InviteURL(token string) string
+	// SendInvitation 向 invitation.Email 发送加入 workspace 的邀请邮件
+	SendInvitation(ctx context.Context, workspace *Workspace, invitation *Invitation, token string) error
+}
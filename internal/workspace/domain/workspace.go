@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/domain"
+)
+
+// maxOwnedWorkspaces 每个用户最多拥有的工作空间数量
+const maxOwnedWorkspaces = 20
+
+type WorkspaceService interface {
+	// CreateWorkspace 创建工作空间，创建者成为所有者
+	CreateWorkspace(ctx context.Context, userID uint64, name string) (*Workspace, error)
+	// GetWorkspace 查询用户加入的工作空间，不是成员时返回 ErrWorkspaceNotFound
+	GetWorkspace(ctx context.Context, userID, id uint64) (*Workspace, error)
+	// ListWorkspaces 查询用户加入的全部工作空间
+	ListWorkspaces(ctx context.Context, userID uint64) ([]*Workspace, error)
+	// RenameWorkspace 重命名工作空间，需要管理员及以上角色
+	RenameWorkspace(ctx context.Context, userID, id uint64, name string) error
+	// DeleteWorkspace 删除工作空间及其成员与邀请，只有所有者可以删除
+	DeleteWorkspace(ctx context.Context, userID, id uint64) error
+}
+
+type workspaceService struct {
+	srv        *domain.Service
+	workspace  WorkspaceRepository
+	member     MemberRepository
+	invitation InvitationRepository
+}
+
+func (w *workspaceService) CreateWorkspace(ctx context.Context, userID uint64, name string) (*Workspace, error) {
+	name, err := NormalizeWorkspaceName(name)
+	if err != nil {
+		return nil, err
+	}
+	owned, err := w.workspace.CountOwnedWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Workspace] count owned workspaces: %w", err)
+	}
+	if owned >= maxOwnedWorkspaces {
+		return nil, ErrTooManyWorkspaces
+	}
+	id, err := w.srv.Sid.GenUint64()
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Workspace] gen sid field: %w", err)
+	}
+	now := time.Now()
+	workspace := &Workspace{
+		ID:        id,
+		Name:      name,
+		OwnerID:   userID,
+		Role:      RoleOwner,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	err = w.srv.Tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := w.workspace.CreateWorkspace(ctx, workspace); err != nil {
+			return err
+		}
+		return w.member.AddMember(ctx, &Member{
+			WorkspaceID: id,
+			UserID:      userID,
+			Role:        RoleOwner,
+			CreatedAt:   now,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Workspace] create workspace: %w", err)
+	}
+	return workspace, nil
+}
+
+func (w *workspaceService) GetWorkspace(ctx context.Context, userID, id uint64) (*Workspace, error) {
+	member, err := requireRole(ctx, w.member, id, userID, RoleViewer)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Workspace] %w", err)
+	}
+	workspace, err := w.workspace.GetWorkspace(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Workspace] get workspace: %w", err)
+	}
+	workspace.Role = member.Role
+	return workspace, nil
+}
+
+func (w *workspaceService) ListWorkspaces(ctx context.Context, userID uint64) ([]*Workspace, error) {
+	workspaces, err := w.workspace.ListUserWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[Domain.Service.Workspace] list workspaces: %w", err)
+	}
+	return workspaces, nil
+}
+
+func (w *workspaceService) RenameWorkspace(ctx context.Context, userID, id uint64, name string) error {
+	name, err := NormalizeWorkspaceName(name)
+	if err != nil {
+		return err
+	}
+	if _, err := requireRole(ctx, w.member, id, userID, RoleAdmin); err != nil {
+		return fmt.Errorf("[Domain.Service.Workspace] %w", err)
+	}
+	if err := w.workspace.RenameWorkspace(ctx, id, name, time.Now()); err != nil {
+		return fmt.Errorf("[Domain.Service.Workspace] rename workspace: %w", err)
+	}
+	return nil
+}
+
+func (w *workspaceService) DeleteWorkspace(ctx context.Context, userID, id uint64) error {
+	if _, err := requireRole(ctx, w.member, id, userID, RoleOwner); err != nil {
+		return fmt.Errorf("[Domain.Service.Workspace] %w", err)
+	}
+	err := w.srv.Tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := w.workspace.DeleteWorkspace(ctx, id); err != nil {
+			return err
+		}
+		if err := w.invitation.DeleteWorkspaceInvitations(ctx, id); err != nil {
+			return err
+		}
+		return w.member.DeleteWorkspaceMembers(ctx, id)
+	})
+	if err != nil {
+		return fmt.Errorf("[Domain.Service.Workspace] delete workspace: %w", err)
+	}
+	return nil
+}
+
+func NewWorkspaceService(
+	srv *domain.Service,
+	workspace WorkspaceRepository,
+	member MemberRepository,
+	invitation InvitationRepository,
+) WorkspaceService {
+	return &workspaceService{
+		srv:        srv,
+		workspace:  workspace,
+		member:     member,
+		invitation: invitation,
+	}
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/infrastructure/model"
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/infrastructure/repository/query"
+)
+
+type InvitationRepository struct {
+	repo *Repository
+}
+
+// usable 返回邀请在 now 时仍可使用的查询条件：未撤销、未过期且未达到使用次数上限
+func usable(ctx context.Context, q *query.Query, now time.Time) []gen.Condition {
+	i := q.WorkspaceInvitation
+	return []gen.Condition{
+		i.RevokedAt.IsNull(),
+		i.ExpiresAt.Gt(now),
+		i.WithContext(ctx).Where(i.MaxUses.Eq(0)).Or(i.Uses.LtCol(i.MaxUses)),
+	}
+}
+
+func (i *InvitationRepository) CreateInvitations(ctx context.Context, invitations []*domain.Invitation) error {
+	models := make([]*model.WorkspaceInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		m := &model.WorkspaceInvitation{
+			ID:          invitation.ID,
+			WorkspaceID: invitation.WorkspaceID,
+			InviterID:   invitation.InviterID,
+			Role:        string(invitation.Role),
+			TokenHash:   invitation.TokenHash,
+			MaxUses:     int32(invitation.MaxUses),
+			Uses:        int32(invitation.Uses),
+			ExpiresAt:   invitation.ExpiresAt,
+			CreatedAt:   invitation.CreatedAt,
+		}
+		if invitation.Email != "" {
+			m.Email = &invitation.Email
+		}
+		models = append(models, m)
+	}
+	if err := i.repo.Query(ctx).WorkspaceInvitation.WithContext(ctx).Create(models...); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Invitation]failed to create invitations: %w", err)
+	}
+	return nil
+}
+
+func (i *InvitationRepository) GetInvitationByTokenHash(ctx context.Context, hash string) (*domain.Invitation, error) {
+	q := i.repo.Query(ctx).WorkspaceInvitation
+	res, err := q.WithContext(ctx).Where(q.TokenHash.Eq(hash)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.Invitation]failed to get invitation: %w", err)
+	}
+	return toDomainInvitation(res), nil
+}
+
+func (i *InvitationRepository) ListUsableInvitations(ctx context.Context, workspaceID uint64, now time.Time) ([]*domain.Invitation, error) {
+	query := i.repo.Query(ctx)
+	q := query.WorkspaceInvitation
+	res, err := q.WithContext(ctx).
+		Where(q.WorkspaceID.Eq(workspaceID)).
+		Where(usable(ctx, query, now)...).
+		Order(q.CreatedAt.Desc()).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Invitation]failed to list invitations: %w", err)
+	}
+	invitations := make([]*domain.Invitation, 0, len(res))
+	for _, r := range res {
+		invitations = append(invitations, toDomainInvitation(r))
+	}
+	return invitations, nil
+}
+
+func (i *InvitationRepository) CountUsableInvitations(ctx context.Context, workspaceID uint64, now time.Time) (int64, error) {
+	query := i.repo.Query(ctx)
+	q := query.WorkspaceInvitation
+	count, err := q.WithContext(ctx).
+		Where(q.WorkspaceID.Eq(workspaceID)).
+		Where(usable(ctx, query, now)...).
+		Count()
+	if err != nil {
+		return 0, fmt.Errorf("[Infrastructure.Repository.Invitation]failed to count invitations: %w", err)
+	}
+	return count, nil
+}
+
+func (i *InvitationRepository) RevokeInvitation(ctx context.Context, workspaceID, id uint64, at time.Time) error {
+	q := i.repo.Query(ctx).WorkspaceInvitation
+	info, err := q.WithContext(ctx).
+		Where(q.ID.Eq(id), q.WorkspaceID.Eq(workspaceID), q.RevokedAt.IsNull()).
+		UpdateSimple(q.RevokedAt.Value(at))
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Invitation]failed to revoke invitation %d: %w", id, err)
+	}
+	if info.RowsAffected == 0 {
+		return domain.ErrInvitationNotFound
+	}
+	return nil
+}
+
+// ConsumeInvitation 以可用条件更新使用次数，并发接受同一邀请时不会超过使用次数上限
+func (i *InvitationRepository) ConsumeInvitation(ctx context.Context, id uint64, now time.Time) (bool, error) {
+	query := i.repo.Query(ctx)
+	q := query.WorkspaceInvitation
+	info, err := q.WithContext(ctx).
+		Where(q.ID.Eq(id)).
+		Where(usable(ctx, query, now)...).
+		UpdateSimple(q.Uses.Add(1))
+	if err != nil {
+		return false, fmt.Errorf("[Infrastructure.Repository.Invitation]failed to consume invitation %d: %w", id, err)
+	}
+	return info.RowsAffected > 0, nil
+}
+
+func (i *InvitationRepository) DeleteWorkspaceInvitations(ctx context.Context, workspaceID uint64) error {
+	q := i.repo.Query(ctx).WorkspaceInvitation
+	if _, err := q.WithContext(ctx).Where(q.WorkspaceID.Eq(workspaceID)).Delete(); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Invitation]failed to delete invitations: %w", err)
+	}
+	return nil
+}
+
+func toDomainInvitation(m *model.WorkspaceInvitation) *domain.Invitation {
+	invitation := &domain.Invitation{
+		ID:          m.ID,
+		WorkspaceID: m.WorkspaceID,
+		InviterID:   m.InviterID,
+		Role:        domain.Role(m.Role),
+		TokenHash:   m.TokenHash,
+		MaxUses:     int(m.MaxUses),
+		Uses:        int(m.Uses),
+		ExpiresAt:   m.ExpiresAt,
+		CreatedAt:   m.CreatedAt,
+	}
+	if m.Email != nil {
+		invitation.Email = *m.Email
+	}
+	if m.RevokedAt != nil {
+		invitation.RevokedAt = *m.RevokedAt
+	}
+	return invitation
+}
+
+func NewInvitationRepository(repo *Repository) domain.InvitationRepository {
+	return &InvitationRepository{
+		repo: repo,
+	}
+}
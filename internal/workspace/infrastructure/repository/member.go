@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/infrastructure/model"
+	"github.com/Wenrh2004/lark-lite-server/pkg/cache"
+	"github.com/Wenrh2004/lark-lite-server/pkg/cache/client"
+	"github.com/Wenrh2004/lark-lite-server/pkg/page"
+)
+
+// memberCacheExpire 成员关系缓存时间，其他服务的每次鉴权都会查询成员关系
+const memberCacheExpire = 5 * time.Minute
+
+// memberCacheEntry 成员关系缓存条目，Member 为空表示用户不是成员
+type memberCacheEntry struct {
+	Member *model.WorkspaceMember `json:"member,omitempty"`
+}
+
+type MemberRepository struct {
+	repo  *Repository
+	cache cache.MultiCache[*memberCacheEntry]
+}
+
+func memberCacheKey(workspaceID, userID uint64) string {
+	return fmt.Sprintf("workspace:member:%d:%d", workspaceID, userID)
+}
+
+// invalidate 删除成员关系缓存，失败只记录日志，由缓存过期兜底
+func (m *MemberRepository) invalidate(ctx context.Context, workspaceID, userID uint64) {
+	if err := m.cache.Del(ctx, memberCacheKey(workspaceID, userID)); err != nil {
+		m.repo.logger.WithContext(ctx).Warn("[Infrastructure.Repository.Member]failed to invalidate member cache",
+			zap.Uint64("workspace_id", workspaceID), zap.Uint64("user_id", userID), zap.Error(err))
+	}
+}
+
+func (m *MemberRepository) AddMember(ctx context.Context, member *domain.Member) error {
+	q := m.repo.Query(ctx).WorkspaceMember
+	count, err := q.WithContext(ctx).Where(q.WorkspaceID.Eq(member.WorkspaceID), q.UserID.Eq(member.UserID)).Count()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Member]failed to check member: %w", err)
+	}
+	if count > 0 {
+		return domain.ErrAlreadyMember
+	}
+	err = q.WithContext(ctx).Create(&model.WorkspaceMember{
+		WorkspaceID: member.WorkspaceID,
+		UserID:      member.UserID,
+		Role:        string(member.Role),
+		CreatedAt:   member.CreatedAt,
+		UpdatedAt:   member.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Member]failed to add member: %w", err)
+	}
+	m.invalidate(ctx, member.WorkspaceID, member.UserID)
+	return nil
+}
+
+// GetMember 先读缓存，未命中时回源数据库，不是成员的结果同样会被缓存
+func (m *MemberRepository) GetMember(ctx context.Context, workspaceID, userID uint64) (*domain.Member, error) {
+	entry, err := m.cache.GetAndSingleSet(ctx, memberCacheKey(workspaceID, userID), memberCacheExpire, func() (*memberCacheEntry, error) {
+		q := m.repo.Query(ctx).WorkspaceMember
+		res, err := q.WithContext(ctx).Where(q.WorkspaceID.Eq(workspaceID), q.UserID.Eq(userID)).First()
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &memberCacheEntry{}, nil
+			}
+			return nil, err
+		}
+		return &memberCacheEntry{Member: res}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Member]failed to get member: %w", err)
+	}
+	if entry == nil || entry.Member == nil {
+		return nil, domain.ErrMemberNotFound
+	}
+	return toDomainMember(entry.Member), nil
+}
+
+func (m *MemberRepository) ListMembers(ctx context.Context, workspaceID uint64, p page.Page) ([]*domain.Member, int64, error) {
+	q := m.repo.Query(ctx).WorkspaceMember
+	res, total, err := q.WithContext(ctx).
+		Where(q.WorkspaceID.Eq(workspaceID)).
+		Order(q.CreatedAt, q.UserID).
+		FindByPage(p.Offset, p.Limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("[Infrastructure.Repository.Member]failed to list members: %w", err)
+	}
+	members := make([]*domain.Member, 0, len(res))
+	for _, r := range res {
+		members = append(members, toDomainMember(r))
+	}
+	return members, total, nil
+}
+
+func (m *MemberRepository) UpdateMemberRole(ctx context.Context, workspaceID, userID uint64, role domain.Role) error {
+	q := m.repo.Query(ctx).WorkspaceMember
+	info, err := q.WithContext(ctx).
+		Where(q.WorkspaceID.Eq(workspaceID), q.UserID.Eq(userID)).
+		UpdateSimple(q.Role.Value(string(role)), q.UpdatedAt.Value(time.Now()))
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Member]failed to update member role: %w", err)
+	}
+	m.invalidate(ctx, workspaceID, userID)
+	if info.RowsAffected == 0 {
+		return domain.ErrMemberNotFound
+	}
+	return nil
+}
+
+func (m *MemberRepository) RemoveMember(ctx context.Context, workspaceID, userID uint64) error {
+	q := m.repo.Query(ctx).WorkspaceMember
+	info, err := q.WithContext(ctx).Where(q.WorkspaceID.Eq(workspaceID), q.UserID.Eq(userID)).Delete()
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Member]failed to remove member: %w", err)
+	}
+	m.invalidate(ctx, workspaceID, userID)
+	if info.RowsAffected == 0 {
+		return domain.ErrMemberNotFound
+	}
+	return nil
+}
+
+func (m *MemberRepository) DeleteWorkspaceMembers(ctx context.Context, workspaceID uint64) error {
+	q := m.repo.Query(ctx).WorkspaceMember
+	var userIDs []uint64
+	if err := q.WithContext(ctx).Where(q.WorkspaceID.Eq(workspaceID)).Pluck(q.UserID, &userIDs); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Member]failed to list members: %w", err)
+	}
+	if _, err := q.WithContext(ctx).Where(q.WorkspaceID.Eq(workspaceID)).Delete(); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Member]failed to delete members: %w", err)
+	}
+	for _, userID := range userIDs {
+		m.invalidate(ctx, workspaceID, userID)
+	}
+	return nil
+}
+
+func toDomainMember(m *model.WorkspaceMember) *domain.Member {
+	return &domain.Member{
+		WorkspaceID: m.WorkspaceID,
+		UserID:      m.UserID,
+		Role:        domain.Role(m.Role),
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func NewMemberRepository(conf *viper.Viper, repo *Repository, caches []client.Cache) domain.MemberRepository {
+	return &MemberRepository{
+		repo:  repo,
+		cache: cache.NewMultiCache[*memberCacheEntry](conf, caches),
+	}
+}
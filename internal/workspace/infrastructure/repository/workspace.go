@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/domain"
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/infrastructure/model"
+)
+
+type WorkspaceRepository struct {
+	repo *Repository
+}
+
+func (w *WorkspaceRepository) CreateWorkspace(ctx context.Context, workspace *domain.Workspace) error {
+	err := w.repo.Query(ctx).Workspace.WithContext(ctx).Create(&model.Workspace{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		OwnerID:   workspace.OwnerID,
+		CreatedAt: workspace.CreatedAt,
+		UpdatedAt: workspace.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Workspace]failed to create workspace: %w", err)
+	}
+	return nil
+}
+
+func (w *WorkspaceRepository) GetWorkspace(ctx context.Context, id uint64) (*domain.Workspace, error) {
+	q := w.repo.Query(ctx).Workspace
+	res, err := q.WithContext(ctx).Where(q.ID.Eq(id)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWorkspaceNotFound
+		}
+		return nil, fmt.Errorf("[Infrastructure.Repository.Workspace]failed to get workspace %d: %w", id, err)
+	}
+	return toDomainWorkspace(res), nil
+}
+
+func (w *WorkspaceRepository) RenameWorkspace(ctx context.Context, id uint64, name string, at time.Time) error {
+	q := w.repo.Query(ctx).Workspace
+	info, err := q.WithContext(ctx).Where(q.ID.Eq(id)).
+		UpdateSimple(q.Name.Value(name), q.UpdatedAt.Value(at))
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Workspace]failed to rename workspace %d: %w", id, err)
+	}
+	if info.RowsAffected == 0 {
+		return domain.ErrWorkspaceNotFound
+	}
+	return nil
+}
+
+func (w *WorkspaceRepository) TransferOwnership(ctx context.Context, id, ownerID uint64, at time.Time) error {
+	q := w.repo.Query(ctx).Workspace
+	info, err := q.WithContext(ctx).Where(q.ID.Eq(id)).
+		UpdateSimple(q.OwnerID.Value(ownerID), q.UpdatedAt.Value(at))
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Workspace]failed to transfer workspace %d: %w", id, err)
+	}
+	if info.RowsAffected == 0 {
+		return domain.ErrWorkspaceNotFound
+	}
+	return nil
+}
+
+func (w *WorkspaceRepository) DeleteWorkspace(ctx context.Context, id uint64) error {
+	q := w.repo.Query(ctx).Workspace
+	if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Delete(); err != nil {
+		return fmt.Errorf("[Infrastructure.Repository.Workspace]failed to delete workspace %d: %w", id, err)
+	}
+	return nil
+}
+
+func (w *WorkspaceRepository) ListUserWorkspaces(ctx context.Context, userID uint64) ([]*domain.Workspace, error) {
+	query := w.repo.Query(ctx)
+	m := query.WorkspaceMember
+	members, err := m.WithContext(ctx).Where(m.UserID.Eq(userID)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Workspace]failed to list memberships: %w", err)
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	roles := make(map[uint64]domain.Role, len(members))
+	ids := make([]uint64, 0, len(members))
+	for _, member := range members {
+		roles[member.WorkspaceID] = domain.Role(member.Role)
+		ids = append(ids, member.WorkspaceID)
+	}
+	q := query.Workspace
+	res, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Order(q.CreatedAt).Find()
+	if err != nil {
+		return nil, fmt.Errorf("[Infrastructure.Repository.Workspace]failed to list workspaces: %w", err)
+	}
+	workspaces := make([]*domain.Workspace, 0, len(res))
+	for _, r := range res {
+		workspace := toDomainWorkspace(r)
+		workspace.Role = roles[r.ID]
+		workspaces = append(workspaces, workspace)
+	}
+	return workspaces, nil
+}
+
+func (w *WorkspaceRepository) CountOwnedWorkspaces(ctx context.Context, userID uint64) (int64, error) {
+	q := w.repo.Query(ctx).Workspace
+	count, err := q.WithContext(ctx).Where(q.OwnerID.Eq(userID)).Count()
+	if err != nil {
+		return 0, fmt.Errorf("[Infrastructure.Repository.Workspace]failed to count workspaces: %w", err)
+	}
+	return count, nil
+}
+
+func toDomainWorkspace(m *model.Workspace) *domain.Workspace {
+	return &domain.Workspace{
+		ID:        m.ID,
+		Name:      m.Name,
+		OwnerID:   m.OwnerID,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func NewWorkspaceRepository(repo *Repository) domain.WorkspaceRepository {
+	return &WorkspaceRepository{
+		repo: repo,
+	}
+}
@@ -0,0 +1,59 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/domain"
+	mailpkg "github.com/Wenrh2004/lark-lite-server/pkg/mail"
+)
+
+// roleNames 邮件中展示的角色名称
+var roleNames = map[domain.Role]string{
+	domain.RoleOwner:  "所有者",
+	domain.RoleAdmin:  "管理员",
+	domain.RoleMember: "成员",
+	domain.RoleViewer: "访客",
+}
+
+type Notifier struct {
+	sender    mailpkg.Sender
+	inviteURL string
+}
+
+// InviteURL 将令牌作为 token 查询参数拼接到 app.workspace.invite_url 上
+func (n *Notifier) InviteURL(token string) string {
+	sep := "?"
+	if strings.Contains(n.inviteURL, "?") {
+		sep = "&"
+	}
+	return n.inviteURL + sep + "token=" + url.QueryEscape(token)
+}
+
+func (n *Notifier) SendInvitation(ctx context.Context, workspace *domain.Workspace, invitation *domain.Invitation, token string) error {
+	body, err := mailpkg.Render(mailpkg.WorkspaceInvitationTemplate, mailpkg.TemplateData{
+		"Workspace": workspace.Name,
+		"Role":      roleNames[invitation.Role],
+		"URL":       n.InviteURL(token),
+		"ExpiresAt": invitation.ExpiresAt.Format("2006-01-02 15:04:05 MST"),
+	})
+	if err != nil {
+		return fmt.Errorf("[Infrastructure.Third.Mail]failed to render invitation: %w", err)
+	}
+	subject := fmt.Sprintf("邀请您加入工作空间「%s」", workspace.Name)
+	if err := n.sender.Send([]string{invitation.Email}, subject, body, true); err != nil {
+		return fmt.Errorf("[Infrastructure.Third.Mail]failed to send invitation: %w", err)
+	}
+	return nil
+}
+
+func NewNotifier(conf *viper.Viper, sender mailpkg.Sender) domain.InvitationNotifier {
+	return &Notifier{
+		sender:    sender,
+		inviteURL: conf.GetString("app.workspace.invite_url"),
+	}
+}
@@ -0,0 +1,71 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/discovery"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/transport"
+	"github.com/spf13/viper"
+
+	"github.com/Wenrh2004/lark-lite-server/internal/workspace/domain"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/user"
+	"github.com/Wenrh2004/lark-lite-server/kitex_gen/user/userservice"
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+)
+
+// NewUserServiceClient 创建用户服务客户端，未配置注册中心时使用 app.client.user.addrs 中的固定地址，
+// 使用 TTHeader 透传调用方令牌
+func NewUserServiceClient(conf *viper.Viper, resolver discovery.Resolver) userservice.Client {
+	name := conf.GetString("app.client.user.name")
+	if name == "" {
+		name = "user"
+	}
+	opts := []client.Option{
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+	}
+	if resolver != nil {
+		opts = append(opts, client.WithResolver(resolver))
+	} else {
+		opts = append(opts, client.WithHostPorts(conf.GetStringSlice("app.client.user.addrs")...))
+	}
+	cli, err := userservice.NewClient(name, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return cli
+}
+
+type Client struct {
+	cli userservice.Client
+}
+
+// VerifiedEmail 使用上游透传的调用方令牌查询用户资料，用户服务只允许查询调用方本人，
+// 没有可透传的令牌或用户服务拒绝查询时返回 domain.ErrPermissionDenied
+func (c *Client) VerifiedEmail(ctx context.Context, userID uint64) (string, error) {
+	if !authz.HasToken(ctx) {
+		return "", domain.ErrPermissionDenied
+	}
+	resp, err := c.cli.GetUserInfo(authz.ForwardToken(ctx), &user.GetUserInfoRequest{UserId: int64(userID)})
+	if err != nil {
+		return "", fmt.Errorf("[Infrastructure.Third.User]failed to get user %d: %w", userID, err)
+	}
+	switch code := resp.GetResp().GetCode(); code {
+	case 0:
+	case 401, 403:
+		return "", domain.ErrPermissionDenied
+	default:
+		return "", fmt.Errorf("[Infrastructure.Third.User]failed to get user %d: %d %s", userID, code, resp.GetResp().GetMessage())
+	}
+	if !resp.GetUser().GetEmailVerified() {
+		return "", nil
+	}
+	return resp.GetUser().GetEmail(), nil
+}
+
+func NewUserClient(cli userservice.Client) domain.UserClient {
+	return &Client{cli: cli}
+}
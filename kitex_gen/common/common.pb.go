@@ -28,3 +28,30 @@ func (x *BaseResponse) GetMessage() string {
 	}
 	return ""
 }
+
+type PageRequest struct {
+	Offset int32 `protobuf:"varint,1,opt,name=offset" json:"offset,omitempty"`
+
+	// 为 0 时使用服务端默认值
+	Limit int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (x *PageRequest) Reset() { *x = PageRequest{} }
+
+func (x *PageRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *PageRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *PageRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
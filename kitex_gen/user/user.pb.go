@@ -77,15 +77,28 @@ func (x *RefreshRequest) GetRefreshToken() string {
 }
 
 type UpdateRequest struct {
-	UserId        int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
-	Email         string `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
-	Name          string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
-	Nickname      string `protobuf:"bytes,4,opt,name=nickname" json:"nickname,omitempty"`
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Nickname string `protobuf:"bytes,4,opt,name=nickname" json:"nickname,omitempty"`
+
+	// 已废弃，头像与背景图使用 avatar_file_id 与 background_file_id
 	AvatarUrl     string `protobuf:"bytes,5,opt,name=avatar_url" json:"avatar_url,omitempty"`
 	BackgroundUrl string `protobuf:"bytes,6,opt,name=background_url" json:"background_url,omitempty"`
 	Signature     string `protobuf:"bytes,7,opt,name=signature" json:"signature,omitempty"`
 	Phone         string `protobuf:"bytes,8,opt,name=phone" json:"phone,omitempty"`
 	Gender        int32  `protobuf:"varint,9,opt,name=gender" json:"gender,omitempty"`
+
+	// 需要更新的字段：nickname、avatar_file_id、background_file_id、signature、email、phone、gender，
+	// 未列出的字段保持不变，列出但值为空的字段会被清空
+	UpdateMask []string `protobuf:"bytes,10,rep,name=update_mask" json:"update_mask,omitempty"`
+
+	// 客户端读取资料时的 updated_at，非零时资料已被修改则返回冲突
+	UpdatedAt int64 `protobuf:"varint,11,opt,name=updated_at" json:"updated_at,omitempty"`
+
+	// 通过 UploadProfileImage 上传的图片文件 ID，为 0 表示清空
+	AvatarFileId     uint64 `protobuf:"varint,12,opt,name=avatar_file_id" json:"avatar_file_id,omitempty"`
+	BackgroundFileId uint64 `protobuf:"varint,13,opt,name=background_file_id" json:"background_file_id,omitempty"`
 }
 
 func (x *UpdateRequest) Reset() { *x = UpdateRequest{} }
@@ -157,6 +170,34 @@ func (x *UpdateRequest) GetGender() int32 {
 	return 0
 }
 
+func (x *UpdateRequest) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetAvatarFileId() uint64 {
+	if x != nil {
+		return x.AvatarFileId
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetBackgroundFileId() uint64 {
+	if x != nil {
+		return x.BackgroundFileId
+	}
+	return 0
+}
+
 type TokenPair struct {
 	AccessToken      string `protobuf:"bytes,1,opt,name=access_token" json:"access_token,omitempty"`
 	AccessExpiresIn  int64  `protobuf:"varint,2,opt,name=access_expires_in" json:"access_expires_in,omitempty"`
@@ -199,11 +240,27 @@ func (x *TokenPair) GetRefreshExpiresIn() int64 {
 }
 
 type UserAuthInfo struct {
-	UserId    int64      `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
-	Username  string     `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
-	Nickname  string     `protobuf:"bytes,4,opt,name=nickname" json:"nickname,omitempty"`
-	AvatarUrl string     `protobuf:"bytes,5,opt,name=avatar_url" json:"avatar_url,omitempty"`
-	Token     *TokenPair `protobuf:"bytes,6,opt,name=token" json:"token,omitempty"`
+	UserId        int64      `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Username      string     `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	Nickname      string     `protobuf:"bytes,4,opt,name=nickname" json:"nickname,omitempty"`
+	AvatarUrl     string     `protobuf:"bytes,5,opt,name=avatar_url" json:"avatar_url,omitempty"`
+	Token         *TokenPair `protobuf:"bytes,6,opt,name=token" json:"token,omitempty"`
+	Email         string     `protobuf:"bytes,7,opt,name=email" json:"email,omitempty"`
+	EmailVerified bool       `protobuf:"varint,8,opt,name=email_verified" json:"email_verified,omitempty"`
+	Phone         string     `protobuf:"bytes,9,opt,name=phone" json:"phone,omitempty"`
+	PhoneVerified bool       `protobuf:"varint,10,opt,name=phone_verified" json:"phone_verified,omitempty"`
+
+	// 用户启用二次验证时 token 为空，需使用 mfa_token 调用 VerifyMFA 完成登录
+	MfaToken      string `protobuf:"bytes,11,opt,name=mfa_token" json:"mfa_token,omitempty"`
+	MfaExpiresIn  int64  `protobuf:"varint,12,opt,name=mfa_expires_in" json:"mfa_expires_in,omitempty"`
+	BackgroundUrl string `protobuf:"bytes,13,opt,name=background_url" json:"background_url,omitempty"`
+	Signature     string `protobuf:"bytes,14,opt,name=signature" json:"signature,omitempty"`
+	Gender        int32  `protobuf:"varint,15,opt,name=gender" json:"gender,omitempty"`
+
+	// 资料最近更新时间，部分更新时回传用于并发控制
+	UpdatedAt        int64  `protobuf:"varint,16,opt,name=updated_at" json:"updated_at,omitempty"`
+	AvatarFileId     uint64 `protobuf:"varint,17,opt,name=avatar_file_id" json:"avatar_file_id,omitempty"`
+	BackgroundFileId uint64 `protobuf:"varint,18,opt,name=background_file_id" json:"background_file_id,omitempty"`
 }
 
 func (x *UserAuthInfo) Reset() { *x = UserAuthInfo{} }
@@ -247,6 +304,90 @@ func (x *UserAuthInfo) GetToken() *TokenPair {
 	return nil
 }
 
+func (x *UserAuthInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserAuthInfo) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *UserAuthInfo) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UserAuthInfo) GetPhoneVerified() bool {
+	if x != nil {
+		return x.PhoneVerified
+	}
+	return false
+}
+
+func (x *UserAuthInfo) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *UserAuthInfo) GetMfaExpiresIn() int64 {
+	if x != nil {
+		return x.MfaExpiresIn
+	}
+	return 0
+}
+
+func (x *UserAuthInfo) GetBackgroundUrl() string {
+	if x != nil {
+		return x.BackgroundUrl
+	}
+	return ""
+}
+
+func (x *UserAuthInfo) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *UserAuthInfo) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *UserAuthInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *UserAuthInfo) GetAvatarFileId() uint64 {
+	if x != nil {
+		return x.AvatarFileId
+	}
+	return 0
+}
+
+func (x *UserAuthInfo) GetBackgroundFileId() uint64 {
+	if x != nil {
+		return x.BackgroundFileId
+	}
+	return 0
+}
+
 type UserAuthInfoResponse struct {
 	Resp *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
 	User *UserAuthInfo        `protobuf:"bytes,2,opt,name=user" json:"user,omitempty"`
@@ -339,10 +480,2222 @@ func (x *GetUserInfoResponse) GetUser() *UserAuthInfo {
 	return nil
 }
 
+type LogoutRequest struct {
+	AccessToken  string `protobuf:"bytes,1,opt,name=access_token" json:"access_token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token" json:"refresh_token,omitempty"`
+}
+
+func (x *LogoutRequest) Reset() { *x = LogoutRequest{} }
+
+func (x *LogoutRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *LogoutRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *LogoutRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutAllRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+}
+
+func (x *LogoutAllRequest) Reset() { *x = LogoutAllRequest{} }
+
+func (x *LogoutAllRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *LogoutAllRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *LogoutAllRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SendEmailCodeRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+
+	// 为空时发送到用户当前邮箱
+	Email string `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+}
+
+func (x *SendEmailCodeRequest) Reset() { *x = SendEmailCodeRequest{} }
+
+func (x *SendEmailCodeRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SendEmailCodeRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SendEmailCodeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SendEmailCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type VerifyEmailRequest struct {
+	UserId int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Email  string `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+	Code   string `protobuf:"bytes,3,opt,name=code" json:"code,omitempty"`
+}
+
+func (x *VerifyEmailRequest) Reset() { *x = VerifyEmailRequest{} }
+
+func (x *VerifyEmailRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *VerifyEmailRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *VerifyEmailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerifyEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *VerifyEmailRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RequestPasswordResetRequest struct {
+	Email string `protobuf:"bytes,1,opt,name=email" json:"email,omitempty"`
+}
+
+func (x *RequestPasswordResetRequest) Reset() { *x = RequestPasswordResetRequest{} }
+
+func (x *RequestPasswordResetRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RequestPasswordResetRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	Email    string `protobuf:"bytes,1,opt,name=email" json:"email,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password" json:"password,omitempty"`
+}
+
+func (x *ResetPasswordRequest) Reset() { *x = ResetPasswordRequest{} }
+
+func (x *ResetPasswordRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ResetPasswordRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ResetPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SendPhoneCodeRequest struct {
+	Phone string `protobuf:"bytes,1,opt,name=phone" json:"phone,omitempty"`
+}
+
+func (x *SendPhoneCodeRequest) Reset() { *x = SendPhoneCodeRequest{} }
+
+func (x *SendPhoneCodeRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SendPhoneCodeRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SendPhoneCodeRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type PhoneLoginRequest struct {
+	Phone string `protobuf:"bytes,1,opt,name=phone" json:"phone,omitempty"`
+	Code  string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+}
+
+func (x *PhoneLoginRequest) Reset() { *x = PhoneLoginRequest{} }
+
+func (x *PhoneLoginRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *PhoneLoginRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *PhoneLoginRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *PhoneLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type OAuthAuthorizeRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider" json:"provider,omitempty"`
+
+	// 非零时授权完成后为该用户绑定身份
+	UserId int64 `protobuf:"varint,2,opt,name=user_id" json:"user_id,omitempty"`
+}
+
+func (x *OAuthAuthorizeRequest) Reset() { *x = OAuthAuthorizeRequest{} }
+
+func (x *OAuthAuthorizeRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *OAuthAuthorizeRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *OAuthAuthorizeRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OAuthAuthorizeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type OAuthAuthorizeResponse struct {
+	Resp    *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	AuthUrl string               `protobuf:"bytes,2,opt,name=auth_url" json:"auth_url,omitempty"`
+	State   string               `protobuf:"bytes,3,opt,name=state" json:"state,omitempty"`
+}
+
+func (x *OAuthAuthorizeResponse) Reset() { *x = OAuthAuthorizeResponse{} }
+
+func (x *OAuthAuthorizeResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *OAuthAuthorizeResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *OAuthAuthorizeResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *OAuthAuthorizeResponse) GetAuthUrl() string {
+	if x != nil {
+		return x.AuthUrl
+	}
+	return ""
+}
+
+func (x *OAuthAuthorizeResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type OAuthCallbackRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider" json:"provider,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	State    string `protobuf:"bytes,3,opt,name=state" json:"state,omitempty"`
+}
+
+func (x *OAuthCallbackRequest) Reset() { *x = OAuthCallbackRequest{} }
+
+func (x *OAuthCallbackRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *OAuthCallbackRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *OAuthCallbackRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OAuthCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OAuthCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type OAuthCallbackResponse struct {
+	Resp *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	User *UserAuthInfo        `protobuf:"bytes,2,opt,name=user" json:"user,omitempty"`
+
+	// 为 true 时表示完成绑定，不签发令牌
+	Linked bool `protobuf:"varint,3,opt,name=linked" json:"linked,omitempty"`
+}
+
+func (x *OAuthCallbackResponse) Reset() { *x = OAuthCallbackResponse{} }
+
+func (x *OAuthCallbackResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *OAuthCallbackResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *OAuthCallbackResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *OAuthCallbackResponse) GetUser() *UserAuthInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *OAuthCallbackResponse) GetLinked() bool {
+	if x != nil {
+		return x.Linked
+	}
+	return false
+}
+
+type Identity struct {
+	Provider  string `protobuf:"bytes,1,opt,name=provider" json:"provider,omitempty"`
+	Subject   string `protobuf:"bytes,2,opt,name=subject" json:"subject,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=created_at" json:"created_at,omitempty"`
+}
+
+func (x *Identity) Reset() { *x = Identity{} }
+
+func (x *Identity) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *Identity) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *Identity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Identity) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Identity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Identity) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListIdentitiesRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+}
+
+func (x *ListIdentitiesRequest) Reset() { *x = ListIdentitiesRequest{} }
+
+func (x *ListIdentitiesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListIdentitiesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListIdentitiesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListIdentitiesResponse struct {
+	Resp       *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Identities []*Identity          `protobuf:"bytes,2,rep,name=identities" json:"identities,omitempty"`
+}
+
+func (x *ListIdentitiesResponse) Reset() { *x = ListIdentitiesResponse{} }
+
+func (x *ListIdentitiesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListIdentitiesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListIdentitiesResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*Identity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type UnlinkIdentityRequest struct {
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider" json:"provider,omitempty"`
+}
+
+func (x *UnlinkIdentityRequest) Reset() { *x = UnlinkIdentityRequest{} }
+
+func (x *UnlinkIdentityRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *UnlinkIdentityRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UnlinkIdentityRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnlinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type VerifyMFARequest struct {
+	MfaToken string `protobuf:"bytes,1,opt,name=mfa_token" json:"mfa_token,omitempty"`
+
+	// TOTP 验证码或恢复码
+	Code string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+}
+
+func (x *VerifyMFARequest) Reset() { *x = VerifyMFARequest{} }
+
+func (x *VerifyMFARequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *VerifyMFARequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *VerifyMFARequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type EnrollTOTPRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+}
+
+func (x *EnrollTOTPRequest) Reset() { *x = EnrollTOTPRequest{} }
+
+func (x *EnrollTOTPRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *EnrollTOTPRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *EnrollTOTPRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type EnrollTOTPResponse struct {
+	Resp   *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Secret string               `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
+
+	// otpauth:// 地址，用于生成二维码
+	Uri string `protobuf:"bytes,3,opt,name=uri" json:"uri,omitempty"`
+}
+
+func (x *EnrollTOTPResponse) Reset() { *x = EnrollTOTPResponse{} }
+
+func (x *EnrollTOTPResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *EnrollTOTPResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *EnrollTOTPResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type ConfirmTOTPRequest struct {
+	UserId int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+}
+
+func (x *ConfirmTOTPRequest) Reset() { *x = ConfirmTOTPRequest{} }
+
+func (x *ConfirmTOTPRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ConfirmTOTPRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ConfirmTOTPRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTOTPRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+
+	// TOTP 验证码或恢复码
+	Code string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+}
+
+func (x *DisableTOTPRequest) Reset() { *x = DisableTOTPRequest{} }
+
+func (x *DisableTOTPRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *DisableTOTPRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DisableTOTPRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DisableTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RegenerateRecoveryCodesRequest struct {
+	UserId int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() { *x = RegenerateRecoveryCodesRequest{} }
+
+func (x *RegenerateRecoveryCodesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RegenerateRecoveryCodesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RegenerateRecoveryCodesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RecoveryCodesResponse struct {
+	Resp *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+
+	// 明文恢复码只在生成时返回一次
+	RecoveryCodes []string `protobuf:"bytes,2,rep,name=recovery_codes" json:"recovery_codes,omitempty"`
+}
+
+func (x *RecoveryCodesResponse) Reset() { *x = RecoveryCodesResponse{} }
+
+func (x *RecoveryCodesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RecoveryCodesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RecoveryCodesResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *RecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type Policy struct {
+	// 角色 role:<name> 或用户 user:<id>
+	Subject string `protobuf:"bytes,1,opt,name=subject" json:"subject,omitempty"`
+
+	// * 表示全部域
+	Domain string `protobuf:"bytes,2,opt,name=domain" json:"domain,omitempty"`
+	Object string `protobuf:"bytes,3,opt,name=object" json:"object,omitempty"`
+	Action string `protobuf:"bytes,4,opt,name=action" json:"action,omitempty"`
+}
+
+func (x *Policy) Reset() { *x = Policy{} }
+
+func (x *Policy) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *Policy) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *Policy) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Policy) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Policy) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *Policy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type ListPoliciesRequest struct {
+	// 为空的字段匹配任意值
+	Filter *Policy `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (x *ListPoliciesRequest) Reset() { *x = ListPoliciesRequest{} }
+
+func (x *ListPoliciesRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListPoliciesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListPoliciesRequest) GetFilter() *Policy {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListPoliciesResponse struct {
+	Resp     *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Policies []*Policy            `protobuf:"bytes,2,rep,name=policies" json:"policies,omitempty"`
+}
+
+func (x *ListPoliciesResponse) Reset() { *x = ListPoliciesResponse{} }
+
+func (x *ListPoliciesResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListPoliciesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListPoliciesResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *ListPoliciesResponse) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type PolicyRequest struct {
+	Policy *Policy `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (x *PolicyRequest) Reset() { *x = PolicyRequest{} }
+
+func (x *PolicyRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *PolicyRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *PolicyRequest) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type GetUserRolesRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+
+	// 为空时查询全局角色
+	Domain string `protobuf:"bytes,2,opt,name=domain" json:"domain,omitempty"`
+}
+
+func (x *GetUserRolesRequest) Reset() { *x = GetUserRolesRequest{} }
+
+func (x *GetUserRolesRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *GetUserRolesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetUserRolesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserRolesRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type GetUserRolesResponse struct {
+	Resp  *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Roles []string             `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
+}
+
+func (x *GetUserRolesResponse) Reset() { *x = GetUserRolesResponse{} }
+
+func (x *GetUserRolesResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *GetUserRolesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetUserRolesResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *GetUserRolesResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type RoleRequest struct {
+	UserId int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Role   string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+
+	// 为空时为全局角色
+	Domain string `protobuf:"bytes,3,opt,name=domain" json:"domain,omitempty"`
+}
+
+func (x *RoleRequest) Reset() { *x = RoleRequest{} }
+
+func (x *RoleRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *RoleRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type AccessToken struct {
+	Id   int64  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+
+	// 令牌前缀，用于辨认令牌
+	Prefix    string   `protobuf:"bytes,3,opt,name=prefix" json:"prefix,omitempty"`
+	Scopes    []string `protobuf:"bytes,4,rep,name=scopes" json:"scopes,omitempty"`
+	CreatedAt int64    `protobuf:"varint,5,opt,name=created_at" json:"created_at,omitempty"`
+
+	// 为 0 表示永不过期
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expires_at" json:"expires_at,omitempty"`
+
+	// 为 0 表示从未使用
+	LastUsedAt int64 `protobuf:"varint,7,opt,name=last_used_at" json:"last_used_at,omitempty"`
+}
+
+func (x *AccessToken) Reset() { *x = AccessToken{} }
+
+func (x *AccessToken) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *AccessToken) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AccessToken) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccessToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessToken) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *AccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *AccessToken) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AccessToken) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *AccessToken) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+type CreateAccessTokenRequest struct {
+	UserId int64    `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Name   string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes" json:"scopes,omitempty"`
+
+	// 有效期秒数，为 0 表示永不过期
+	ExpiresIn int64 `protobuf:"varint,4,opt,name=expires_in" json:"expires_in,omitempty"`
+}
+
+func (x *CreateAccessTokenRequest) Reset() { *x = CreateAccessTokenRequest{} }
+
+func (x *CreateAccessTokenRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateAccessTokenRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateAccessTokenRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateAccessTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccessTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type CreateAccessTokenResponse struct {
+	Resp  *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Token *AccessToken         `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+
+	// 明文令牌只在创建时返回一次
+	Secret string `protobuf:"bytes,3,opt,name=secret" json:"secret,omitempty"`
+}
+
+func (x *CreateAccessTokenResponse) Reset() { *x = CreateAccessTokenResponse{} }
+
+func (x *CreateAccessTokenResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateAccessTokenResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateAccessTokenResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenResponse) GetToken() *AccessToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListAccessTokensRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+}
+
+func (x *ListAccessTokensRequest) Reset() { *x = ListAccessTokensRequest{} }
+
+func (x *ListAccessTokensRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListAccessTokensRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAccessTokensRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListAccessTokensResponse struct {
+	Resp   *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Tokens []*AccessToken       `protobuf:"bytes,2,rep,name=tokens" json:"tokens,omitempty"`
+}
+
+func (x *ListAccessTokensResponse) Reset() { *x = ListAccessTokensResponse{} }
+
+func (x *ListAccessTokensResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListAccessTokensResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAccessTokensResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *ListAccessTokensResponse) GetTokens() []*AccessToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeAccessTokenRequest struct {
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	TokenId int64 `protobuf:"varint,2,opt,name=token_id" json:"token_id,omitempty"`
+}
+
+func (x *RevokeAccessTokenRequest) Reset() { *x = RevokeAccessTokenRequest{} }
+
+func (x *RevokeAccessTokenRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RevokeAccessTokenRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RevokeAccessTokenRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeAccessTokenRequest) GetTokenId() int64 {
+	if x != nil {
+		return x.TokenId
+	}
+	return 0
+}
+
+type AuthenticateAccessTokenRequest struct {
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+}
+
+func (x *AuthenticateAccessTokenRequest) Reset() { *x = AuthenticateAccessTokenRequest{} }
+
+func (x *AuthenticateAccessTokenRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *AuthenticateAccessTokenRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AuthenticateAccessTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AuthenticateAccessTokenResponse struct {
+	Resp   *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	UserId int64                `protobuf:"varint,2,opt,name=user_id" json:"user_id,omitempty"`
+	Scopes []string             `protobuf:"bytes,3,rep,name=scopes" json:"scopes,omitempty"`
+}
+
+func (x *AuthenticateAccessTokenResponse) Reset() { *x = AuthenticateAccessTokenResponse{} }
+
+func (x *AuthenticateAccessTokenResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *AuthenticateAccessTokenResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AuthenticateAccessTokenResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *AuthenticateAccessTokenResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuthenticateAccessTokenResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type UserSummary struct {
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Username      string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	Nickname      string `protobuf:"bytes,3,opt,name=nickname" json:"nickname,omitempty"`
+	AvatarUrl     string `protobuf:"bytes,4,opt,name=avatar_url" json:"avatar_url,omitempty"`
+	Email         string `protobuf:"bytes,5,opt,name=email" json:"email,omitempty"`
+	EmailVerified bool   `protobuf:"varint,6,opt,name=email_verified" json:"email_verified,omitempty"`
+	Phone         string `protobuf:"bytes,7,opt,name=phone" json:"phone,omitempty"`
+	PhoneVerified bool   `protobuf:"varint,8,opt,name=phone_verified" json:"phone_verified,omitempty"`
+	Status        string `protobuf:"bytes,9,opt,name=status" json:"status,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,10,opt,name=created_at" json:"created_at,omitempty"`
+
+	// 暂停截止时间，仅 suspended 状态有效
+	SuspendedUntil  int64  `protobuf:"varint,11,opt,name=suspended_until" json:"suspended_until,omitempty"`
+	StatusReason    string `protobuf:"bytes,12,opt,name=status_reason" json:"status_reason,omitempty"`
+	StatusChangedAt int64  `protobuf:"varint,13,opt,name=status_changed_at" json:"status_changed_at,omitempty"`
+}
+
+func (x *UserSummary) Reset() { *x = UserSummary{} }
+
+func (x *UserSummary) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *UserSummary) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UserSummary) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserSummary) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserSummary) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UserSummary) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UserSummary) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserSummary) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *UserSummary) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UserSummary) GetPhoneVerified() bool {
+	if x != nil {
+		return x.PhoneVerified
+	}
+	return false
+}
+
+func (x *UserSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserSummary) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *UserSummary) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *UserSummary) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *UserSummary) GetStatusChangedAt() int64 {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return 0
+}
+
+type ListUsersRequest struct {
+	// 以下条件为空时不参与过滤
+	UsernamePrefix string `protobuf:"bytes,1,opt,name=username_prefix" json:"username_prefix,omitempty"`
+	NicknamePrefix string `protobuf:"bytes,2,opt,name=nickname_prefix" json:"nickname_prefix,omitempty"`
+	Email          string `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
+	Phone          string `protobuf:"bytes,4,opt,name=phone" json:"phone,omitempty"`
+
+	// 创建时间区间 [created_from, created_to)，unix 秒
+	CreatedFrom int64 `protobuf:"varint,5,opt,name=created_from" json:"created_from,omitempty"`
+	CreatedTo   int64 `protobuf:"varint,6,opt,name=created_to" json:"created_to,omitempty"`
+
+	// active、suspended、banned 或 deleted，为空时查询未删除的用户
+	Status string `protobuf:"bytes,7,opt,name=status" json:"status,omitempty"`
+
+	// id、username 或 created_at，为空时按 created_at 排序
+	SortBy string              `protobuf:"bytes,8,opt,name=sort_by" json:"sort_by,omitempty"`
+	Desc   bool                `protobuf:"varint,9,opt,name=desc" json:"desc,omitempty"`
+	Page   *common.PageRequest `protobuf:"bytes,10,opt,name=page" json:"page,omitempty"`
+}
+
+func (x *ListUsersRequest) Reset() { *x = ListUsersRequest{} }
+
+func (x *ListUsersRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListUsersRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListUsersRequest) GetUsernamePrefix() string {
+	if x != nil {
+		return x.UsernamePrefix
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetNicknamePrefix() string {
+	if x != nil {
+		return x.NicknamePrefix
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetPage() *common.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListUsersResponse struct {
+	Resp  *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Users []*UserSummary       `protobuf:"bytes,2,rep,name=users" json:"users,omitempty"`
+	Total int64                `protobuf:"varint,3,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListUsersResponse) Reset() { *x = ListUsersResponse{} }
+
+func (x *ListUsersResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListUsersResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListUsersResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetUsers() []*UserSummary {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ChangeUserStatusRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+
+	// active、suspended、banned 或 deleted，已删除的账号在宽限期内可以恢复为 active
+	Status string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+
+	// 暂停截止时间，unix 秒，仅 status 为 suspended 时有效
+	SuspendedUntil int64  `protobuf:"varint,3,opt,name=suspended_until" json:"suspended_until,omitempty"`
+	Reason         string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (x *ChangeUserStatusRequest) Reset() { *x = ChangeUserStatusRequest{} }
+
+func (x *ChangeUserStatusRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ChangeUserStatusRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ChangeUserStatusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangeUserStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChangeUserStatusRequest) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *ChangeUserStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DataJob struct {
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+
+	// export 或 erasure
+	Type string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+
+	// pending、running、succeeded 或 failed
+	Status string `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
+
+	// 最近完成的步骤
+	Step      string `protobuf:"bytes,4,opt,name=step" json:"step,omitempty"`
+	CreatedAt int64  `protobuf:"varint,5,opt,name=created_at" json:"created_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,6,opt,name=updated_at" json:"updated_at,omitempty"`
+
+	// 为 0 表示未结束
+	FinishedAt int64 `protobuf:"varint,7,opt,name=finished_at" json:"finished_at,omitempty"`
+}
+
+func (x *DataJob) Reset() { *x = DataJob{} }
+
+func (x *DataJob) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *DataJob) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DataJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DataJob) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DataJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataJob) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *DataJob) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DataJob) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *DataJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+type DataJobResponse struct {
+	Resp *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Job  *DataJob             `protobuf:"bytes,2,opt,name=job" json:"job,omitempty"`
+
+	// 导出文件的临时下载地址，仅导出任务成功后返回
+	DownloadUrl string `protobuf:"bytes,3,opt,name=download_url" json:"download_url,omitempty"`
+}
+
+func (x *DataJobResponse) Reset() { *x = DataJobResponse{} }
+
+func (x *DataJobResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *DataJobResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DataJobResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *DataJobResponse) GetJob() *DataJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *DataJobResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+type RequestDataExportRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+}
+
+func (x *RequestDataExportRequest) Reset() { *x = RequestDataExportRequest{} }
+
+func (x *RequestDataExportRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RequestDataExportRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RequestDataExportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetDataExportRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	JobId  int64 `protobuf:"varint,2,opt,name=job_id" json:"job_id,omitempty"`
+}
+
+func (x *GetDataExportRequest) Reset() { *x = GetDataExportRequest{} }
+
+func (x *GetDataExportRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *GetDataExportRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetDataExportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetDataExportRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type RequestAccountErasureRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+}
+
+func (x *RequestAccountErasureRequest) Reset() { *x = RequestAccountErasureRequest{} }
+
+func (x *RequestAccountErasureRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RequestAccountErasureRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RequestAccountErasureRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UploadProfileImageRequest struct {
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	FileName string `protobuf:"bytes,2,opt,name=file_name" json:"file_name,omitempty"`
+
+	// 图片内容，支持 jpeg、png、gif、webp，最大 5MB
+	Data []byte `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+}
+
+func (x *UploadProfileImageRequest) Reset() { *x = UploadProfileImageRequest{} }
+
+func (x *UploadProfileImageRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *UploadProfileImageRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UploadProfileImageRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UploadProfileImageRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadProfileImageRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadProfileImageResponse struct {
+	Resp      *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	FileId    uint64               `protobuf:"varint,2,opt,name=file_id" json:"file_id,omitempty"`
+	AccessUrl string               `protobuf:"bytes,3,opt,name=access_url" json:"access_url,omitempty"`
+}
+
+func (x *UploadProfileImageResponse) Reset() { *x = UploadProfileImageResponse{} }
+
+func (x *UploadProfileImageResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *UploadProfileImageResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UploadProfileImageResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *UploadProfileImageResponse) GetFileId() uint64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *UploadProfileImageResponse) GetAccessUrl() string {
+	if x != nil {
+		return x.AccessUrl
+	}
+	return ""
+}
+
+type AuditLog struct {
+	Id     int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id" json:"user_id,omitempty"`
+
+	// 发起操作的用户，管理操作时为管理员
+	ActorId int64  `protobuf:"varint,3,opt,name=actor_id" json:"actor_id,omitempty"`
+	Action  string `protobuf:"bytes,4,opt,name=action" json:"action,omitempty"`
+
+	// success、failure 或 mfa_required
+	Outcome   string `protobuf:"bytes,5,opt,name=outcome" json:"outcome,omitempty"`
+	Reason    string `protobuf:"bytes,6,opt,name=reason" json:"reason,omitempty"`
+	Detail    string `protobuf:"bytes,7,opt,name=detail" json:"detail,omitempty"`
+	Ip        string `protobuf:"bytes,8,opt,name=ip" json:"ip,omitempty"`
+	UserAgent string `protobuf:"bytes,9,opt,name=user_agent" json:"user_agent,omitempty"`
+	RequestId string `protobuf:"bytes,10,opt,name=request_id" json:"request_id,omitempty"`
+	CreatedAt int64  `protobuf:"varint,11,opt,name=created_at" json:"created_at,omitempty"`
+}
+
+func (x *AuditLog) Reset() { *x = AuditLog{} }
+
+func (x *AuditLog) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *AuditLog) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AuditLog) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLog) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLog) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditLog) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AuditLog) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AuditLog) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditLog) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListAuditLogsRequest struct {
+	// 以下条件为空时不参与过滤
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	ActorId int64  `protobuf:"varint,2,opt,name=actor_id" json:"actor_id,omitempty"`
+	Action  string `protobuf:"bytes,3,opt,name=action" json:"action,omitempty"`
+	Outcome string `protobuf:"bytes,4,opt,name=outcome" json:"outcome,omitempty"`
+	Ip      string `protobuf:"bytes,5,opt,name=ip" json:"ip,omitempty"`
+
+	// 时间区间 [created_from, created_to)，unix 秒
+	CreatedFrom int64               `protobuf:"varint,6,opt,name=created_from" json:"created_from,omitempty"`
+	CreatedTo   int64               `protobuf:"varint,7,opt,name=created_to" json:"created_to,omitempty"`
+	Page        *common.PageRequest `protobuf:"bytes,8,opt,name=page" json:"page,omitempty"`
+}
+
+func (x *ListAuditLogsRequest) Reset() { *x = ListAuditLogsRequest{} }
+
+func (x *ListAuditLogsRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListAuditLogsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAuditLogsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPage() *common.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListAuditLogsResponse struct {
+	Resp  *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Logs  []*AuditLog          `protobuf:"bytes,2,rep,name=logs" json:"logs,omitempty"`
+	Total int64                `protobuf:"varint,3,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListAuditLogsResponse) Reset() { *x = ListAuditLogsResponse{} }
+
+func (x *ListAuditLogsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListAuditLogsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAuditLogsResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetLogs() []*AuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetRecentActivityRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+
+	// 为 0 时使用服务端默认值
+	Limit int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (x *GetRecentActivityRequest) Reset() { *x = GetRecentActivityRequest{} }
+
+func (x *GetRecentActivityRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetRecentActivityRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetRecentActivityRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetRecentActivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRecentActivityResponse struct {
+	Resp *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Logs []*AuditLog          `protobuf:"bytes,2,rep,name=logs" json:"logs,omitempty"`
+}
+
+func (x *GetRecentActivityResponse) Reset() { *x = GetRecentActivityResponse{} }
+
+func (x *GetRecentActivityResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetRecentActivityResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetRecentActivityResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *GetRecentActivityResponse) GetLogs() []*AuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type SessionInfo struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+
+	// 由 User-Agent 解析出的设备名称，如 "Chrome on macOS"
+	Device    string `protobuf:"bytes,2,opt,name=device" json:"device,omitempty"`
+	UserAgent string `protobuf:"bytes,3,opt,name=user_agent" json:"user_agent,omitempty"`
+
+	// 最近一次使用该会话的客户端 IP
+	Ip         string `protobuf:"bytes,4,opt,name=ip" json:"ip,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,5,opt,name=created_at" json:"created_at,omitempty"`
+	LastSeenAt int64  `protobuf:"varint,6,opt,name=last_seen_at" json:"last_seen_at,omitempty"`
+
+	// 是否为发起请求的会话
+	Current bool `protobuf:"varint,7,opt,name=current" json:"current,omitempty"`
+}
+
+func (x *SessionInfo) Reset() { *x = SessionInfo{} }
+
+func (x *SessionInfo) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SessionInfo) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SessionInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetLastSeenAt() int64 {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+
+	// 发起请求的会话 ID，用于标记当前会话
+	CurrentSessionId string `protobuf:"bytes,2,opt,name=current_session_id" json:"current_session_id,omitempty"`
+}
+
+func (x *ListSessionsRequest) Reset() { *x = ListSessionsRequest{} }
+
+func (x *ListSessionsRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListSessionsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListSessionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetCurrentSessionId() string {
+	if x != nil {
+		return x.CurrentSessionId
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	Resp     *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+	Sessions []*SessionInfo       `protobuf:"bytes,2,rep,name=sessions" json:"sessions,omitempty"`
+}
+
+func (x *ListSessionsResponse) Reset() { *x = ListSessionsResponse{} }
+
+func (x *ListSessionsResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListSessionsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListSessionsResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	UserId    int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	SessionId string `protobuf:"bytes,2,opt,name=session_id" json:"session_id,omitempty"`
+}
+
+func (x *RevokeSessionRequest) Reset() { *x = RevokeSessionRequest{} }
+
+func (x *RevokeSessionRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *RevokeSessionRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RevokeSessionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetPreferencesRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+
+	// 为空时返回全部偏好项
+	Keys []string `protobuf:"bytes,2,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (x *GetPreferencesRequest) Reset() { *x = GetPreferencesRequest{} }
+
+func (x *GetPreferencesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetPreferencesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetPreferencesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPreferencesRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type PreferencesResponse struct {
+	Resp *common.BaseResponse `protobuf:"bytes,1,opt,name=resp" json:"resp,omitempty"`
+
+	// 偏好项名称到 JSON 编码的偏好值，未设置的偏好项为默认值
+	Values map[string]string `protobuf:"bytes,2,rep,name=values" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (x *PreferencesResponse) Reset() { *x = PreferencesResponse{} }
+
+func (x *PreferencesResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *PreferencesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *PreferencesResponse) GetResp() *common.BaseResponse {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
+func (x *PreferencesResponse) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SetPreferenceRequest struct {
+	UserId int64  `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+
+	// JSON 编码的偏好值，null 表示恢复默认值
+	Value string `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+}
+
+func (x *SetPreferenceRequest) Reset() { *x = SetPreferenceRequest{} }
+
+func (x *SetPreferenceRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SetPreferenceRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SetPreferenceRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetPreferenceRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetPreferenceRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type BatchSetPreferencesRequest struct {
+	UserId int64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+
+	// 偏好项名称到 JSON 编码的偏好值，任一偏好项不合法时全部不生效
+	Values map[string]string `protobuf:"bytes,2,rep,name=values" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (x *BatchSetPreferencesRequest) Reset() { *x = BatchSetPreferencesRequest{} }
+
+func (x *BatchSetPreferencesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *BatchSetPreferencesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *BatchSetPreferencesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BatchSetPreferencesRequest) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type UserService interface {
 	Register(ctx context.Context, req *RegisterRequest) (res *UserAuthInfoResponse, err error)
 	Login(ctx context.Context, req *LoginRequest) (res *UserAuthInfoResponse, err error)
 	Refresh(ctx context.Context, req *RefreshRequest) (res *AuthResponse, err error)
 	Update(ctx context.Context, req *UpdateRequest) (res *common.BaseResponse, err error)
 	GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (res *GetUserInfoResponse, err error)
+	Logout(ctx context.Context, req *LogoutRequest) (res *common.BaseResponse, err error)
+	LogoutAll(ctx context.Context, req *LogoutAllRequest) (res *common.BaseResponse, err error)
+	SendEmailCode(ctx context.Context, req *SendEmailCodeRequest) (res *common.BaseResponse, err error)
+	VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (res *common.BaseResponse, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest) (res *common.BaseResponse, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest) (res *common.BaseResponse, err error)
+	SendPhoneCode(ctx context.Context, req *SendPhoneCodeRequest) (res *common.BaseResponse, err error)
+	PhoneLogin(ctx context.Context, req *PhoneLoginRequest) (res *UserAuthInfoResponse, err error)
+	OAuthAuthorize(ctx context.Context, req *OAuthAuthorizeRequest) (res *OAuthAuthorizeResponse, err error)
+	OAuthCallback(ctx context.Context, req *OAuthCallbackRequest) (res *OAuthCallbackResponse, err error)
+	ListIdentities(ctx context.Context, req *ListIdentitiesRequest) (res *ListIdentitiesResponse, err error)
+	UnlinkIdentity(ctx context.Context, req *UnlinkIdentityRequest) (res *common.BaseResponse, err error)
+	VerifyMFA(ctx context.Context, req *VerifyMFARequest) (res *UserAuthInfoResponse, err error)
+	EnrollTOTP(ctx context.Context, req *EnrollTOTPRequest) (res *EnrollTOTPResponse, err error)
+	ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest) (res *RecoveryCodesResponse, err error)
+	DisableTOTP(ctx context.Context, req *DisableTOTPRequest) (res *common.BaseResponse, err error)
+	RegenerateRecoveryCodes(ctx context.Context, req *RegenerateRecoveryCodesRequest) (res *RecoveryCodesResponse, err error)
+	ListPolicies(ctx context.Context, req *ListPoliciesRequest) (res *ListPoliciesResponse, err error)
+	AddPolicy(ctx context.Context, req *PolicyRequest) (res *common.BaseResponse, err error)
+	RemovePolicy(ctx context.Context, req *PolicyRequest) (res *common.BaseResponse, err error)
+	GetUserRoles(ctx context.Context, req *GetUserRolesRequest) (res *GetUserRolesResponse, err error)
+	AssignRole(ctx context.Context, req *RoleRequest) (res *common.BaseResponse, err error)
+	RevokeRole(ctx context.Context, req *RoleRequest) (res *common.BaseResponse, err error)
+	ListUsers(ctx context.Context, req *ListUsersRequest) (res *ListUsersResponse, err error)
+	ChangeUserStatus(ctx context.Context, req *ChangeUserStatusRequest) (res *common.BaseResponse, err error)
+	CreateAccessToken(ctx context.Context, req *CreateAccessTokenRequest) (res *CreateAccessTokenResponse, err error)
+	ListAccessTokens(ctx context.Context, req *ListAccessTokensRequest) (res *ListAccessTokensResponse, err error)
+	RevokeAccessToken(ctx context.Context, req *RevokeAccessTokenRequest) (res *common.BaseResponse, err error)
+	AuthenticateAccessToken(ctx context.Context, req *AuthenticateAccessTokenRequest) (res *AuthenticateAccessTokenResponse, err error)
+	UploadProfileImage(ctx context.Context, req *UploadProfileImageRequest) (res *UploadProfileImageResponse, err error)
+	RequestDataExport(ctx context.Context, req *RequestDataExportRequest) (res *DataJobResponse, err error)
+	GetDataExport(ctx context.Context, req *GetDataExportRequest) (res *DataJobResponse, err error)
+	RequestAccountErasure(ctx context.Context, req *RequestAccountErasureRequest) (res *DataJobResponse, err error)
+	ListAuditLogs(ctx context.Context, req *ListAuditLogsRequest) (res *ListAuditLogsResponse, err error)
+	GetRecentActivity(ctx context.Context, req *GetRecentActivityRequest) (res *GetRecentActivityResponse, err error)
+	ListSessions(ctx context.Context, req *ListSessionsRequest) (res *ListSessionsResponse, err error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest) (res *common.BaseResponse, err error)
+	GetPreferences(ctx context.Context, req *GetPreferencesRequest) (res *PreferencesResponse, err error)
+	SetPreference(ctx context.Context, req *SetPreferenceRequest) (res *common.BaseResponse, err error)
+	BatchSetPreferences(ctx context.Context, req *BatchSetPreferencesRequest) (res *PreferencesResponse, err error)
 }
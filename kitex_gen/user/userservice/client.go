@@ -17,6 +17,46 @@ type Client interface {
 	Refresh(ctx context.Context, Req *user.RefreshRequest, callOptions ...callopt.Option) (r *user.AuthResponse, err error)
 	Update(ctx context.Context, Req *user.UpdateRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
 	GetUserInfo(ctx context.Context, Req *user.GetUserInfoRequest, callOptions ...callopt.Option) (r *user.GetUserInfoResponse, err error)
+	Logout(ctx context.Context, Req *user.LogoutRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	LogoutAll(ctx context.Context, Req *user.LogoutAllRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	SendEmailCode(ctx context.Context, Req *user.SendEmailCodeRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	VerifyEmail(ctx context.Context, Req *user.VerifyEmailRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	ResetPassword(ctx context.Context, Req *user.ResetPasswordRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	SendPhoneCode(ctx context.Context, Req *user.SendPhoneCodeRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	PhoneLogin(ctx context.Context, Req *user.PhoneLoginRequest, callOptions ...callopt.Option) (r *user.UserAuthInfoResponse, err error)
+	OAuthAuthorize(ctx context.Context, Req *user.OAuthAuthorizeRequest, callOptions ...callopt.Option) (r *user.OAuthAuthorizeResponse, err error)
+	OAuthCallback(ctx context.Context, Req *user.OAuthCallbackRequest, callOptions ...callopt.Option) (r *user.OAuthCallbackResponse, err error)
+	ListIdentities(ctx context.Context, Req *user.ListIdentitiesRequest, callOptions ...callopt.Option) (r *user.ListIdentitiesResponse, err error)
+	UnlinkIdentity(ctx context.Context, Req *user.UnlinkIdentityRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	VerifyMFA(ctx context.Context, Req *user.VerifyMFARequest, callOptions ...callopt.Option) (r *user.UserAuthInfoResponse, err error)
+	EnrollTOTP(ctx context.Context, Req *user.EnrollTOTPRequest, callOptions ...callopt.Option) (r *user.EnrollTOTPResponse, err error)
+	ConfirmTOTP(ctx context.Context, Req *user.ConfirmTOTPRequest, callOptions ...callopt.Option) (r *user.RecoveryCodesResponse, err error)
+	DisableTOTP(ctx context.Context, Req *user.DisableTOTPRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesRequest, callOptions ...callopt.Option) (r *user.RecoveryCodesResponse, err error)
+	ListPolicies(ctx context.Context, Req *user.ListPoliciesRequest, callOptions ...callopt.Option) (r *user.ListPoliciesResponse, err error)
+	AddPolicy(ctx context.Context, Req *user.PolicyRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	RemovePolicy(ctx context.Context, Req *user.PolicyRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	GetUserRoles(ctx context.Context, Req *user.GetUserRolesRequest, callOptions ...callopt.Option) (r *user.GetUserRolesResponse, err error)
+	AssignRole(ctx context.Context, Req *user.RoleRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	RevokeRole(ctx context.Context, Req *user.RoleRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	ListUsers(ctx context.Context, Req *user.ListUsersRequest, callOptions ...callopt.Option) (r *user.ListUsersResponse, err error)
+	ChangeUserStatus(ctx context.Context, Req *user.ChangeUserStatusRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	CreateAccessToken(ctx context.Context, Req *user.CreateAccessTokenRequest, callOptions ...callopt.Option) (r *user.CreateAccessTokenResponse, err error)
+	ListAccessTokens(ctx context.Context, Req *user.ListAccessTokensRequest, callOptions ...callopt.Option) (r *user.ListAccessTokensResponse, err error)
+	RevokeAccessToken(ctx context.Context, Req *user.RevokeAccessTokenRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	AuthenticateAccessToken(ctx context.Context, Req *user.AuthenticateAccessTokenRequest, callOptions ...callopt.Option) (r *user.AuthenticateAccessTokenResponse, err error)
+	UploadProfileImage(ctx context.Context, Req *user.UploadProfileImageRequest, callOptions ...callopt.Option) (r *user.UploadProfileImageResponse, err error)
+	RequestDataExport(ctx context.Context, Req *user.RequestDataExportRequest, callOptions ...callopt.Option) (r *user.DataJobResponse, err error)
+	GetDataExport(ctx context.Context, Req *user.GetDataExportRequest, callOptions ...callopt.Option) (r *user.DataJobResponse, err error)
+	RequestAccountErasure(ctx context.Context, Req *user.RequestAccountErasureRequest, callOptions ...callopt.Option) (r *user.DataJobResponse, err error)
+	ListAuditLogs(ctx context.Context, Req *user.ListAuditLogsRequest, callOptions ...callopt.Option) (r *user.ListAuditLogsResponse, err error)
+	GetRecentActivity(ctx context.Context, Req *user.GetRecentActivityRequest, callOptions ...callopt.Option) (r *user.GetRecentActivityResponse, err error)
+	ListSessions(ctx context.Context, Req *user.ListSessionsRequest, callOptions ...callopt.Option) (r *user.ListSessionsResponse, err error)
+	RevokeSession(ctx context.Context, Req *user.RevokeSessionRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	GetPreferences(ctx context.Context, Req *user.GetPreferencesRequest, callOptions ...callopt.Option) (r *user.PreferencesResponse, err error)
+	SetPreference(ctx context.Context, Req *user.SetPreferenceRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error)
+	BatchSetPreferences(ctx context.Context, Req *user.BatchSetPreferencesRequest, callOptions ...callopt.Option) (r *user.PreferencesResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -72,3 +112,203 @@ func (p *kUserServiceClient) GetUserInfo(ctx context.Context, Req *user.GetUserI
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetUserInfo(ctx, Req)
 }
+
+func (p *kUserServiceClient) Logout(ctx context.Context, Req *user.LogoutRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Logout(ctx, Req)
+}
+
+func (p *kUserServiceClient) LogoutAll(ctx context.Context, Req *user.LogoutAllRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.LogoutAll(ctx, Req)
+}
+
+func (p *kUserServiceClient) SendEmailCode(ctx context.Context, Req *user.SendEmailCodeRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SendEmailCode(ctx, Req)
+}
+
+func (p *kUserServiceClient) VerifyEmail(ctx context.Context, Req *user.VerifyEmailRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyEmail(ctx, Req)
+}
+
+func (p *kUserServiceClient) RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestPasswordReset(ctx, Req)
+}
+
+func (p *kUserServiceClient) ResetPassword(ctx context.Context, Req *user.ResetPasswordRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResetPassword(ctx, Req)
+}
+
+func (p *kUserServiceClient) SendPhoneCode(ctx context.Context, Req *user.SendPhoneCodeRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SendPhoneCode(ctx, Req)
+}
+
+func (p *kUserServiceClient) PhoneLogin(ctx context.Context, Req *user.PhoneLoginRequest, callOptions ...callopt.Option) (r *user.UserAuthInfoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PhoneLogin(ctx, Req)
+}
+
+func (p *kUserServiceClient) OAuthAuthorize(ctx context.Context, Req *user.OAuthAuthorizeRequest, callOptions ...callopt.Option) (r *user.OAuthAuthorizeResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.OAuthAuthorize(ctx, Req)
+}
+
+func (p *kUserServiceClient) OAuthCallback(ctx context.Context, Req *user.OAuthCallbackRequest, callOptions ...callopt.Option) (r *user.OAuthCallbackResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.OAuthCallback(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListIdentities(ctx context.Context, Req *user.ListIdentitiesRequest, callOptions ...callopt.Option) (r *user.ListIdentitiesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListIdentities(ctx, Req)
+}
+
+func (p *kUserServiceClient) UnlinkIdentity(ctx context.Context, Req *user.UnlinkIdentityRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnlinkIdentity(ctx, Req)
+}
+
+func (p *kUserServiceClient) VerifyMFA(ctx context.Context, Req *user.VerifyMFARequest, callOptions ...callopt.Option) (r *user.UserAuthInfoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyMFA(ctx, Req)
+}
+
+func (p *kUserServiceClient) EnrollTOTP(ctx context.Context, Req *user.EnrollTOTPRequest, callOptions ...callopt.Option) (r *user.EnrollTOTPResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EnrollTOTP(ctx, Req)
+}
+
+func (p *kUserServiceClient) ConfirmTOTP(ctx context.Context, Req *user.ConfirmTOTPRequest, callOptions ...callopt.Option) (r *user.RecoveryCodesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmTOTP(ctx, Req)
+}
+
+func (p *kUserServiceClient) DisableTOTP(ctx context.Context, Req *user.DisableTOTPRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisableTOTP(ctx, Req)
+}
+
+func (p *kUserServiceClient) RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesRequest, callOptions ...callopt.Option) (r *user.RecoveryCodesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RegenerateRecoveryCodes(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListPolicies(ctx context.Context, Req *user.ListPoliciesRequest, callOptions ...callopt.Option) (r *user.ListPoliciesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPolicies(ctx, Req)
+}
+
+func (p *kUserServiceClient) AddPolicy(ctx context.Context, Req *user.PolicyRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddPolicy(ctx, Req)
+}
+
+func (p *kUserServiceClient) RemovePolicy(ctx context.Context, Req *user.PolicyRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemovePolicy(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetUserRoles(ctx context.Context, Req *user.GetUserRolesRequest, callOptions ...callopt.Option) (r *user.GetUserRolesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUserRoles(ctx, Req)
+}
+
+func (p *kUserServiceClient) AssignRole(ctx context.Context, Req *user.RoleRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AssignRole(ctx, Req)
+}
+
+func (p *kUserServiceClient) RevokeRole(ctx context.Context, Req *user.RoleRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeRole(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListUsers(ctx context.Context, Req *user.ListUsersRequest, callOptions ...callopt.Option) (r *user.ListUsersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListUsers(ctx, Req)
+}
+
+func (p *kUserServiceClient) ChangeUserStatus(ctx context.Context, Req *user.ChangeUserStatusRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ChangeUserStatus(ctx, Req)
+}
+
+func (p *kUserServiceClient) CreateAccessToken(ctx context.Context, Req *user.CreateAccessTokenRequest, callOptions ...callopt.Option) (r *user.CreateAccessTokenResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAccessToken(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListAccessTokens(ctx context.Context, Req *user.ListAccessTokensRequest, callOptions ...callopt.Option) (r *user.ListAccessTokensResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAccessTokens(ctx, Req)
+}
+
+func (p *kUserServiceClient) RevokeAccessToken(ctx context.Context, Req *user.RevokeAccessTokenRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeAccessToken(ctx, Req)
+}
+
+func (p *kUserServiceClient) AuthenticateAccessToken(ctx context.Context, Req *user.AuthenticateAccessTokenRequest, callOptions ...callopt.Option) (r *user.AuthenticateAccessTokenResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AuthenticateAccessToken(ctx, Req)
+}
+
+func (p *kUserServiceClient) UploadProfileImage(ctx context.Context, Req *user.UploadProfileImageRequest, callOptions ...callopt.Option) (r *user.UploadProfileImageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadProfileImage(ctx, Req)
+}
+
+func (p *kUserServiceClient) RequestDataExport(ctx context.Context, Req *user.RequestDataExportRequest, callOptions ...callopt.Option) (r *user.DataJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestDataExport(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetDataExport(ctx context.Context, Req *user.GetDataExportRequest, callOptions ...callopt.Option) (r *user.DataJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDataExport(ctx, Req)
+}
+
+func (p *kUserServiceClient) RequestAccountErasure(ctx context.Context, Req *user.RequestAccountErasureRequest, callOptions ...callopt.Option) (r *user.DataJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestAccountErasure(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListAuditLogs(ctx context.Context, Req *user.ListAuditLogsRequest, callOptions ...callopt.Option) (r *user.ListAuditLogsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAuditLogs(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetRecentActivity(ctx context.Context, Req *user.GetRecentActivityRequest, callOptions ...callopt.Option) (r *user.GetRecentActivityResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetRecentActivity(ctx, Req)
+}
+
+func (p *kUserServiceClient) ListSessions(ctx context.Context, Req *user.ListSessionsRequest, callOptions ...callopt.Option) (r *user.ListSessionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSessions(ctx, Req)
+}
+
+func (p *kUserServiceClient) RevokeSession(ctx context.Context, Req *user.RevokeSessionRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeSession(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetPreferences(ctx context.Context, Req *user.GetPreferencesRequest, callOptions ...callopt.Option) (r *user.PreferencesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPreferences(ctx, Req)
+}
+
+func (p *kUserServiceClient) SetPreference(ctx context.Context, Req *user.SetPreferenceRequest, callOptions ...callopt.Option) (r *common.BaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetPreference(ctx, Req)
+}
+
+func (p *kUserServiceClient) BatchSetPreferences(ctx context.Context, Req *user.BatchSetPreferencesRequest, callOptions ...callopt.Option) (r *user.PreferencesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchSetPreferences(ctx, Req)
+}
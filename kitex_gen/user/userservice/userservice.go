@@ -51,6 +51,286 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"Logout": kitex.NewMethodInfo(
+		logoutHandler,
+		newLogoutArgs,
+		newLogoutResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"LogoutAll": kitex.NewMethodInfo(
+		logoutAllHandler,
+		newLogoutAllArgs,
+		newLogoutAllResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SendEmailCode": kitex.NewMethodInfo(
+		sendEmailCodeHandler,
+		newSendEmailCodeArgs,
+		newSendEmailCodeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"VerifyEmail": kitex.NewMethodInfo(
+		verifyEmailHandler,
+		newVerifyEmailArgs,
+		newVerifyEmailResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RequestPasswordReset": kitex.NewMethodInfo(
+		requestPasswordResetHandler,
+		newRequestPasswordResetArgs,
+		newRequestPasswordResetResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ResetPassword": kitex.NewMethodInfo(
+		resetPasswordHandler,
+		newResetPasswordArgs,
+		newResetPasswordResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SendPhoneCode": kitex.NewMethodInfo(
+		sendPhoneCodeHandler,
+		newSendPhoneCodeArgs,
+		newSendPhoneCodeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"PhoneLogin": kitex.NewMethodInfo(
+		phoneLoginHandler,
+		newPhoneLoginArgs,
+		newPhoneLoginResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"OAuthAuthorize": kitex.NewMethodInfo(
+		oAuthAuthorizeHandler,
+		newOAuthAuthorizeArgs,
+		newOAuthAuthorizeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"OAuthCallback": kitex.NewMethodInfo(
+		oAuthCallbackHandler,
+		newOAuthCallbackArgs,
+		newOAuthCallbackResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListIdentities": kitex.NewMethodInfo(
+		listIdentitiesHandler,
+		newListIdentitiesArgs,
+		newListIdentitiesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UnlinkIdentity": kitex.NewMethodInfo(
+		unlinkIdentityHandler,
+		newUnlinkIdentityArgs,
+		newUnlinkIdentityResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"VerifyMFA": kitex.NewMethodInfo(
+		verifyMFAHandler,
+		newVerifyMFAArgs,
+		newVerifyMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"EnrollTOTP": kitex.NewMethodInfo(
+		enrollTOTPHandler,
+		newEnrollTOTPArgs,
+		newEnrollTOTPResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ConfirmTOTP": kitex.NewMethodInfo(
+		confirmTOTPHandler,
+		newConfirmTOTPArgs,
+		newConfirmTOTPResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DisableTOTP": kitex.NewMethodInfo(
+		disableTOTPHandler,
+		newDisableTOTPArgs,
+		newDisableTOTPResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RegenerateRecoveryCodes": kitex.NewMethodInfo(
+		regenerateRecoveryCodesHandler,
+		newRegenerateRecoveryCodesArgs,
+		newRegenerateRecoveryCodesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListPolicies": kitex.NewMethodInfo(
+		listPoliciesHandler,
+		newListPoliciesArgs,
+		newListPoliciesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AddPolicy": kitex.NewMethodInfo(
+		addPolicyHandler,
+		newAddPolicyArgs,
+		newAddPolicyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RemovePolicy": kitex.NewMethodInfo(
+		removePolicyHandler,
+		newRemovePolicyArgs,
+		newRemovePolicyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetUserRoles": kitex.NewMethodInfo(
+		getUserRolesHandler,
+		newGetUserRolesArgs,
+		newGetUserRolesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AssignRole": kitex.NewMethodInfo(
+		assignRoleHandler,
+		newAssignRoleArgs,
+		newAssignRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RevokeRole": kitex.NewMethodInfo(
+		revokeRoleHandler,
+		newRevokeRoleArgs,
+		newRevokeRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListUsers": kitex.NewMethodInfo(
+		listUsersHandler,
+		newListUsersArgs,
+		newListUsersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ChangeUserStatus": kitex.NewMethodInfo(
+		changeUserStatusHandler,
+		newChangeUserStatusArgs,
+		newChangeUserStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateAccessToken": kitex.NewMethodInfo(
+		createAccessTokenHandler,
+		newCreateAccessTokenArgs,
+		newCreateAccessTokenResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAccessTokens": kitex.NewMethodInfo(
+		listAccessTokensHandler,
+		newListAccessTokensArgs,
+		newListAccessTokensResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RevokeAccessToken": kitex.NewMethodInfo(
+		revokeAccessTokenHandler,
+		newRevokeAccessTokenArgs,
+		newRevokeAccessTokenResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AuthenticateAccessToken": kitex.NewMethodInfo(
+		authenticateAccessTokenHandler,
+		newAuthenticateAccessTokenArgs,
+		newAuthenticateAccessTokenResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UploadProfileImage": kitex.NewMethodInfo(
+		uploadProfileImageHandler,
+		newUploadProfileImageArgs,
+		newUploadProfileImageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RequestDataExport": kitex.NewMethodInfo(
+		requestDataExportHandler,
+		newRequestDataExportArgs,
+		newRequestDataExportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetDataExport": kitex.NewMethodInfo(
+		getDataExportHandler,
+		newGetDataExportArgs,
+		newGetDataExportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RequestAccountErasure": kitex.NewMethodInfo(
+		requestAccountErasureHandler,
+		newRequestAccountErasureArgs,
+		newRequestAccountErasureResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAuditLogs": kitex.NewMethodInfo(
+		listAuditLogsHandler,
+		newListAuditLogsArgs,
+		newListAuditLogsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetRecentActivity": kitex.NewMethodInfo(
+		getRecentActivityHandler,
+		newGetRecentActivityArgs,
+		newGetRecentActivityResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListSessions": kitex.NewMethodInfo(
+		listSessionsHandler,
+		newListSessionsArgs,
+		newListSessionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RevokeSession": kitex.NewMethodInfo(
+		revokeSessionHandler,
+		newRevokeSessionArgs,
+		newRevokeSessionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetPreferences": kitex.NewMethodInfo(
+		getPreferencesHandler,
+		newGetPreferencesArgs,
+		newGetPreferencesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SetPreference": kitex.NewMethodInfo(
+		setPreferenceHandler,
+		newSetPreferenceArgs,
+		newSetPreferenceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"BatchSetPreferences": kitex.NewMethodInfo(
+		batchSetPreferencesHandler,
+		newBatchSetPreferencesArgs,
+		newBatchSetPreferencesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -672,61 +952,4901 @@ func (p *GetUserInfoResult) GetResult() interface{} {
 	return p.Success
 }
 
-type kClient struct {
-	c client.Client
+func logoutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.LogoutRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Logout(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LogoutArgs:
+		success, err := handler.(user.UserService).Logout(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LogoutResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLogoutArgs() interface{} {
+	return &LogoutArgs{}
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
+func newLogoutResult() interface{} {
+	return &LogoutResult{}
+}
+
+type LogoutArgs struct {
+	Req *user.LogoutRequest
+}
+
+func (p *LogoutArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
 	}
+	return proto.Marshal(p.Req)
 }
 
-func (p *kClient) Register(ctx context.Context, Req *user.RegisterRequest) (r *user.UserAuthInfoResponse, err error) {
-	var _args RegisterArgs
-	_args.Req = Req
-	var _result RegisterResult
-	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
-		return
+func (p *LogoutArgs) Unmarshal(in []byte) error {
+	msg := new(user.LogoutRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Req = msg
+	return nil
 }
 
-func (p *kClient) Login(ctx context.Context, Req *user.LoginRequest) (r *user.UserAuthInfoResponse, err error) {
-	var _args LoginArgs
-	_args.Req = Req
-	var _result LoginResult
-	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
-		return
+var LogoutArgs_Req_DEFAULT *user.LogoutRequest
+
+func (p *LogoutArgs) GetReq() *user.LogoutRequest {
+	if !p.IsSetReq() {
+		return LogoutArgs_Req_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return p.Req
 }
 
-func (p *kClient) Refresh(ctx context.Context, Req *user.RefreshRequest) (r *user.AuthResponse, err error) {
-	var _args RefreshArgs
-	_args.Req = Req
-	var _result RefreshResult
-	if err = p.c.Call(ctx, "Refresh", &_args, &_result); err != nil {
-		return
+func (p *LogoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LogoutArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LogoutResult struct {
+	Success *common.BaseResponse
+}
+
+var LogoutResult_Success_DEFAULT *common.BaseResponse
+
+func (p *LogoutResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
 	}
-	return _result.GetSuccess(), nil
+	return proto.Marshal(p.Success)
 }
 
-func (p *kClient) Update(ctx context.Context, Req *user.UpdateRequest) (r *common.BaseResponse, err error) {
-	var _args UpdateArgs
-	_args.Req = Req
-	var _result UpdateResult
-	if err = p.c.Call(ctx, "Update", &_args, &_result); err != nil {
-		return
+func (p *LogoutResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Success = msg
+	return nil
 }
 
-func (p *kClient) GetUserInfo(ctx context.Context, Req *user.GetUserInfoRequest) (r *user.GetUserInfoResponse, err error) {
-	var _args GetUserInfoArgs
-	_args.Req = Req
-	var _result GetUserInfoResult
-	if err = p.c.Call(ctx, "GetUserInfo", &_args, &_result); err != nil {
+func (p *LogoutResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return LogoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LogoutResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *LogoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LogoutResult) GetResult() interface{} {
+	return p.Success
+}
+
+func logoutAllHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.LogoutAllRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).LogoutAll(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *LogoutAllArgs:
+		success, err := handler.(user.UserService).LogoutAll(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*LogoutAllResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newLogoutAllArgs() interface{} {
+	return &LogoutAllArgs{}
+}
+
+func newLogoutAllResult() interface{} {
+	return &LogoutAllResult{}
+}
+
+type LogoutAllArgs struct {
+	Req *user.LogoutAllRequest
+}
+
+func (p *LogoutAllArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *LogoutAllArgs) Unmarshal(in []byte) error {
+	msg := new(user.LogoutAllRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var LogoutAllArgs_Req_DEFAULT *user.LogoutAllRequest
+
+func (p *LogoutAllArgs) GetReq() *user.LogoutAllRequest {
+	if !p.IsSetReq() {
+		return LogoutAllArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *LogoutAllArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *LogoutAllArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type LogoutAllResult struct {
+	Success *common.BaseResponse
+}
+
+var LogoutAllResult_Success_DEFAULT *common.BaseResponse
+
+func (p *LogoutAllResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *LogoutAllResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *LogoutAllResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return LogoutAllResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *LogoutAllResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *LogoutAllResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *LogoutAllResult) GetResult() interface{} {
+	return p.Success
+}
+
+func sendEmailCodeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SendEmailCodeRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SendEmailCode(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SendEmailCodeArgs:
+		success, err := handler.(user.UserService).SendEmailCode(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SendEmailCodeResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSendEmailCodeArgs() interface{} {
+	return &SendEmailCodeArgs{}
+}
+
+func newSendEmailCodeResult() interface{} {
+	return &SendEmailCodeResult{}
+}
+
+type SendEmailCodeArgs struct {
+	Req *user.SendEmailCodeRequest
+}
+
+func (p *SendEmailCodeArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SendEmailCodeArgs) Unmarshal(in []byte) error {
+	msg := new(user.SendEmailCodeRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SendEmailCodeArgs_Req_DEFAULT *user.SendEmailCodeRequest
+
+func (p *SendEmailCodeArgs) GetReq() *user.SendEmailCodeRequest {
+	if !p.IsSetReq() {
+		return SendEmailCodeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SendEmailCodeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SendEmailCodeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SendEmailCodeResult struct {
+	Success *common.BaseResponse
+}
+
+var SendEmailCodeResult_Success_DEFAULT *common.BaseResponse
+
+func (p *SendEmailCodeResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SendEmailCodeResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SendEmailCodeResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return SendEmailCodeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SendEmailCodeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *SendEmailCodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SendEmailCodeResult) GetResult() interface{} {
+	return p.Success
+}
+
+func verifyEmailHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.VerifyEmailRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).VerifyEmail(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *VerifyEmailArgs:
+		success, err := handler.(user.UserService).VerifyEmail(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyEmailResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newVerifyEmailArgs() interface{} {
+	return &VerifyEmailArgs{}
+}
+
+func newVerifyEmailResult() interface{} {
+	return &VerifyEmailResult{}
+}
+
+type VerifyEmailArgs struct {
+	Req *user.VerifyEmailRequest
+}
+
+func (p *VerifyEmailArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyEmailArgs) Unmarshal(in []byte) error {
+	msg := new(user.VerifyEmailRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyEmailArgs_Req_DEFAULT *user.VerifyEmailRequest
+
+func (p *VerifyEmailArgs) GetReq() *user.VerifyEmailRequest {
+	if !p.IsSetReq() {
+		return VerifyEmailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyEmailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyEmailArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VerifyEmailResult struct {
+	Success *common.BaseResponse
+}
+
+var VerifyEmailResult_Success_DEFAULT *common.BaseResponse
+
+func (p *VerifyEmailResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyEmailResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyEmailResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return VerifyEmailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyEmailResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *VerifyEmailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyEmailResult) GetResult() interface{} {
+	return p.Success
+}
+
+func requestPasswordResetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RequestPasswordResetRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RequestPasswordReset(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RequestPasswordResetArgs:
+		success, err := handler.(user.UserService).RequestPasswordReset(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RequestPasswordResetResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRequestPasswordResetArgs() interface{} {
+	return &RequestPasswordResetArgs{}
+}
+
+func newRequestPasswordResetResult() interface{} {
+	return &RequestPasswordResetResult{}
+}
+
+type RequestPasswordResetArgs struct {
+	Req *user.RequestPasswordResetRequest
+}
+
+func (p *RequestPasswordResetArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RequestPasswordResetArgs) Unmarshal(in []byte) error {
+	msg := new(user.RequestPasswordResetRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RequestPasswordResetArgs_Req_DEFAULT *user.RequestPasswordResetRequest
+
+func (p *RequestPasswordResetArgs) GetReq() *user.RequestPasswordResetRequest {
+	if !p.IsSetReq() {
+		return RequestPasswordResetArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RequestPasswordResetArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RequestPasswordResetArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RequestPasswordResetResult struct {
+	Success *common.BaseResponse
+}
+
+var RequestPasswordResetResult_Success_DEFAULT *common.BaseResponse
+
+func (p *RequestPasswordResetResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RequestPasswordResetResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RequestPasswordResetResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return RequestPasswordResetResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RequestPasswordResetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *RequestPasswordResetResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RequestPasswordResetResult) GetResult() interface{} {
+	return p.Success
+}
+
+func resetPasswordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ResetPasswordRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ResetPassword(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ResetPasswordArgs:
+		success, err := handler.(user.UserService).ResetPassword(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ResetPasswordResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newResetPasswordArgs() interface{} {
+	return &ResetPasswordArgs{}
+}
+
+func newResetPasswordResult() interface{} {
+	return &ResetPasswordResult{}
+}
+
+type ResetPasswordArgs struct {
+	Req *user.ResetPasswordRequest
+}
+
+func (p *ResetPasswordArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ResetPasswordArgs) Unmarshal(in []byte) error {
+	msg := new(user.ResetPasswordRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ResetPasswordArgs_Req_DEFAULT *user.ResetPasswordRequest
+
+func (p *ResetPasswordArgs) GetReq() *user.ResetPasswordRequest {
+	if !p.IsSetReq() {
+		return ResetPasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ResetPasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ResetPasswordArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ResetPasswordResult struct {
+	Success *common.BaseResponse
+}
+
+var ResetPasswordResult_Success_DEFAULT *common.BaseResponse
+
+func (p *ResetPasswordResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ResetPasswordResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ResetPasswordResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return ResetPasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ResetPasswordResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *ResetPasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ResetPasswordResult) GetResult() interface{} {
+	return p.Success
+}
+
+func sendPhoneCodeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SendPhoneCodeRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SendPhoneCode(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SendPhoneCodeArgs:
+		success, err := handler.(user.UserService).SendPhoneCode(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SendPhoneCodeResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSendPhoneCodeArgs() interface{} {
+	return &SendPhoneCodeArgs{}
+}
+
+func newSendPhoneCodeResult() interface{} {
+	return &SendPhoneCodeResult{}
+}
+
+type SendPhoneCodeArgs struct {
+	Req *user.SendPhoneCodeRequest
+}
+
+func (p *SendPhoneCodeArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SendPhoneCodeArgs) Unmarshal(in []byte) error {
+	msg := new(user.SendPhoneCodeRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SendPhoneCodeArgs_Req_DEFAULT *user.SendPhoneCodeRequest
+
+func (p *SendPhoneCodeArgs) GetReq() *user.SendPhoneCodeRequest {
+	if !p.IsSetReq() {
+		return SendPhoneCodeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SendPhoneCodeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SendPhoneCodeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SendPhoneCodeResult struct {
+	Success *common.BaseResponse
+}
+
+var SendPhoneCodeResult_Success_DEFAULT *common.BaseResponse
+
+func (p *SendPhoneCodeResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SendPhoneCodeResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SendPhoneCodeResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return SendPhoneCodeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SendPhoneCodeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *SendPhoneCodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SendPhoneCodeResult) GetResult() interface{} {
+	return p.Success
+}
+
+func phoneLoginHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.PhoneLoginRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).PhoneLogin(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *PhoneLoginArgs:
+		success, err := handler.(user.UserService).PhoneLogin(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*PhoneLoginResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newPhoneLoginArgs() interface{} {
+	return &PhoneLoginArgs{}
+}
+
+func newPhoneLoginResult() interface{} {
+	return &PhoneLoginResult{}
+}
+
+type PhoneLoginArgs struct {
+	Req *user.PhoneLoginRequest
+}
+
+func (p *PhoneLoginArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *PhoneLoginArgs) Unmarshal(in []byte) error {
+	msg := new(user.PhoneLoginRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var PhoneLoginArgs_Req_DEFAULT *user.PhoneLoginRequest
+
+func (p *PhoneLoginArgs) GetReq() *user.PhoneLoginRequest {
+	if !p.IsSetReq() {
+		return PhoneLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *PhoneLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PhoneLoginArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type PhoneLoginResult struct {
+	Success *user.UserAuthInfoResponse
+}
+
+var PhoneLoginResult_Success_DEFAULT *user.UserAuthInfoResponse
+
+func (p *PhoneLoginResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *PhoneLoginResult) Unmarshal(in []byte) error {
+	msg := new(user.UserAuthInfoResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *PhoneLoginResult) GetSuccess() *user.UserAuthInfoResponse {
+	if !p.IsSetSuccess() {
+		return PhoneLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *PhoneLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UserAuthInfoResponse)
+}
+
+func (p *PhoneLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PhoneLoginResult) GetResult() interface{} {
+	return p.Success
+}
+
+func oAuthAuthorizeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.OAuthAuthorizeRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).OAuthAuthorize(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *OAuthAuthorizeArgs:
+		success, err := handler.(user.UserService).OAuthAuthorize(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*OAuthAuthorizeResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newOAuthAuthorizeArgs() interface{} {
+	return &OAuthAuthorizeArgs{}
+}
+
+func newOAuthAuthorizeResult() interface{} {
+	return &OAuthAuthorizeResult{}
+}
+
+type OAuthAuthorizeArgs struct {
+	Req *user.OAuthAuthorizeRequest
+}
+
+func (p *OAuthAuthorizeArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *OAuthAuthorizeArgs) Unmarshal(in []byte) error {
+	msg := new(user.OAuthAuthorizeRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var OAuthAuthorizeArgs_Req_DEFAULT *user.OAuthAuthorizeRequest
+
+func (p *OAuthAuthorizeArgs) GetReq() *user.OAuthAuthorizeRequest {
+	if !p.IsSetReq() {
+		return OAuthAuthorizeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *OAuthAuthorizeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OAuthAuthorizeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type OAuthAuthorizeResult struct {
+	Success *user.OAuthAuthorizeResponse
+}
+
+var OAuthAuthorizeResult_Success_DEFAULT *user.OAuthAuthorizeResponse
+
+func (p *OAuthAuthorizeResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *OAuthAuthorizeResult) Unmarshal(in []byte) error {
+	msg := new(user.OAuthAuthorizeResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *OAuthAuthorizeResult) GetSuccess() *user.OAuthAuthorizeResponse {
+	if !p.IsSetSuccess() {
+		return OAuthAuthorizeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *OAuthAuthorizeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.OAuthAuthorizeResponse)
+}
+
+func (p *OAuthAuthorizeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OAuthAuthorizeResult) GetResult() interface{} {
+	return p.Success
+}
+
+func oAuthCallbackHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.OAuthCallbackRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).OAuthCallback(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *OAuthCallbackArgs:
+		success, err := handler.(user.UserService).OAuthCallback(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*OAuthCallbackResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newOAuthCallbackArgs() interface{} {
+	return &OAuthCallbackArgs{}
+}
+
+func newOAuthCallbackResult() interface{} {
+	return &OAuthCallbackResult{}
+}
+
+type OAuthCallbackArgs struct {
+	Req *user.OAuthCallbackRequest
+}
+
+func (p *OAuthCallbackArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *OAuthCallbackArgs) Unmarshal(in []byte) error {
+	msg := new(user.OAuthCallbackRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var OAuthCallbackArgs_Req_DEFAULT *user.OAuthCallbackRequest
+
+func (p *OAuthCallbackArgs) GetReq() *user.OAuthCallbackRequest {
+	if !p.IsSetReq() {
+		return OAuthCallbackArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *OAuthCallbackArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OAuthCallbackArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type OAuthCallbackResult struct {
+	Success *user.OAuthCallbackResponse
+}
+
+var OAuthCallbackResult_Success_DEFAULT *user.OAuthCallbackResponse
+
+func (p *OAuthCallbackResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *OAuthCallbackResult) Unmarshal(in []byte) error {
+	msg := new(user.OAuthCallbackResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *OAuthCallbackResult) GetSuccess() *user.OAuthCallbackResponse {
+	if !p.IsSetSuccess() {
+		return OAuthCallbackResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *OAuthCallbackResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.OAuthCallbackResponse)
+}
+
+func (p *OAuthCallbackResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OAuthCallbackResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listIdentitiesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListIdentitiesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListIdentities(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListIdentitiesArgs:
+		success, err := handler.(user.UserService).ListIdentities(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListIdentitiesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListIdentitiesArgs() interface{} {
+	return &ListIdentitiesArgs{}
+}
+
+func newListIdentitiesResult() interface{} {
+	return &ListIdentitiesResult{}
+}
+
+type ListIdentitiesArgs struct {
+	Req *user.ListIdentitiesRequest
+}
+
+func (p *ListIdentitiesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListIdentitiesArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListIdentitiesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListIdentitiesArgs_Req_DEFAULT *user.ListIdentitiesRequest
+
+func (p *ListIdentitiesArgs) GetReq() *user.ListIdentitiesRequest {
+	if !p.IsSetReq() {
+		return ListIdentitiesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListIdentitiesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListIdentitiesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListIdentitiesResult struct {
+	Success *user.ListIdentitiesResponse
+}
+
+var ListIdentitiesResult_Success_DEFAULT *user.ListIdentitiesResponse
+
+func (p *ListIdentitiesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListIdentitiesResult) Unmarshal(in []byte) error {
+	msg := new(user.ListIdentitiesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListIdentitiesResult) GetSuccess() *user.ListIdentitiesResponse {
+	if !p.IsSetSuccess() {
+		return ListIdentitiesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListIdentitiesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListIdentitiesResponse)
+}
+
+func (p *ListIdentitiesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListIdentitiesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func unlinkIdentityHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UnlinkIdentityRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UnlinkIdentity(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UnlinkIdentityArgs:
+		success, err := handler.(user.UserService).UnlinkIdentity(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UnlinkIdentityResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUnlinkIdentityArgs() interface{} {
+	return &UnlinkIdentityArgs{}
+}
+
+func newUnlinkIdentityResult() interface{} {
+	return &UnlinkIdentityResult{}
+}
+
+type UnlinkIdentityArgs struct {
+	Req *user.UnlinkIdentityRequest
+}
+
+func (p *UnlinkIdentityArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UnlinkIdentityArgs) Unmarshal(in []byte) error {
+	msg := new(user.UnlinkIdentityRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UnlinkIdentityArgs_Req_DEFAULT *user.UnlinkIdentityRequest
+
+func (p *UnlinkIdentityArgs) GetReq() *user.UnlinkIdentityRequest {
+	if !p.IsSetReq() {
+		return UnlinkIdentityArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UnlinkIdentityArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UnlinkIdentityArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UnlinkIdentityResult struct {
+	Success *common.BaseResponse
+}
+
+var UnlinkIdentityResult_Success_DEFAULT *common.BaseResponse
+
+func (p *UnlinkIdentityResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UnlinkIdentityResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UnlinkIdentityResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return UnlinkIdentityResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UnlinkIdentityResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *UnlinkIdentityResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UnlinkIdentityResult) GetResult() interface{} {
+	return p.Success
+}
+
+func verifyMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.VerifyMFARequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).VerifyMFA(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *VerifyMFAArgs:
+		success, err := handler.(user.UserService).VerifyMFA(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VerifyMFAResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newVerifyMFAArgs() interface{} {
+	return &VerifyMFAArgs{}
+}
+
+func newVerifyMFAResult() interface{} {
+	return &VerifyMFAResult{}
+}
+
+type VerifyMFAArgs struct {
+	Req *user.VerifyMFARequest
+}
+
+func (p *VerifyMFAArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VerifyMFAArgs) Unmarshal(in []byte) error {
+	msg := new(user.VerifyMFARequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VerifyMFAArgs_Req_DEFAULT *user.VerifyMFARequest
+
+func (p *VerifyMFAArgs) GetReq() *user.VerifyMFARequest {
+	if !p.IsSetReq() {
+		return VerifyMFAArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VerifyMFAArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyMFAArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VerifyMFAResult struct {
+	Success *user.UserAuthInfoResponse
+}
+
+var VerifyMFAResult_Success_DEFAULT *user.UserAuthInfoResponse
+
+func (p *VerifyMFAResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VerifyMFAResult) Unmarshal(in []byte) error {
+	msg := new(user.UserAuthInfoResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VerifyMFAResult) GetSuccess() *user.UserAuthInfoResponse {
+	if !p.IsSetSuccess() {
+		return VerifyMFAResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VerifyMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UserAuthInfoResponse)
+}
+
+func (p *VerifyMFAResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyMFAResult) GetResult() interface{} {
+	return p.Success
+}
+
+func enrollTOTPHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.EnrollTOTPRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).EnrollTOTP(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *EnrollTOTPArgs:
+		success, err := handler.(user.UserService).EnrollTOTP(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*EnrollTOTPResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newEnrollTOTPArgs() interface{} {
+	return &EnrollTOTPArgs{}
+}
+
+func newEnrollTOTPResult() interface{} {
+	return &EnrollTOTPResult{}
+}
+
+type EnrollTOTPArgs struct {
+	Req *user.EnrollTOTPRequest
+}
+
+func (p *EnrollTOTPArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *EnrollTOTPArgs) Unmarshal(in []byte) error {
+	msg := new(user.EnrollTOTPRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var EnrollTOTPArgs_Req_DEFAULT *user.EnrollTOTPRequest
+
+func (p *EnrollTOTPArgs) GetReq() *user.EnrollTOTPRequest {
+	if !p.IsSetReq() {
+		return EnrollTOTPArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *EnrollTOTPArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *EnrollTOTPArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type EnrollTOTPResult struct {
+	Success *user.EnrollTOTPResponse
+}
+
+var EnrollTOTPResult_Success_DEFAULT *user.EnrollTOTPResponse
+
+func (p *EnrollTOTPResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *EnrollTOTPResult) Unmarshal(in []byte) error {
+	msg := new(user.EnrollTOTPResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *EnrollTOTPResult) GetSuccess() *user.EnrollTOTPResponse {
+	if !p.IsSetSuccess() {
+		return EnrollTOTPResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *EnrollTOTPResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.EnrollTOTPResponse)
+}
+
+func (p *EnrollTOTPResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *EnrollTOTPResult) GetResult() interface{} {
+	return p.Success
+}
+
+func confirmTOTPHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ConfirmTOTPRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ConfirmTOTP(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ConfirmTOTPArgs:
+		success, err := handler.(user.UserService).ConfirmTOTP(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ConfirmTOTPResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newConfirmTOTPArgs() interface{} {
+	return &ConfirmTOTPArgs{}
+}
+
+func newConfirmTOTPResult() interface{} {
+	return &ConfirmTOTPResult{}
+}
+
+type ConfirmTOTPArgs struct {
+	Req *user.ConfirmTOTPRequest
+}
+
+func (p *ConfirmTOTPArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ConfirmTOTPArgs) Unmarshal(in []byte) error {
+	msg := new(user.ConfirmTOTPRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ConfirmTOTPArgs_Req_DEFAULT *user.ConfirmTOTPRequest
+
+func (p *ConfirmTOTPArgs) GetReq() *user.ConfirmTOTPRequest {
+	if !p.IsSetReq() {
+		return ConfirmTOTPArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ConfirmTOTPArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ConfirmTOTPArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ConfirmTOTPResult struct {
+	Success *user.RecoveryCodesResponse
+}
+
+var ConfirmTOTPResult_Success_DEFAULT *user.RecoveryCodesResponse
+
+func (p *ConfirmTOTPResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ConfirmTOTPResult) Unmarshal(in []byte) error {
+	msg := new(user.RecoveryCodesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ConfirmTOTPResult) GetSuccess() *user.RecoveryCodesResponse {
+	if !p.IsSetSuccess() {
+		return ConfirmTOTPResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ConfirmTOTPResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RecoveryCodesResponse)
+}
+
+func (p *ConfirmTOTPResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ConfirmTOTPResult) GetResult() interface{} {
+	return p.Success
+}
+
+func disableTOTPHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DisableTOTPRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).DisableTOTP(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DisableTOTPArgs:
+		success, err := handler.(user.UserService).DisableTOTP(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DisableTOTPResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDisableTOTPArgs() interface{} {
+	return &DisableTOTPArgs{}
+}
+
+func newDisableTOTPResult() interface{} {
+	return &DisableTOTPResult{}
+}
+
+type DisableTOTPArgs struct {
+	Req *user.DisableTOTPRequest
+}
+
+func (p *DisableTOTPArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DisableTOTPArgs) Unmarshal(in []byte) error {
+	msg := new(user.DisableTOTPRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DisableTOTPArgs_Req_DEFAULT *user.DisableTOTPRequest
+
+func (p *DisableTOTPArgs) GetReq() *user.DisableTOTPRequest {
+	if !p.IsSetReq() {
+		return DisableTOTPArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DisableTOTPArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DisableTOTPArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DisableTOTPResult struct {
+	Success *common.BaseResponse
+}
+
+var DisableTOTPResult_Success_DEFAULT *common.BaseResponse
+
+func (p *DisableTOTPResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DisableTOTPResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DisableTOTPResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return DisableTOTPResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DisableTOTPResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *DisableTOTPResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DisableTOTPResult) GetResult() interface{} {
+	return p.Success
+}
+
+func regenerateRecoveryCodesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RegenerateRecoveryCodesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RegenerateRecoveryCodes(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RegenerateRecoveryCodesArgs:
+		success, err := handler.(user.UserService).RegenerateRecoveryCodes(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RegenerateRecoveryCodesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRegenerateRecoveryCodesArgs() interface{} {
+	return &RegenerateRecoveryCodesArgs{}
+}
+
+func newRegenerateRecoveryCodesResult() interface{} {
+	return &RegenerateRecoveryCodesResult{}
+}
+
+type RegenerateRecoveryCodesArgs struct {
+	Req *user.RegenerateRecoveryCodesRequest
+}
+
+func (p *RegenerateRecoveryCodesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RegenerateRecoveryCodesArgs) Unmarshal(in []byte) error {
+	msg := new(user.RegenerateRecoveryCodesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RegenerateRecoveryCodesArgs_Req_DEFAULT *user.RegenerateRecoveryCodesRequest
+
+func (p *RegenerateRecoveryCodesArgs) GetReq() *user.RegenerateRecoveryCodesRequest {
+	if !p.IsSetReq() {
+		return RegenerateRecoveryCodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RegenerateRecoveryCodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RegenerateRecoveryCodesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RegenerateRecoveryCodesResult struct {
+	Success *user.RecoveryCodesResponse
+}
+
+var RegenerateRecoveryCodesResult_Success_DEFAULT *user.RecoveryCodesResponse
+
+func (p *RegenerateRecoveryCodesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RegenerateRecoveryCodesResult) Unmarshal(in []byte) error {
+	msg := new(user.RecoveryCodesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RegenerateRecoveryCodesResult) GetSuccess() *user.RecoveryCodesResponse {
+	if !p.IsSetSuccess() {
+		return RegenerateRecoveryCodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RegenerateRecoveryCodesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.RecoveryCodesResponse)
+}
+
+func (p *RegenerateRecoveryCodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RegenerateRecoveryCodesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listPoliciesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListPoliciesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListPolicies(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListPoliciesArgs:
+		success, err := handler.(user.UserService).ListPolicies(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListPoliciesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListPoliciesArgs() interface{} {
+	return &ListPoliciesArgs{}
+}
+
+func newListPoliciesResult() interface{} {
+	return &ListPoliciesResult{}
+}
+
+type ListPoliciesArgs struct {
+	Req *user.ListPoliciesRequest
+}
+
+func (p *ListPoliciesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListPoliciesArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListPoliciesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListPoliciesArgs_Req_DEFAULT *user.ListPoliciesRequest
+
+func (p *ListPoliciesArgs) GetReq() *user.ListPoliciesRequest {
+	if !p.IsSetReq() {
+		return ListPoliciesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListPoliciesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListPoliciesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListPoliciesResult struct {
+	Success *user.ListPoliciesResponse
+}
+
+var ListPoliciesResult_Success_DEFAULT *user.ListPoliciesResponse
+
+func (p *ListPoliciesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListPoliciesResult) Unmarshal(in []byte) error {
+	msg := new(user.ListPoliciesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListPoliciesResult) GetSuccess() *user.ListPoliciesResponse {
+	if !p.IsSetSuccess() {
+		return ListPoliciesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListPoliciesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListPoliciesResponse)
+}
+
+func (p *ListPoliciesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListPoliciesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func addPolicyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.PolicyRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).AddPolicy(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AddPolicyArgs:
+		success, err := handler.(user.UserService).AddPolicy(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AddPolicyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAddPolicyArgs() interface{} {
+	return &AddPolicyArgs{}
+}
+
+func newAddPolicyResult() interface{} {
+	return &AddPolicyResult{}
+}
+
+type AddPolicyArgs struct {
+	Req *user.PolicyRequest
+}
+
+func (p *AddPolicyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AddPolicyArgs) Unmarshal(in []byte) error {
+	msg := new(user.PolicyRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AddPolicyArgs_Req_DEFAULT *user.PolicyRequest
+
+func (p *AddPolicyArgs) GetReq() *user.PolicyRequest {
+	if !p.IsSetReq() {
+		return AddPolicyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AddPolicyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AddPolicyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AddPolicyResult struct {
+	Success *common.BaseResponse
+}
+
+var AddPolicyResult_Success_DEFAULT *common.BaseResponse
+
+func (p *AddPolicyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AddPolicyResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AddPolicyResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return AddPolicyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AddPolicyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *AddPolicyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AddPolicyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func removePolicyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.PolicyRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RemovePolicy(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RemovePolicyArgs:
+		success, err := handler.(user.UserService).RemovePolicy(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RemovePolicyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRemovePolicyArgs() interface{} {
+	return &RemovePolicyArgs{}
+}
+
+func newRemovePolicyResult() interface{} {
+	return &RemovePolicyResult{}
+}
+
+type RemovePolicyArgs struct {
+	Req *user.PolicyRequest
+}
+
+func (p *RemovePolicyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RemovePolicyArgs) Unmarshal(in []byte) error {
+	msg := new(user.PolicyRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RemovePolicyArgs_Req_DEFAULT *user.PolicyRequest
+
+func (p *RemovePolicyArgs) GetReq() *user.PolicyRequest {
+	if !p.IsSetReq() {
+		return RemovePolicyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RemovePolicyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RemovePolicyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RemovePolicyResult struct {
+	Success *common.BaseResponse
+}
+
+var RemovePolicyResult_Success_DEFAULT *common.BaseResponse
+
+func (p *RemovePolicyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RemovePolicyResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RemovePolicyResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return RemovePolicyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RemovePolicyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *RemovePolicyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RemovePolicyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getUserRolesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetUserRolesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetUserRoles(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetUserRolesArgs:
+		success, err := handler.(user.UserService).GetUserRoles(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetUserRolesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetUserRolesArgs() interface{} {
+	return &GetUserRolesArgs{}
+}
+
+func newGetUserRolesResult() interface{} {
+	return &GetUserRolesResult{}
+}
+
+type GetUserRolesArgs struct {
+	Req *user.GetUserRolesRequest
+}
+
+func (p *GetUserRolesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetUserRolesArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetUserRolesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetUserRolesArgs_Req_DEFAULT *user.GetUserRolesRequest
+
+func (p *GetUserRolesArgs) GetReq() *user.GetUserRolesRequest {
+	if !p.IsSetReq() {
+		return GetUserRolesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetUserRolesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetUserRolesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetUserRolesResult struct {
+	Success *user.GetUserRolesResponse
+}
+
+var GetUserRolesResult_Success_DEFAULT *user.GetUserRolesResponse
+
+func (p *GetUserRolesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetUserRolesResult) Unmarshal(in []byte) error {
+	msg := new(user.GetUserRolesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetUserRolesResult) GetSuccess() *user.GetUserRolesResponse {
+	if !p.IsSetSuccess() {
+		return GetUserRolesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetUserRolesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetUserRolesResponse)
+}
+
+func (p *GetUserRolesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetUserRolesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func assignRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RoleRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).AssignRole(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AssignRoleArgs:
+		success, err := handler.(user.UserService).AssignRole(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AssignRoleResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAssignRoleArgs() interface{} {
+	return &AssignRoleArgs{}
+}
+
+func newAssignRoleResult() interface{} {
+	return &AssignRoleResult{}
+}
+
+type AssignRoleArgs struct {
+	Req *user.RoleRequest
+}
+
+func (p *AssignRoleArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AssignRoleArgs) Unmarshal(in []byte) error {
+	msg := new(user.RoleRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AssignRoleArgs_Req_DEFAULT *user.RoleRequest
+
+func (p *AssignRoleArgs) GetReq() *user.RoleRequest {
+	if !p.IsSetReq() {
+		return AssignRoleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AssignRoleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AssignRoleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AssignRoleResult struct {
+	Success *common.BaseResponse
+}
+
+var AssignRoleResult_Success_DEFAULT *common.BaseResponse
+
+func (p *AssignRoleResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AssignRoleResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AssignRoleResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return AssignRoleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AssignRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *AssignRoleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AssignRoleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func revokeRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RoleRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RevokeRole(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RevokeRoleArgs:
+		success, err := handler.(user.UserService).RevokeRole(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeRoleResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRevokeRoleArgs() interface{} {
+	return &RevokeRoleArgs{}
+}
+
+func newRevokeRoleResult() interface{} {
+	return &RevokeRoleResult{}
+}
+
+type RevokeRoleArgs struct {
+	Req *user.RoleRequest
+}
+
+func (p *RevokeRoleArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeRoleArgs) Unmarshal(in []byte) error {
+	msg := new(user.RoleRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeRoleArgs_Req_DEFAULT *user.RoleRequest
+
+func (p *RevokeRoleArgs) GetReq() *user.RoleRequest {
+	if !p.IsSetReq() {
+		return RevokeRoleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeRoleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RevokeRoleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RevokeRoleResult struct {
+	Success *common.BaseResponse
+}
+
+var RevokeRoleResult_Success_DEFAULT *common.BaseResponse
+
+func (p *RevokeRoleResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeRoleResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeRoleResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return RevokeRoleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *RevokeRoleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RevokeRoleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listUsersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListUsersRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListUsers(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListUsersArgs:
+		success, err := handler.(user.UserService).ListUsers(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListUsersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListUsersArgs() interface{} {
+	return &ListUsersArgs{}
+}
+
+func newListUsersResult() interface{} {
+	return &ListUsersResult{}
+}
+
+type ListUsersArgs struct {
+	Req *user.ListUsersRequest
+}
+
+func (p *ListUsersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListUsersArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListUsersRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListUsersArgs_Req_DEFAULT *user.ListUsersRequest
+
+func (p *ListUsersArgs) GetReq() *user.ListUsersRequest {
+	if !p.IsSetReq() {
+		return ListUsersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListUsersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListUsersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListUsersResult struct {
+	Success *user.ListUsersResponse
+}
+
+var ListUsersResult_Success_DEFAULT *user.ListUsersResponse
+
+func (p *ListUsersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListUsersResult) Unmarshal(in []byte) error {
+	msg := new(user.ListUsersResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListUsersResult) GetSuccess() *user.ListUsersResponse {
+	if !p.IsSetSuccess() {
+		return ListUsersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListUsersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListUsersResponse)
+}
+
+func (p *ListUsersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListUsersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func changeUserStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ChangeUserStatusRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ChangeUserStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ChangeUserStatusArgs:
+		success, err := handler.(user.UserService).ChangeUserStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ChangeUserStatusResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newChangeUserStatusArgs() interface{} {
+	return &ChangeUserStatusArgs{}
+}
+
+func newChangeUserStatusResult() interface{} {
+	return &ChangeUserStatusResult{}
+}
+
+type ChangeUserStatusArgs struct {
+	Req *user.ChangeUserStatusRequest
+}
+
+func (p *ChangeUserStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ChangeUserStatusArgs) Unmarshal(in []byte) error {
+	msg := new(user.ChangeUserStatusRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ChangeUserStatusArgs_Req_DEFAULT *user.ChangeUserStatusRequest
+
+func (p *ChangeUserStatusArgs) GetReq() *user.ChangeUserStatusRequest {
+	if !p.IsSetReq() {
+		return ChangeUserStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ChangeUserStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ChangeUserStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ChangeUserStatusResult struct {
+	Success *common.BaseResponse
+}
+
+var ChangeUserStatusResult_Success_DEFAULT *common.BaseResponse
+
+func (p *ChangeUserStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ChangeUserStatusResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ChangeUserStatusResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return ChangeUserStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ChangeUserStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *ChangeUserStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ChangeUserStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createAccessTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.CreateAccessTokenRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).CreateAccessToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateAccessTokenArgs:
+		success, err := handler.(user.UserService).CreateAccessToken(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateAccessTokenResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateAccessTokenArgs() interface{} {
+	return &CreateAccessTokenArgs{}
+}
+
+func newCreateAccessTokenResult() interface{} {
+	return &CreateAccessTokenResult{}
+}
+
+type CreateAccessTokenArgs struct {
+	Req *user.CreateAccessTokenRequest
+}
+
+func (p *CreateAccessTokenArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateAccessTokenArgs) Unmarshal(in []byte) error {
+	msg := new(user.CreateAccessTokenRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateAccessTokenArgs_Req_DEFAULT *user.CreateAccessTokenRequest
+
+func (p *CreateAccessTokenArgs) GetReq() *user.CreateAccessTokenRequest {
+	if !p.IsSetReq() {
+		return CreateAccessTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateAccessTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateAccessTokenArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateAccessTokenResult struct {
+	Success *user.CreateAccessTokenResponse
+}
+
+var CreateAccessTokenResult_Success_DEFAULT *user.CreateAccessTokenResponse
+
+func (p *CreateAccessTokenResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateAccessTokenResult) Unmarshal(in []byte) error {
+	msg := new(user.CreateAccessTokenResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateAccessTokenResult) GetSuccess() *user.CreateAccessTokenResponse {
+	if !p.IsSetSuccess() {
+		return CreateAccessTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateAccessTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.CreateAccessTokenResponse)
+}
+
+func (p *CreateAccessTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateAccessTokenResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listAccessTokensHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListAccessTokensRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListAccessTokens(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListAccessTokensArgs:
+		success, err := handler.(user.UserService).ListAccessTokens(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListAccessTokensResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListAccessTokensArgs() interface{} {
+	return &ListAccessTokensArgs{}
+}
+
+func newListAccessTokensResult() interface{} {
+	return &ListAccessTokensResult{}
+}
+
+type ListAccessTokensArgs struct {
+	Req *user.ListAccessTokensRequest
+}
+
+func (p *ListAccessTokensArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListAccessTokensArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListAccessTokensRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListAccessTokensArgs_Req_DEFAULT *user.ListAccessTokensRequest
+
+func (p *ListAccessTokensArgs) GetReq() *user.ListAccessTokensRequest {
+	if !p.IsSetReq() {
+		return ListAccessTokensArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListAccessTokensArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListAccessTokensArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListAccessTokensResult struct {
+	Success *user.ListAccessTokensResponse
+}
+
+var ListAccessTokensResult_Success_DEFAULT *user.ListAccessTokensResponse
+
+func (p *ListAccessTokensResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListAccessTokensResult) Unmarshal(in []byte) error {
+	msg := new(user.ListAccessTokensResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListAccessTokensResult) GetSuccess() *user.ListAccessTokensResponse {
+	if !p.IsSetSuccess() {
+		return ListAccessTokensResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListAccessTokensResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListAccessTokensResponse)
+}
+
+func (p *ListAccessTokensResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListAccessTokensResult) GetResult() interface{} {
+	return p.Success
+}
+
+func revokeAccessTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RevokeAccessTokenRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RevokeAccessToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RevokeAccessTokenArgs:
+		success, err := handler.(user.UserService).RevokeAccessToken(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeAccessTokenResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRevokeAccessTokenArgs() interface{} {
+	return &RevokeAccessTokenArgs{}
+}
+
+func newRevokeAccessTokenResult() interface{} {
+	return &RevokeAccessTokenResult{}
+}
+
+type RevokeAccessTokenArgs struct {
+	Req *user.RevokeAccessTokenRequest
+}
+
+func (p *RevokeAccessTokenArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeAccessTokenArgs) Unmarshal(in []byte) error {
+	msg := new(user.RevokeAccessTokenRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeAccessTokenArgs_Req_DEFAULT *user.RevokeAccessTokenRequest
+
+func (p *RevokeAccessTokenArgs) GetReq() *user.RevokeAccessTokenRequest {
+	if !p.IsSetReq() {
+		return RevokeAccessTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeAccessTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RevokeAccessTokenArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RevokeAccessTokenResult struct {
+	Success *common.BaseResponse
+}
+
+var RevokeAccessTokenResult_Success_DEFAULT *common.BaseResponse
+
+func (p *RevokeAccessTokenResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeAccessTokenResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeAccessTokenResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return RevokeAccessTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeAccessTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *RevokeAccessTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RevokeAccessTokenResult) GetResult() interface{} {
+	return p.Success
+}
+
+func authenticateAccessTokenHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.AuthenticateAccessTokenRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).AuthenticateAccessToken(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AuthenticateAccessTokenArgs:
+		success, err := handler.(user.UserService).AuthenticateAccessToken(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AuthenticateAccessTokenResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAuthenticateAccessTokenArgs() interface{} {
+	return &AuthenticateAccessTokenArgs{}
+}
+
+func newAuthenticateAccessTokenResult() interface{} {
+	return &AuthenticateAccessTokenResult{}
+}
+
+type AuthenticateAccessTokenArgs struct {
+	Req *user.AuthenticateAccessTokenRequest
+}
+
+func (p *AuthenticateAccessTokenArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AuthenticateAccessTokenArgs) Unmarshal(in []byte) error {
+	msg := new(user.AuthenticateAccessTokenRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AuthenticateAccessTokenArgs_Req_DEFAULT *user.AuthenticateAccessTokenRequest
+
+func (p *AuthenticateAccessTokenArgs) GetReq() *user.AuthenticateAccessTokenRequest {
+	if !p.IsSetReq() {
+		return AuthenticateAccessTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AuthenticateAccessTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AuthenticateAccessTokenArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AuthenticateAccessTokenResult struct {
+	Success *user.AuthenticateAccessTokenResponse
+}
+
+var AuthenticateAccessTokenResult_Success_DEFAULT *user.AuthenticateAccessTokenResponse
+
+func (p *AuthenticateAccessTokenResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AuthenticateAccessTokenResult) Unmarshal(in []byte) error {
+	msg := new(user.AuthenticateAccessTokenResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AuthenticateAccessTokenResult) GetSuccess() *user.AuthenticateAccessTokenResponse {
+	if !p.IsSetSuccess() {
+		return AuthenticateAccessTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AuthenticateAccessTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.AuthenticateAccessTokenResponse)
+}
+
+func (p *AuthenticateAccessTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AuthenticateAccessTokenResult) GetResult() interface{} {
+	return p.Success
+}
+
+func uploadProfileImageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UploadProfileImageRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UploadProfileImage(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UploadProfileImageArgs:
+		success, err := handler.(user.UserService).UploadProfileImage(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UploadProfileImageResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUploadProfileImageArgs() interface{} {
+	return &UploadProfileImageArgs{}
+}
+
+func newUploadProfileImageResult() interface{} {
+	return &UploadProfileImageResult{}
+}
+
+type UploadProfileImageArgs struct {
+	Req *user.UploadProfileImageRequest
+}
+
+func (p *UploadProfileImageArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UploadProfileImageArgs) Unmarshal(in []byte) error {
+	msg := new(user.UploadProfileImageRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UploadProfileImageArgs_Req_DEFAULT *user.UploadProfileImageRequest
+
+func (p *UploadProfileImageArgs) GetReq() *user.UploadProfileImageRequest {
+	if !p.IsSetReq() {
+		return UploadProfileImageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UploadProfileImageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UploadProfileImageArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UploadProfileImageResult struct {
+	Success *user.UploadProfileImageResponse
+}
+
+var UploadProfileImageResult_Success_DEFAULT *user.UploadProfileImageResponse
+
+func (p *UploadProfileImageResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UploadProfileImageResult) Unmarshal(in []byte) error {
+	msg := new(user.UploadProfileImageResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UploadProfileImageResult) GetSuccess() *user.UploadProfileImageResponse {
+	if !p.IsSetSuccess() {
+		return UploadProfileImageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UploadProfileImageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UploadProfileImageResponse)
+}
+
+func (p *UploadProfileImageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UploadProfileImageResult) GetResult() interface{} {
+	return p.Success
+}
+
+func requestDataExportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RequestDataExportRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RequestDataExport(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RequestDataExportArgs:
+		success, err := handler.(user.UserService).RequestDataExport(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RequestDataExportResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRequestDataExportArgs() interface{} {
+	return &RequestDataExportArgs{}
+}
+
+func newRequestDataExportResult() interface{} {
+	return &RequestDataExportResult{}
+}
+
+type RequestDataExportArgs struct {
+	Req *user.RequestDataExportRequest
+}
+
+func (p *RequestDataExportArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RequestDataExportArgs) Unmarshal(in []byte) error {
+	msg := new(user.RequestDataExportRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RequestDataExportArgs_Req_DEFAULT *user.RequestDataExportRequest
+
+func (p *RequestDataExportArgs) GetReq() *user.RequestDataExportRequest {
+	if !p.IsSetReq() {
+		return RequestDataExportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RequestDataExportArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RequestDataExportArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RequestDataExportResult struct {
+	Success *user.DataJobResponse
+}
+
+var RequestDataExportResult_Success_DEFAULT *user.DataJobResponse
+
+func (p *RequestDataExportResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RequestDataExportResult) Unmarshal(in []byte) error {
+	msg := new(user.DataJobResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RequestDataExportResult) GetSuccess() *user.DataJobResponse {
+	if !p.IsSetSuccess() {
+		return RequestDataExportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RequestDataExportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DataJobResponse)
+}
+
+func (p *RequestDataExportResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RequestDataExportResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getDataExportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetDataExportRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetDataExport(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetDataExportArgs:
+		success, err := handler.(user.UserService).GetDataExport(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetDataExportResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetDataExportArgs() interface{} {
+	return &GetDataExportArgs{}
+}
+
+func newGetDataExportResult() interface{} {
+	return &GetDataExportResult{}
+}
+
+type GetDataExportArgs struct {
+	Req *user.GetDataExportRequest
+}
+
+func (p *GetDataExportArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetDataExportArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetDataExportRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetDataExportArgs_Req_DEFAULT *user.GetDataExportRequest
+
+func (p *GetDataExportArgs) GetReq() *user.GetDataExportRequest {
+	if !p.IsSetReq() {
+		return GetDataExportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetDataExportArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetDataExportArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetDataExportResult struct {
+	Success *user.DataJobResponse
+}
+
+var GetDataExportResult_Success_DEFAULT *user.DataJobResponse
+
+func (p *GetDataExportResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetDataExportResult) Unmarshal(in []byte) error {
+	msg := new(user.DataJobResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetDataExportResult) GetSuccess() *user.DataJobResponse {
+	if !p.IsSetSuccess() {
+		return GetDataExportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetDataExportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DataJobResponse)
+}
+
+func (p *GetDataExportResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetDataExportResult) GetResult() interface{} {
+	return p.Success
+}
+
+func requestAccountErasureHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RequestAccountErasureRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RequestAccountErasure(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RequestAccountErasureArgs:
+		success, err := handler.(user.UserService).RequestAccountErasure(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RequestAccountErasureResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRequestAccountErasureArgs() interface{} {
+	return &RequestAccountErasureArgs{}
+}
+
+func newRequestAccountErasureResult() interface{} {
+	return &RequestAccountErasureResult{}
+}
+
+type RequestAccountErasureArgs struct {
+	Req *user.RequestAccountErasureRequest
+}
+
+func (p *RequestAccountErasureArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RequestAccountErasureArgs) Unmarshal(in []byte) error {
+	msg := new(user.RequestAccountErasureRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RequestAccountErasureArgs_Req_DEFAULT *user.RequestAccountErasureRequest
+
+func (p *RequestAccountErasureArgs) GetReq() *user.RequestAccountErasureRequest {
+	if !p.IsSetReq() {
+		return RequestAccountErasureArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RequestAccountErasureArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RequestAccountErasureArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RequestAccountErasureResult struct {
+	Success *user.DataJobResponse
+}
+
+var RequestAccountErasureResult_Success_DEFAULT *user.DataJobResponse
+
+func (p *RequestAccountErasureResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RequestAccountErasureResult) Unmarshal(in []byte) error {
+	msg := new(user.DataJobResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RequestAccountErasureResult) GetSuccess() *user.DataJobResponse {
+	if !p.IsSetSuccess() {
+		return RequestAccountErasureResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RequestAccountErasureResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DataJobResponse)
+}
+
+func (p *RequestAccountErasureResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RequestAccountErasureResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listAuditLogsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListAuditLogsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListAuditLogs(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListAuditLogsArgs:
+		success, err := handler.(user.UserService).ListAuditLogs(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListAuditLogsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListAuditLogsArgs() interface{} {
+	return &ListAuditLogsArgs{}
+}
+
+func newListAuditLogsResult() interface{} {
+	return &ListAuditLogsResult{}
+}
+
+type ListAuditLogsArgs struct {
+	Req *user.ListAuditLogsRequest
+}
+
+func (p *ListAuditLogsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListAuditLogsArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListAuditLogsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListAuditLogsArgs_Req_DEFAULT *user.ListAuditLogsRequest
+
+func (p *ListAuditLogsArgs) GetReq() *user.ListAuditLogsRequest {
+	if !p.IsSetReq() {
+		return ListAuditLogsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListAuditLogsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListAuditLogsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListAuditLogsResult struct {
+	Success *user.ListAuditLogsResponse
+}
+
+var ListAuditLogsResult_Success_DEFAULT *user.ListAuditLogsResponse
+
+func (p *ListAuditLogsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListAuditLogsResult) Unmarshal(in []byte) error {
+	msg := new(user.ListAuditLogsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListAuditLogsResult) GetSuccess() *user.ListAuditLogsResponse {
+	if !p.IsSetSuccess() {
+		return ListAuditLogsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListAuditLogsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListAuditLogsResponse)
+}
+
+func (p *ListAuditLogsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListAuditLogsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getRecentActivityHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetRecentActivityRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetRecentActivity(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetRecentActivityArgs:
+		success, err := handler.(user.UserService).GetRecentActivity(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetRecentActivityResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetRecentActivityArgs() interface{} {
+	return &GetRecentActivityArgs{}
+}
+
+func newGetRecentActivityResult() interface{} {
+	return &GetRecentActivityResult{}
+}
+
+type GetRecentActivityArgs struct {
+	Req *user.GetRecentActivityRequest
+}
+
+func (p *GetRecentActivityArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetRecentActivityArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetRecentActivityRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetRecentActivityArgs_Req_DEFAULT *user.GetRecentActivityRequest
+
+func (p *GetRecentActivityArgs) GetReq() *user.GetRecentActivityRequest {
+	if !p.IsSetReq() {
+		return GetRecentActivityArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetRecentActivityArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetRecentActivityArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetRecentActivityResult struct {
+	Success *user.GetRecentActivityResponse
+}
+
+var GetRecentActivityResult_Success_DEFAULT *user.GetRecentActivityResponse
+
+func (p *GetRecentActivityResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetRecentActivityResult) Unmarshal(in []byte) error {
+	msg := new(user.GetRecentActivityResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetRecentActivityResult) GetSuccess() *user.GetRecentActivityResponse {
+	if !p.IsSetSuccess() {
+		return GetRecentActivityResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetRecentActivityResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetRecentActivityResponse)
+}
+
+func (p *GetRecentActivityResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetRecentActivityResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listSessionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListSessionsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListSessions(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListSessionsArgs:
+		success, err := handler.(user.UserService).ListSessions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListSessionsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListSessionsArgs() interface{} {
+	return &ListSessionsArgs{}
+}
+
+func newListSessionsResult() interface{} {
+	return &ListSessionsResult{}
+}
+
+type ListSessionsArgs struct {
+	Req *user.ListSessionsRequest
+}
+
+func (p *ListSessionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListSessionsArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListSessionsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListSessionsArgs_Req_DEFAULT *user.ListSessionsRequest
+
+func (p *ListSessionsArgs) GetReq() *user.ListSessionsRequest {
+	if !p.IsSetReq() {
+		return ListSessionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListSessionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListSessionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListSessionsResult struct {
+	Success *user.ListSessionsResponse
+}
+
+var ListSessionsResult_Success_DEFAULT *user.ListSessionsResponse
+
+func (p *ListSessionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListSessionsResult) Unmarshal(in []byte) error {
+	msg := new(user.ListSessionsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListSessionsResult) GetSuccess() *user.ListSessionsResponse {
+	if !p.IsSetSuccess() {
+		return ListSessionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListSessionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListSessionsResponse)
+}
+
+func (p *ListSessionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListSessionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func revokeSessionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.RevokeSessionRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).RevokeSession(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RevokeSessionArgs:
+		success, err := handler.(user.UserService).RevokeSession(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RevokeSessionResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRevokeSessionArgs() interface{} {
+	return &RevokeSessionArgs{}
+}
+
+func newRevokeSessionResult() interface{} {
+	return &RevokeSessionResult{}
+}
+
+type RevokeSessionArgs struct {
+	Req *user.RevokeSessionRequest
+}
+
+func (p *RevokeSessionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RevokeSessionArgs) Unmarshal(in []byte) error {
+	msg := new(user.RevokeSessionRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RevokeSessionArgs_Req_DEFAULT *user.RevokeSessionRequest
+
+func (p *RevokeSessionArgs) GetReq() *user.RevokeSessionRequest {
+	if !p.IsSetReq() {
+		return RevokeSessionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RevokeSessionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RevokeSessionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RevokeSessionResult struct {
+	Success *common.BaseResponse
+}
+
+var RevokeSessionResult_Success_DEFAULT *common.BaseResponse
+
+func (p *RevokeSessionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RevokeSessionResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RevokeSessionResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return RevokeSessionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RevokeSessionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *RevokeSessionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RevokeSessionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getPreferencesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetPreferencesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetPreferences(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetPreferencesArgs:
+		success, err := handler.(user.UserService).GetPreferences(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetPreferencesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetPreferencesArgs() interface{} {
+	return &GetPreferencesArgs{}
+}
+
+func newGetPreferencesResult() interface{} {
+	return &GetPreferencesResult{}
+}
+
+type GetPreferencesArgs struct {
+	Req *user.GetPreferencesRequest
+}
+
+func (p *GetPreferencesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetPreferencesArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetPreferencesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetPreferencesArgs_Req_DEFAULT *user.GetPreferencesRequest
+
+func (p *GetPreferencesArgs) GetReq() *user.GetPreferencesRequest {
+	if !p.IsSetReq() {
+		return GetPreferencesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetPreferencesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetPreferencesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetPreferencesResult struct {
+	Success *user.PreferencesResponse
+}
+
+var GetPreferencesResult_Success_DEFAULT *user.PreferencesResponse
+
+func (p *GetPreferencesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetPreferencesResult) Unmarshal(in []byte) error {
+	msg := new(user.PreferencesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetPreferencesResult) GetSuccess() *user.PreferencesResponse {
+	if !p.IsSetSuccess() {
+		return GetPreferencesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetPreferencesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.PreferencesResponse)
+}
+
+func (p *GetPreferencesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetPreferencesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func setPreferenceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SetPreferenceRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SetPreference(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SetPreferenceArgs:
+		success, err := handler.(user.UserService).SetPreference(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SetPreferenceResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSetPreferenceArgs() interface{} {
+	return &SetPreferenceArgs{}
+}
+
+func newSetPreferenceResult() interface{} {
+	return &SetPreferenceResult{}
+}
+
+type SetPreferenceArgs struct {
+	Req *user.SetPreferenceRequest
+}
+
+func (p *SetPreferenceArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SetPreferenceArgs) Unmarshal(in []byte) error {
+	msg := new(user.SetPreferenceRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SetPreferenceArgs_Req_DEFAULT *user.SetPreferenceRequest
+
+func (p *SetPreferenceArgs) GetReq() *user.SetPreferenceRequest {
+	if !p.IsSetReq() {
+		return SetPreferenceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SetPreferenceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SetPreferenceArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SetPreferenceResult struct {
+	Success *common.BaseResponse
+}
+
+var SetPreferenceResult_Success_DEFAULT *common.BaseResponse
+
+func (p *SetPreferenceResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SetPreferenceResult) Unmarshal(in []byte) error {
+	msg := new(common.BaseResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SetPreferenceResult) GetSuccess() *common.BaseResponse {
+	if !p.IsSetSuccess() {
+		return SetPreferenceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SetPreferenceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*common.BaseResponse)
+}
+
+func (p *SetPreferenceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SetPreferenceResult) GetResult() interface{} {
+	return p.Success
+}
+
+func batchSetPreferencesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.BatchSetPreferencesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).BatchSetPreferences(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *BatchSetPreferencesArgs:
+		success, err := handler.(user.UserService).BatchSetPreferences(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*BatchSetPreferencesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newBatchSetPreferencesArgs() interface{} {
+	return &BatchSetPreferencesArgs{}
+}
+
+func newBatchSetPreferencesResult() interface{} {
+	return &BatchSetPreferencesResult{}
+}
+
+type BatchSetPreferencesArgs struct {
+	Req *user.BatchSetPreferencesRequest
+}
+
+func (p *BatchSetPreferencesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *BatchSetPreferencesArgs) Unmarshal(in []byte) error {
+	msg := new(user.BatchSetPreferencesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var BatchSetPreferencesArgs_Req_DEFAULT *user.BatchSetPreferencesRequest
+
+func (p *BatchSetPreferencesArgs) GetReq() *user.BatchSetPreferencesRequest {
+	if !p.IsSetReq() {
+		return BatchSetPreferencesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *BatchSetPreferencesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *BatchSetPreferencesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type BatchSetPreferencesResult struct {
+	Success *user.PreferencesResponse
+}
+
+var BatchSetPreferencesResult_Success_DEFAULT *user.PreferencesResponse
+
+func (p *BatchSetPreferencesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *BatchSetPreferencesResult) Unmarshal(in []byte) error {
+	msg := new(user.PreferencesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BatchSetPreferencesResult) GetSuccess() *user.PreferencesResponse {
+	if !p.IsSetSuccess() {
+		return BatchSetPreferencesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *BatchSetPreferencesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.PreferencesResponse)
+}
+
+func (p *BatchSetPreferencesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BatchSetPreferencesResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) Register(ctx context.Context, Req *user.RegisterRequest) (r *user.UserAuthInfoResponse, err error) {
+	var _args RegisterArgs
+	_args.Req = Req
+	var _result RegisterResult
+	if err = p.c.Call(ctx, "Register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Login(ctx context.Context, Req *user.LoginRequest) (r *user.UserAuthInfoResponse, err error) {
+	var _args LoginArgs
+	_args.Req = Req
+	var _result LoginResult
+	if err = p.c.Call(ctx, "Login", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Refresh(ctx context.Context, Req *user.RefreshRequest) (r *user.AuthResponse, err error) {
+	var _args RefreshArgs
+	_args.Req = Req
+	var _result RefreshResult
+	if err = p.c.Call(ctx, "Refresh", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Update(ctx context.Context, Req *user.UpdateRequest) (r *common.BaseResponse, err error) {
+	var _args UpdateArgs
+	_args.Req = Req
+	var _result UpdateResult
+	if err = p.c.Call(ctx, "Update", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserInfo(ctx context.Context, Req *user.GetUserInfoRequest) (r *user.GetUserInfoResponse, err error) {
+	var _args GetUserInfoArgs
+	_args.Req = Req
+	var _result GetUserInfoResult
+	if err = p.c.Call(ctx, "GetUserInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Logout(ctx context.Context, Req *user.LogoutRequest) (r *common.BaseResponse, err error) {
+	var _args LogoutArgs
+	_args.Req = Req
+	var _result LogoutResult
+	if err = p.c.Call(ctx, "Logout", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) LogoutAll(ctx context.Context, Req *user.LogoutAllRequest) (r *common.BaseResponse, err error) {
+	var _args LogoutAllArgs
+	_args.Req = Req
+	var _result LogoutAllResult
+	if err = p.c.Call(ctx, "LogoutAll", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SendEmailCode(ctx context.Context, Req *user.SendEmailCodeRequest) (r *common.BaseResponse, err error) {
+	var _args SendEmailCodeArgs
+	_args.Req = Req
+	var _result SendEmailCodeResult
+	if err = p.c.Call(ctx, "SendEmailCode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyEmail(ctx context.Context, Req *user.VerifyEmailRequest) (r *common.BaseResponse, err error) {
+	var _args VerifyEmailArgs
+	_args.Req = Req
+	var _result VerifyEmailResult
+	if err = p.c.Call(ctx, "VerifyEmail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestPasswordReset(ctx context.Context, Req *user.RequestPasswordResetRequest) (r *common.BaseResponse, err error) {
+	var _args RequestPasswordResetArgs
+	_args.Req = Req
+	var _result RequestPasswordResetResult
+	if err = p.c.Call(ctx, "RequestPasswordReset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResetPassword(ctx context.Context, Req *user.ResetPasswordRequest) (r *common.BaseResponse, err error) {
+	var _args ResetPasswordArgs
+	_args.Req = Req
+	var _result ResetPasswordResult
+	if err = p.c.Call(ctx, "ResetPassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SendPhoneCode(ctx context.Context, Req *user.SendPhoneCodeRequest) (r *common.BaseResponse, err error) {
+	var _args SendPhoneCodeArgs
+	_args.Req = Req
+	var _result SendPhoneCodeResult
+	if err = p.c.Call(ctx, "SendPhoneCode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PhoneLogin(ctx context.Context, Req *user.PhoneLoginRequest) (r *user.UserAuthInfoResponse, err error) {
+	var _args PhoneLoginArgs
+	_args.Req = Req
+	var _result PhoneLoginResult
+	if err = p.c.Call(ctx, "PhoneLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) OAuthAuthorize(ctx context.Context, Req *user.OAuthAuthorizeRequest) (r *user.OAuthAuthorizeResponse, err error) {
+	var _args OAuthAuthorizeArgs
+	_args.Req = Req
+	var _result OAuthAuthorizeResult
+	if err = p.c.Call(ctx, "OAuthAuthorize", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) OAuthCallback(ctx context.Context, Req *user.OAuthCallbackRequest) (r *user.OAuthCallbackResponse, err error) {
+	var _args OAuthCallbackArgs
+	_args.Req = Req
+	var _result OAuthCallbackResult
+	if err = p.c.Call(ctx, "OAuthCallback", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListIdentities(ctx context.Context, Req *user.ListIdentitiesRequest) (r *user.ListIdentitiesResponse, err error) {
+	var _args ListIdentitiesArgs
+	_args.Req = Req
+	var _result ListIdentitiesResult
+	if err = p.c.Call(ctx, "ListIdentities", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnlinkIdentity(ctx context.Context, Req *user.UnlinkIdentityRequest) (r *common.BaseResponse, err error) {
+	var _args UnlinkIdentityArgs
+	_args.Req = Req
+	var _result UnlinkIdentityResult
+	if err = p.c.Call(ctx, "UnlinkIdentity", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyMFA(ctx context.Context, Req *user.VerifyMFARequest) (r *user.UserAuthInfoResponse, err error) {
+	var _args VerifyMFAArgs
+	_args.Req = Req
+	var _result VerifyMFAResult
+	if err = p.c.Call(ctx, "VerifyMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EnrollTOTP(ctx context.Context, Req *user.EnrollTOTPRequest) (r *user.EnrollTOTPResponse, err error) {
+	var _args EnrollTOTPArgs
+	_args.Req = Req
+	var _result EnrollTOTPResult
+	if err = p.c.Call(ctx, "EnrollTOTP", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmTOTP(ctx context.Context, Req *user.ConfirmTOTPRequest) (r *user.RecoveryCodesResponse, err error) {
+	var _args ConfirmTOTPArgs
+	_args.Req = Req
+	var _result ConfirmTOTPResult
+	if err = p.c.Call(ctx, "ConfirmTOTP", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisableTOTP(ctx context.Context, Req *user.DisableTOTPRequest) (r *common.BaseResponse, err error) {
+	var _args DisableTOTPArgs
+	_args.Req = Req
+	var _result DisableTOTPResult
+	if err = p.c.Call(ctx, "DisableTOTP", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RegenerateRecoveryCodes(ctx context.Context, Req *user.RegenerateRecoveryCodesRequest) (r *user.RecoveryCodesResponse, err error) {
+	var _args RegenerateRecoveryCodesArgs
+	_args.Req = Req
+	var _result RegenerateRecoveryCodesResult
+	if err = p.c.Call(ctx, "RegenerateRecoveryCodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPolicies(ctx context.Context, Req *user.ListPoliciesRequest) (r *user.ListPoliciesResponse, err error) {
+	var _args ListPoliciesArgs
+	_args.Req = Req
+	var _result ListPoliciesResult
+	if err = p.c.Call(ctx, "ListPolicies", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddPolicy(ctx context.Context, Req *user.PolicyRequest) (r *common.BaseResponse, err error) {
+	var _args AddPolicyArgs
+	_args.Req = Req
+	var _result AddPolicyResult
+	if err = p.c.Call(ctx, "AddPolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemovePolicy(ctx context.Context, Req *user.PolicyRequest) (r *common.BaseResponse, err error) {
+	var _args RemovePolicyArgs
+	_args.Req = Req
+	var _result RemovePolicyResult
+	if err = p.c.Call(ctx, "RemovePolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserRoles(ctx context.Context, Req *user.GetUserRolesRequest) (r *user.GetUserRolesResponse, err error) {
+	var _args GetUserRolesArgs
+	_args.Req = Req
+	var _result GetUserRolesResult
+	if err = p.c.Call(ctx, "GetUserRoles", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AssignRole(ctx context.Context, Req *user.RoleRequest) (r *common.BaseResponse, err error) {
+	var _args AssignRoleArgs
+	_args.Req = Req
+	var _result AssignRoleResult
+	if err = p.c.Call(ctx, "AssignRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeRole(ctx context.Context, Req *user.RoleRequest) (r *common.BaseResponse, err error) {
+	var _args RevokeRoleArgs
+	_args.Req = Req
+	var _result RevokeRoleResult
+	if err = p.c.Call(ctx, "RevokeRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListUsers(ctx context.Context, Req *user.ListUsersRequest) (r *user.ListUsersResponse, err error) {
+	var _args ListUsersArgs
+	_args.Req = Req
+	var _result ListUsersResult
+	if err = p.c.Call(ctx, "ListUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ChangeUserStatus(ctx context.Context, Req *user.ChangeUserStatusRequest) (r *common.BaseResponse, err error) {
+	var _args ChangeUserStatusArgs
+	_args.Req = Req
+	var _result ChangeUserStatusResult
+	if err = p.c.Call(ctx, "ChangeUserStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAccessToken(ctx context.Context, Req *user.CreateAccessTokenRequest) (r *user.CreateAccessTokenResponse, err error) {
+	var _args CreateAccessTokenArgs
+	_args.Req = Req
+	var _result CreateAccessTokenResult
+	if err = p.c.Call(ctx, "CreateAccessToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAccessTokens(ctx context.Context, Req *user.ListAccessTokensRequest) (r *user.ListAccessTokensResponse, err error) {
+	var _args ListAccessTokensArgs
+	_args.Req = Req
+	var _result ListAccessTokensResult
+	if err = p.c.Call(ctx, "ListAccessTokens", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeAccessToken(ctx context.Context, Req *user.RevokeAccessTokenRequest) (r *common.BaseResponse, err error) {
+	var _args RevokeAccessTokenArgs
+	_args.Req = Req
+	var _result RevokeAccessTokenResult
+	if err = p.c.Call(ctx, "RevokeAccessToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AuthenticateAccessToken(ctx context.Context, Req *user.AuthenticateAccessTokenRequest) (r *user.AuthenticateAccessTokenResponse, err error) {
+	var _args AuthenticateAccessTokenArgs
+	_args.Req = Req
+	var _result AuthenticateAccessTokenResult
+	if err = p.c.Call(ctx, "AuthenticateAccessToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadProfileImage(ctx context.Context, Req *user.UploadProfileImageRequest) (r *user.UploadProfileImageResponse, err error) {
+	var _args UploadProfileImageArgs
+	_args.Req = Req
+	var _result UploadProfileImageResult
+	if err = p.c.Call(ctx, "UploadProfileImage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestDataExport(ctx context.Context, Req *user.RequestDataExportRequest) (r *user.DataJobResponse, err error) {
+	var _args RequestDataExportArgs
+	_args.Req = Req
+	var _result RequestDataExportResult
+	if err = p.c.Call(ctx, "RequestDataExport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDataExport(ctx context.Context, Req *user.GetDataExportRequest) (r *user.DataJobResponse, err error) {
+	var _args GetDataExportArgs
+	_args.Req = Req
+	var _result GetDataExportResult
+	if err = p.c.Call(ctx, "GetDataExport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestAccountErasure(ctx context.Context, Req *user.RequestAccountErasureRequest) (r *user.DataJobResponse, err error) {
+	var _args RequestAccountErasureArgs
+	_args.Req = Req
+	var _result RequestAccountErasureResult
+	if err = p.c.Call(ctx, "RequestAccountErasure", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAuditLogs(ctx context.Context, Req *user.ListAuditLogsRequest) (r *user.ListAuditLogsResponse, err error) {
+	var _args ListAuditLogsArgs
+	_args.Req = Req
+	var _result ListAuditLogsResult
+	if err = p.c.Call(ctx, "ListAuditLogs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetRecentActivity(ctx context.Context, Req *user.GetRecentActivityRequest) (r *user.GetRecentActivityResponse, err error) {
+	var _args GetRecentActivityArgs
+	_args.Req = Req
+	var _result GetRecentActivityResult
+	if err = p.c.Call(ctx, "GetRecentActivity", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListSessions(ctx context.Context, Req *user.ListSessionsRequest) (r *user.ListSessionsResponse, err error) {
+	var _args ListSessionsArgs
+	_args.Req = Req
+	var _result ListSessionsResult
+	if err = p.c.Call(ctx, "ListSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeSession(ctx context.Context, Req *user.RevokeSessionRequest) (r *common.BaseResponse, err error) {
+	var _args RevokeSessionArgs
+	_args.Req = Req
+	var _result RevokeSessionResult
+	if err = p.c.Call(ctx, "RevokeSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPreferences(ctx context.Context, Req *user.GetPreferencesRequest) (r *user.PreferencesResponse, err error) {
+	var _args GetPreferencesArgs
+	_args.Req = Req
+	var _result GetPreferencesResult
+	if err = p.c.Call(ctx, "GetPreferences", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetPreference(ctx context.Context, Req *user.SetPreferenceRequest) (r *common.BaseResponse, err error) {
+	var _args SetPreferenceArgs
+	_args.Req = Req
+	var _result SetPreferenceResult
+	if err = p.c.Call(ctx, "SetPreference", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchSetPreferences(ctx context.Context, Req *user.BatchSetPreferencesRequest) (r *user.PreferencesResponse, err error) {
+	var _args BatchSetPreferencesArgs
+	_args.Req = Req
+	var _result BatchSetPreferencesResult
+	if err = p.c.Call(ctx, "BatchSetPreferences", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
@@ -1,15 +1,27 @@
 package nacos
 
 import (
+	"github.com/cloudwego/kitex/pkg/discovery"
 	kitexregistry "github.com/cloudwego/kitex/pkg/registry"
 	"github.com/kitex-contrib/registry-nacos/v2/registry"
+	"github.com/kitex-contrib/registry-nacos/v2/resolver"
 	"github.com/nacos-group/nacos-sdk-go/v2/clients"
+	"github.com/nacos-group/nacos-sdk-go/v2/clients/naming_client"
 	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
 	"github.com/nacos-group/nacos-sdk-go/v2/vo"
 	"github.com/spf13/viper"
 )
 
 func NewNacosRegister(conf *viper.Viper) kitexregistry.Registry {
+	return registry.NewNacosRegistry(newNamingClient(conf))
+}
+
+// NewNacosResolver 创建基于 Nacos 的服务发现，供 RPC 客户端使用
+func NewNacosResolver(conf *viper.Viper) discovery.Resolver {
+	return resolver.NewNacosResolver(newNamingClient(conf))
+}
+
+func newNamingClient(conf *viper.Viper) naming_client.INamingClient {
 	sc := []constant.ServerConfig{
 		*constant.NewServerConfig(conf.GetString("app.register.nacos.addr"), conf.GetUint64("app.register.nacos.port")),
 	}
@@ -34,7 +46,5 @@ func NewNacosRegister(conf *viper.Viper) kitexregistry.Registry {
 	if err != nil {
 		panic(err)
 	}
-	r := registry.NewNacosRegistry(cli)
-
-	return r
+	return cli
 }
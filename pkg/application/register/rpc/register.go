@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"github.com/cloudwego/kitex/pkg/discovery"
 	kitexregistry "github.com/cloudwego/kitex/pkg/registry"
 	"github.com/spf13/viper"
 
@@ -13,3 +14,11 @@ func NewRegister(conf *viper.Viper) kitexregistry.Registry {
 	}
 	return nil
 }
+
+// NewResolver 创建 RPC 客户端的服务发现，未配置注册中心时返回 nil，客户端需使用固定地址
+func NewResolver(conf *viper.Viper) discovery.Resolver {
+	if conf.Get("app.register.nacos") != nil {
+		return nacos.NewNacosResolver(conf)
+	}
+	return nil
+}
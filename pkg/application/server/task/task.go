@@ -0,0 +1,56 @@
+package task
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/log"
+)
+
+// Server 按固定间隔执行后台任务
+type Server struct {
+	logger   *log.Logger
+	interval time.Duration
+	fn       func(ctx context.Context) error
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func NewServer(logger *log.Logger, interval time.Duration, fn func(ctx context.Context) error) *Server {
+	return &Server{
+		logger:   logger,
+		interval: interval,
+		fn:       fn,
+	}
+}
+
+func (s *Server) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.fn(ctx); err != nil {
+					s.logger.Error("task run failed", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *Server) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
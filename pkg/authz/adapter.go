@@ -0,0 +1,136 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"gorm.io/gorm"
+)
+
+// CasbinRule casbin_rule 表的一行策略，字段与 casbin/gorm-adapter 兼容
+type CasbinRule struct {
+	ID    uint64 `gorm:"column:id;primaryKey;autoIncrement:true"`
+	Ptype string `gorm:"column:ptype;type:varchar(100);uniqueIndex:unique_index"`
+	V0    string `gorm:"column:v0;type:varchar(100);uniqueIndex:unique_index"`
+	V1    string `gorm:"column:v1;type:varchar(100);uniqueIndex:unique_index"`
+	V2    string `gorm:"column:v2;type:varchar(100);uniqueIndex:unique_index"`
+	V3    string `gorm:"column:v3;type:varchar(100);uniqueIndex:unique_index"`
+	V4    string `gorm:"column:v4;type:varchar(100);uniqueIndex:unique_index"`
+	V5    string `gorm:"column:v5;type:varchar(100);uniqueIndex:unique_index"`
+}
+
+func (CasbinRule) TableName() string {
+	return "casbin_rule"
+}
+
+func (r *CasbinRule) values() []string {
+	values := []string{r.V0, r.V1, r.V2, r.V3, r.V4, r.V5}
+	for len(values) > 0 && values[len(values)-1] == "" {
+		values = values[:len(values)-1]
+	}
+	return values
+}
+
+func newCasbinRule(ptype string, rule []string) *CasbinRule {
+	r := &CasbinRule{Ptype: ptype}
+	fields := []*string{&r.V0, &r.V1, &r.V2, &r.V3, &r.V4, &r.V5}
+	for i, v := range rule {
+		if i >= len(fields) {
+			break
+		}
+		*fields[i] = v
+	}
+	return r
+}
+
+// Adapter 基于 gorm 的 Casbin 策略存储
+type Adapter struct {
+	db *gorm.DB
+}
+
+var _ persist.Adapter = (*Adapter)(nil)
+
+// NewAdapter 创建策略存储，casbin_rule 表不存在时自动创建
+func NewAdapter(db *gorm.DB) (*Adapter, error) {
+	if err := db.AutoMigrate(&CasbinRule{}); err != nil {
+		return nil, fmt.Errorf("[authz.Adapter] migrate casbin_rule: %w", err)
+	}
+	return &Adapter{db: db}, nil
+}
+
+func (a *Adapter) LoadPolicy(m model.Model) error {
+	var rules []*CasbinRule
+	if err := a.db.Order("id").Find(&rules).Error; err != nil {
+		return fmt.Errorf("[authz.Adapter] load policy: %w", err)
+	}
+	for _, r := range rules {
+		if err := persist.LoadPolicyArray(append([]string{r.Ptype}, r.values()...), m); err != nil {
+			return fmt.Errorf("[authz.Adapter] load policy line %d: %w", r.ID, err)
+		}
+	}
+	return nil
+}
+
+func (a *Adapter) SavePolicy(m model.Model) error {
+	var rules []*CasbinRule
+	for _, sec := range []string{"p", "g"} {
+		for ptype, ast := range m[sec] {
+			for _, rule := range ast.Policy {
+				rules = append(rules, newCasbinRule(ptype, rule))
+			}
+		}
+	}
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&CasbinRule{}).Error; err != nil {
+			return fmt.Errorf("[authz.Adapter] clear policy: %w", err)
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(rules, 100).Error; err != nil {
+			return fmt.Errorf("[authz.Adapter] save policy: %w", err)
+		}
+		return nil
+	})
+}
+
+func (a *Adapter) AddPolicy(sec string, ptype string, rule []string) error {
+	if err := a.db.Create(newCasbinRule(ptype, rule)).Error; err != nil {
+		return fmt.Errorf("[authz.Adapter] add policy: %w", err)
+	}
+	return nil
+}
+
+func (a *Adapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	r := newCasbinRule(ptype, rule)
+	err := a.db.Where(map[string]interface{}{
+		"ptype": r.Ptype,
+		"v0":    r.V0,
+		"v1":    r.V1,
+		"v2":    r.V2,
+		"v3":    r.V3,
+		"v4":    r.V4,
+		"v5":    r.V5,
+	}).Delete(&CasbinRule{}).Error
+	if err != nil {
+		return fmt.Errorf("[authz.Adapter] remove policy: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilteredPolicy 删除从 fieldIndex 开始各字段与 fieldValues 匹配的策略，空值匹配任意值
+func (a *Adapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	conditions := map[string]interface{}{"ptype": ptype}
+	for i, v := range fieldValues {
+		idx := fieldIndex + i
+		if v == "" || idx < 0 || idx > 5 {
+			continue
+		}
+		conditions[fmt.Sprintf("v%d", idx)] = v
+	}
+	if err := a.db.Where(conditions).Delete(&CasbinRule{}).Error; err != nil {
+		return fmt.Errorf("[authz.Adapter] remove filtered policy: %w", err)
+	}
+	return nil
+}
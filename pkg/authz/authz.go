@@ -0,0 +1,269 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/log"
+)
+
+const (
+	// DomainAll 全局域，在该域中分配的角色与策略对所有域生效
+	DomainAll = "*"
+	// RoleAdmin 内置管理员角色，拥有全部权限
+	RoleAdmin = "admin"
+	// ActionCall RPC 方法调用的动作名
+	ActionCall = "CALL"
+)
+
+const (
+	userSubjectPrefix = "user:"
+	roleSubjectPrefix = "role:"
+)
+
+// modelText RBAC with domains：g 的第三个字段为域，域为 * 的角色与策略在所有域生效，
+// 对象使用 keyMatch2 匹配，支持 /v1/user/:id 与 /v1/admin/* 形式的路由
+const modelText = `
+[request_definition]
+r = sub, dom, obj, act
+
+[policy_definition]
+p = sub, dom, obj, act
+
+[role_definition]
+g = _, _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = (g(r.sub, p.sub, r.dom) || g(r.sub, p.sub, "*")) && (p.dom == "*" || r.dom == p.dom) && keyMatch2(r.obj, p.obj) && (p.act == "*" || r.act == p.act)
+`
+
+var (
+	ErrForbidden    = errors.New("permission denied")
+	ErrInvalidRole  = errors.New("invalid role")
+	ErrInvalidRule  = errors.New("invalid policy")
+	errEmptySubject = errors.New("empty subject")
+)
+
+// Policy 一条访问策略：Subject 在 Domain 中可以对 Object 执行 Action
+type Policy struct {
+	// Subject 角色 role:<name> 或用户 user:<id>
+	Subject string
+	Domain  string
+	Object  string
+	Action  string
+}
+
+func (p Policy) validate() error {
+	if p.Subject == "" || p.Domain == "" || p.Object == "" || p.Action == "" {
+		return ErrInvalidRule
+	}
+	if !strings.HasPrefix(p.Subject, userSubjectPrefix) && !strings.HasPrefix(p.Subject, roleSubjectPrefix) {
+		return ErrInvalidRule
+	}
+	return nil
+}
+
+// UserSubject 用户在策略中的主体名
+func UserSubject(userID uint64) string {
+	return userSubjectPrefix + strconv.FormatUint(userID, 10)
+}
+
+// RoleSubject 角色在策略中的主体名
+func RoleSubject(role string) string {
+	return roleSubjectPrefix + role
+}
+
+func validateRole(role string) error {
+	if role == "" || len(role) > 64 || strings.ContainsAny(role, ":, ") {
+		return ErrInvalidRole
+	}
+	return nil
+}
+
+// Enforcer 基于 Casbin 的 RBAC 权限校验，策略持久化在 casbin_rule 表
+type Enforcer struct {
+	e      *casbin.SyncedEnforcer
+	logger *log.Logger
+}
+
+// NewEnforcer 创建权限校验器：
+// 内置 admin 角色拥有全部权限，security.authz.admins 中的用户 ID 启动时被授予 admin 角色，
+// security.authz.reload_interval 大于 0 时定期从数据库重新加载策略，用于同步其他实例的修改
+func NewEnforcer(conf *viper.Viper, db *gorm.DB, logger *log.Logger) *Enforcer {
+	adapter, err := NewAdapter(db)
+	if err != nil {
+		panic(err)
+	}
+	m, err := model.NewModelFromString(modelText)
+	if err != nil {
+		panic(err)
+	}
+	e, err := casbin.NewSyncedEnforcer(m, adapter)
+	if err != nil {
+		panic(err)
+	}
+	enforcer := &Enforcer{e: e, logger: logger}
+	if _, err := enforcer.AddPolicy(Policy{
+		Subject: RoleSubject(RoleAdmin),
+		Domain:  DomainAll,
+		Object:  "*",
+		Action:  "*",
+	}); err != nil {
+		panic(err)
+	}
+	for _, id := range conf.GetStringSlice("security.authz.admins") {
+		userID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			panic(fmt.Sprintf("invalid security.authz.admins entry %q", id))
+		}
+		if _, err := enforcer.AssignRole(userID, RoleAdmin, DomainAll); err != nil {
+			panic(err)
+		}
+	}
+	if interval := conf.GetDuration("security.authz.reload_interval"); interval > 0 {
+		e.StartAutoLoadPolicy(interval)
+	}
+	return enforcer
+}
+
+// Enforce 校验用户或其任一角色是否拥有权限，roles 为访问令牌中携带的角色
+func (e *Enforcer) Enforce(userID uint64, roles []string, dom, obj, act string) (bool, error) {
+	subjects := make([]string, 0, len(roles)+1)
+	if userID != 0 {
+		subjects = append(subjects, UserSubject(userID))
+	}
+	for _, r := range roles {
+		subjects = append(subjects, RoleSubject(r))
+	}
+	if len(subjects) == 0 {
+		return false, errEmptySubject
+	}
+	for _, sub := range subjects {
+		ok, err := e.e.Enforce(sub, dom, obj, act)
+		if err != nil {
+			return false, fmt.Errorf("[authz.Enforcer] enforce: %w", err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	e.logger.Debug("[authz.Enforcer] permission denied",
+		zap.Uint64("user_id", userID), zap.Strings("roles", roles),
+		zap.String("dom", dom), zap.String("obj", obj), zap.String("act", act))
+	return false, nil
+}
+
+// RolesForUser 返回直接分配给用户的 dom 域角色
+func (e *Enforcer) RolesForUser(userID uint64, dom string) []string {
+	subjects := e.e.GetRolesForUserInDomain(UserSubject(userID), dom)
+	roles := make([]string, 0, len(subjects))
+	for _, s := range subjects {
+		if strings.HasPrefix(s, roleSubjectPrefix) {
+			roles = append(roles, strings.TrimPrefix(s, roleSubjectPrefix))
+		}
+	}
+	return roles
+}
+
+// AssignRole 在 dom 中为用户分配角色，已分配时返回 false
+func (e *Enforcer) AssignRole(userID uint64, role, dom string) (bool, error) {
+	if err := validateRole(role); err != nil {
+		return false, err
+	}
+	if dom == "" {
+		return false, ErrInvalidRule
+	}
+	ok, err := e.e.AddRoleForUserInDomain(UserSubject(userID), RoleSubject(role), dom)
+	if err != nil {
+		return false, fmt.Errorf("[authz.Enforcer] assign role: %w", err)
+	}
+	return ok, nil
+}
+
+// RevokeRole 撤销用户在 dom 中的角色，未分配时返回 false
+func (e *Enforcer) RevokeRole(userID uint64, role, dom string) (bool, error) {
+	if err := validateRole(role); err != nil {
+		return false, err
+	}
+	ok, err := e.e.DeleteRoleForUserInDomain(UserSubject(userID), RoleSubject(role), dom)
+	if err != nil {
+		return false, fmt.Errorf("[authz.Enforcer] revoke role: %w", err)
+	}
+	return ok, nil
+}
+
+// RemoveUser 删除用户在所有域中的角色以及直接授予用户的策略
+func (e *Enforcer) RemoveUser(userID uint64) error {
+	sub := UserSubject(userID)
+	if _, err := e.e.RemoveFilteredGroupingPolicy(0, sub); err != nil {
+		return fmt.Errorf("[authz.Enforcer] remove user roles: %w", err)
+	}
+	if _, err := e.e.RemoveFilteredPolicy(0, sub); err != nil {
+		return fmt.Errorf("[authz.Enforcer] remove user policies: %w", err)
+	}
+	return nil
+}
+
+// UsersForRole 返回在 dom 中拥有角色的用户 ID
+func (e *Enforcer) UsersForRole(role, dom string) []uint64 {
+	subjects := e.e.GetUsersForRoleInDomain(RoleSubject(role), dom)
+	ids := make([]uint64, 0, len(subjects))
+	for _, s := range subjects {
+		id, err := strconv.ParseUint(strings.TrimPrefix(s, userSubjectPrefix), 10, 64)
+		if err == nil && strings.HasPrefix(s, userSubjectPrefix) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// AddPolicy 添加策略，已存在时返回 false
+func (e *Enforcer) AddPolicy(p Policy) (bool, error) {
+	if err := p.validate(); err != nil {
+		return false, err
+	}
+	ok, err := e.e.AddPolicy(p.Subject, p.Domain, p.Object, p.Action)
+	if err != nil {
+		return false, fmt.Errorf("[authz.Enforcer] add policy: %w", err)
+	}
+	return ok, nil
+}
+
+// RemovePolicy 删除策略，不存在时返回 false
+func (e *Enforcer) RemovePolicy(p Policy) (bool, error) {
+	if err := p.validate(); err != nil {
+		return false, err
+	}
+	ok, err := e.e.RemovePolicy(p.Subject, p.Domain, p.Object, p.Action)
+	if err != nil {
+		return false, fmt.Errorf("[authz.Enforcer] remove policy: %w", err)
+	}
+	return ok, nil
+}
+
+// Policies 返回与 filter 匹配的策略，filter 中的空字段匹配任意值
+func (e *Enforcer) Policies(filter Policy) ([]Policy, error) {
+	rules, err := e.e.GetFilteredPolicy(0, filter.Subject, filter.Domain, filter.Object, filter.Action)
+	if err != nil {
+		return nil, fmt.Errorf("[authz.Enforcer] get policy: %w", err)
+	}
+	policies := make([]Policy, 0, len(rules))
+	for _, r := range rules {
+		if len(r) < 4 {
+			continue
+		}
+		policies = append(policies, Policy{Subject: r[0], Domain: r[1], Object: r[2], Action: r[3]})
+	}
+	return policies, nil
+}
@@ -0,0 +1,167 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/jwt"
+)
+
+// 调用方令牌通过 metainfo 透传，需要客户端与服务端使用 TTHeader 传输协议
+const metaToken = "AUTHZ_TOKEN"
+
+// Subject 经过认证的调用方身份
+type Subject struct {
+	UserID uint64
+	Roles  []string
+}
+
+// Authenticator 校验上游透传的令牌，令牌无效时返回 nil 且不返回错误，error 只用于内部错误
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*Subject, error)
+}
+
+// AuthenticatorFunc 函数形式的 Authenticator
+type AuthenticatorFunc func(ctx context.Context, token string) (*Subject, error)
+
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, token string) (*Subject, error) {
+	return f(ctx, token)
+}
+
+// NewJWTAuthenticator 只接受未被吊销的访问令牌，角色取自令牌签发时写入的 roles
+func NewJWTAuthenticator(j *jwt.JWT, revocation jwt.Revocation) Authenticator {
+	return AuthenticatorFunc(func(ctx context.Context, token string) (*Subject, error) {
+		claims, err := j.ParseToken(token)
+		if err != nil || claims.TokenType != jwt.TokenTypeAccess {
+			return nil, nil
+		}
+		revoked, err := revocation.IsRevoked(ctx, claims)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, nil
+		}
+		return &Subject{UserID: claims.UserId, Roles: claims.Roles}, nil
+	})
+}
+
+// WithToken 在 RPC 调用上下文中写入调用方的访问令牌，由下游的 KitexMiddleware 校验后得到调用方身份
+func WithToken(ctx context.Context, token string) context.Context {
+	return metainfo.WithValue(ctx, metaToken, token)
+}
+
+// ForwardToken 将上游透传的令牌继续透传给下游服务，metainfo 中的临时值只会透传一跳
+func ForwardToken(ctx context.Context) context.Context {
+	if token, ok := metainfo.GetValue(ctx, metaToken); ok && token != "" {
+		return WithToken(ctx, token)
+	}
+	return ctx
+}
+
+// HasToken 判断上下文中是否有上游透传的令牌
+func HasToken(ctx context.Context) bool {
+	token, ok := metainfo.GetValue(ctx, metaToken)
+	return ok && token != ""
+}
+
+type subjectKey struct{}
+
+// SubjectFromContext 读取 KitexMiddleware 校验通过的调用方身份，未透传令牌或令牌无效时返回零值
+func SubjectFromContext(ctx context.Context) (uint64, []string) {
+	s, ok := ctx.Value(subjectKey{}).(*Subject)
+	if !ok {
+		return 0, nil
+	}
+	return s.UserID, s.Roles
+}
+
+// KitexMiddleware 使用 auth 校验上游透传的令牌得到调用方身份，用于不需要按方法校验权限的服务。
+// 未透传令牌、令牌无效或请求中的 user_id 与调用方身份不一致时返回 ErrForbidden
+func KitexMiddleware(auth Authenticator) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			ctx, subject, err := authenticate(ctx, auth)
+			if err != nil {
+				return err
+			}
+			if subject == nil {
+				return ErrForbidden
+			}
+			if userID, ok := requestUserID(req); ok && subject.UserID != userID {
+				return ErrForbidden
+			}
+			return next(ctx, req, resp)
+		}
+	}
+}
+
+// KitexMiddleware 使用 auth 校验上游透传的令牌得到调用方身份，并按 /<服务名>/<方法名> 与 CALL 动作校验 RPC 调用权限，
+// methods 为需要校验权限的方法名，为空时校验全部方法，未通过时返回 ErrForbidden。
+// 其余方法的请求携带非零 user_id 时，user_id 必须与调用方身份一致
+func (e *Enforcer) KitexMiddleware(auth Authenticator, methods ...string) endpoint.Middleware {
+	protected := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		protected[m] = struct{}{}
+	}
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			ri := rpcinfo.GetRPCInfo(ctx)
+			if ri == nil {
+				return ErrForbidden
+			}
+			ctx, subject, err := authenticate(ctx, auth)
+			if err != nil {
+				return err
+			}
+			method := ri.Invocation().MethodName()
+			if _, ok := protected[method]; len(protected) > 0 && !ok {
+				if userID, _ := requestUserID(req); userID != 0 && (subject == nil || subject.UserID != userID) {
+					return ErrForbidden
+				}
+				return next(ctx, req, resp)
+			}
+			if subject == nil {
+				return ErrForbidden
+			}
+			obj := "/" + ri.Invocation().ServiceName() + "/" + method
+			ok, err := e.Enforce(subject.UserID, subject.Roles, DomainAll, obj, ActionCall)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return ErrForbidden
+			}
+			return next(ctx, req, resp)
+		}
+	}
+}
+
+// authenticate 校验上游透传的令牌，通过后将调用方身份写入上下文，未透传令牌或令牌无效时返回 nil
+func authenticate(ctx context.Context, auth Authenticator) (context.Context, *Subject, error) {
+	token, ok := metainfo.GetValue(ctx, metaToken)
+	if !ok || token == "" {
+		return ctx, nil, nil
+	}
+	s, err := auth.Authenticate(ctx, token)
+	if err != nil || s == nil {
+		return ctx, nil, err
+	}
+	return context.WithValue(ctx, subjectKey{}, s), s, nil
+}
+
+// requestUserID 读取请求中的 user_id 字段，ok 表示请求是否有该字段
+func requestUserID(req interface{}) (userID uint64, ok bool) {
+	args, ok := req.(interface{ GetFirstArgument() interface{} })
+	if !ok {
+		return 0, false
+	}
+	r, ok := args.GetFirstArgument().(interface{ GetUserId() int64 })
+	if !ok {
+		return 0, false
+	}
+	return uint64(r.GetUserId()), true
+}
@@ -0,0 +1,60 @@
+package authz_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/authz"
+)
+
+type userRequest struct{ userID int64 }
+
+func (r *userRequest) GetUserId() int64 { return r.userID }
+
+type args struct{ req interface{} }
+
+func (a *args) GetFirstArgument() interface{} { return a.req }
+
+var testAuth = authz.AuthenticatorFunc(func(_ context.Context, token string) (*authz.Subject, error) {
+	if token != "valid" {
+		return nil, nil
+	}
+	return &authz.Subject{UserID: 42}, nil
+})
+
+func TestKitexMiddleware(t *testing.T) {
+	cases := []struct {
+		name  string
+		token string
+		req   interface{}
+		err   error
+	}{
+		{"matching user", "valid", &args{&userRequest{42}}, nil},
+		{"request without user_id", "valid", &args{struct{}{}}, nil},
+		{"other user", "valid", &args{&userRequest{7}}, authz.ErrForbidden},
+		{"zero user_id", "valid", &args{&userRequest{0}}, authz.ErrForbidden},
+		{"invalid token", "invalid", &args{&userRequest{42}}, authz.ErrForbidden},
+		{"no token", "", &args{&userRequest{42}}, authz.ErrForbidden},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			if c.token != "" {
+				ctx = authz.WithToken(ctx, c.token)
+			}
+			var subject uint64
+			next := func(ctx context.Context, req, resp interface{}) error {
+				subject, _ = authz.SubjectFromContext(ctx)
+				return nil
+			}
+			err := authz.KitexMiddleware(testAuth)(next)(ctx, c.req, nil)
+			if !errors.Is(err, c.err) {
+				t.Fatalf("got error %v, want %v", err, c.err)
+			}
+			if err == nil && subject != 42 {
+				t.Fatalf("subject = %d, want 42", subject)
+			}
+		})
+	}
+}
@@ -149,20 +149,29 @@ func (m *multiCache[T]) GetAndSet(ctx context.Context, key string, expire time.D
 	return value, nil
 }
 
-// GetAndSingleSet ensures only one concurrent call to fetch and store the value using singleflight
+// GetAndSingleSet reads the value from the cache, and on a miss ensures only one concurrent call
+// fetches and stores it using singleflight. Cache errors fall back to the source function.
 func (m *multiCache[T]) GetAndSingleSet(ctx context.Context, key string, expire time.Duration, fn func() (T, error)) (T, error) {
+	if value, err := m.Get(ctx, key); err == nil {
+		return value, nil
+	}
+
 	// 使用 singleflight 执行回源
 	cacheKey := m.buildKey(key)
 	v, err, _ := m.sf.Do(cacheKey, func() (interface{}, error) {
 		return m.GetAndSet(ctx, key, expire, fn)
 	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
 
 	res, ok := v.(T)
 	if !ok {
-		return res, fmt.Errorf("[]")
+		return res, fmt.Errorf("[cache.MultiCache.GetAndSingleSet] unexpected value type %T", v)
 	}
 
-	return res, err
+	return res, nil
 }
 
 // NewMultiCache creates a new MultiCache instance
@@ -171,8 +180,8 @@ func NewMultiCache[T any](conf *viper.Viper, cache []client.Cache) MultiCache[T]
 		panic("at least one cache implementation is required")
 	}
 
-	// 按优先级排序 (低优先级数值 = 更高优先级)
-	sort.Slice(cache, func(i, j int) bool {
+	// 按优先级排序 (低优先级数值 = 更高优先级)，优先级相同时保持传入顺序
+	sort.SliceStable(cache, func(i, j int) bool {
 		return cache[i].GetPriority() < cache[j].GetPriority()
 	})
 
@@ -16,6 +16,8 @@ type LocalCache struct {
 	cache    *freecache.Cache
 	name     string
 	priority int
+	// maxExpire 本地缓存无法跨实例失效，过期时间不超过该值以限制其他实例读到旧值的时长
+	maxExpire time.Duration
 }
 
 func (l *LocalCache) Get(ctx context.Context, key string) ([]byte, error) {
@@ -56,6 +58,9 @@ func (l *LocalCache) Set(ctx context.Context, key string, value []byte, expire t
 	if expireSeconds <= 0 {
 		expireSeconds = 60 // 默认1分钟，防止永久缓存
 	}
+	if maxSeconds := int(l.maxExpire.Seconds()); expireSeconds > maxSeconds {
+		expireSeconds = maxSeconds
+	}
 
 	err := l.cache.Set([]byte(key), value, expireSeconds)
 	if err != nil {
@@ -100,9 +105,15 @@ func NewLocalCache(conf *viper.Viper) *LocalCache {
 		debug.SetGCPercent(gcPercent)
 	}
 
+	maxExpire := time.Duration(conf.GetInt("app.data.cache.local.maxExpire")) * time.Second
+	if maxExpire <= 0 {
+		maxExpire = 10 * time.Second
+	}
+
 	return &LocalCache{
-		cache:    c,
-		name:     "local",
-		priority: conf.GetInt("app.data.cache.local.priority"),
+		cache:     c,
+		name:      "local",
+		priority:  conf.GetInt("app.data.cache.local.priority"),
+		maxExpire: maxExpire,
 	}
 }
@@ -122,3 +122,12 @@ func NewRedis(conf *viper.Viper) *RedisCache {
 		priority: conf.GetInt("app.data.cache.redis.priority"),
 	}
 }
+
+// NewRedisWithClient creates a Redis cache client sharing an existing connection pool
+func NewRedisWithClient(conf *viper.Viper, rdb *redis.Client) *RedisCache {
+	return &RedisCache{
+		rdb:      rdb,
+		name:     "redis",
+		priority: conf.GetInt("app.data.cache.redis.priority"),
+	}
+}
@@ -0,0 +1,96 @@
+package clientinfo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+)
+
+// 客户端信息通过 metainfo 透传，需要客户端与服务端使用 TTHeader 传输协议
+const (
+	metaIP        = "CLIENT_IP"
+	metaUserAgent = "CLIENT_USER_AGENT"
+	metaRequestID = "CLIENT_REQUEST_ID"
+	metaDeviceID  = "CLIENT_DEVICE_ID"
+
+	// HeaderRequestID 请求ID所在的 HTTP 头，上游未携带时由网关生成
+	HeaderRequestID = "X-Request-ID"
+	// HeaderDeviceID 不支持 Cookie 的客户端通过该 HTTP 头携带设备ID
+	HeaderDeviceID = "X-Device-ID"
+	// DeviceCookie 保存设备ID的 Cookie，浏览器首次访问时由网关生成
+	DeviceCookie = "UserDeviceID"
+
+	maxRequestIDLength = 64
+)
+
+// Info 发起请求的客户端信息
+type Info struct {
+	IP        string
+	UserAgent string
+	RequestID string
+	// DeviceID 客户端持久保存的随机设备ID，用于识别新设备登录
+	DeviceID string
+}
+
+// WithInfo 在 RPC 调用上下文中写入客户端信息，供下游服务记录审计日志
+func WithInfo(ctx context.Context, info Info) context.Context {
+	if info.IP != "" {
+		ctx = metainfo.WithValue(ctx, metaIP, info.IP)
+	}
+	if info.UserAgent != "" {
+		ctx = metainfo.WithValue(ctx, metaUserAgent, info.UserAgent)
+	}
+	if info.RequestID != "" {
+		ctx = metainfo.WithValue(ctx, metaRequestID, info.RequestID)
+	}
+	if info.DeviceID != "" {
+		ctx = metainfo.WithValue(ctx, metaDeviceID, info.DeviceID)
+	}
+	return ctx
+}
+
+// FromContext 读取上游透传的客户端信息，未透传的字段为空
+func FromContext(ctx context.Context) Info {
+	var info Info
+	info.IP, _ = metainfo.GetValue(ctx, metaIP)
+	info.UserAgent, _ = metainfo.GetValue(ctx, metaUserAgent)
+	info.RequestID, _ = metainfo.GetValue(ctx, metaRequestID)
+	info.DeviceID, _ = metainfo.GetValue(ctx, metaDeviceID)
+	return info
+}
+
+// RequestID 校验上游传入的请求ID，为空、过长或包含不可见字符时生成新的请求ID
+func RequestID(upstream string) string {
+	if valid(upstream) {
+		return upstream
+	}
+	return newID()
+}
+
+// DeviceID 校验客户端携带的设备ID，无效时生成新的设备ID，created 表示是否新生成
+func DeviceID(upstream string) (id string, created bool) {
+	if valid(upstream) {
+		return upstream, false
+	}
+	return newID(), true
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func valid(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const argon2idPrefix = "$argon2id$"
+
+// 编码哈希值中参数的合法范围，超出范围的编码视为损坏，避免计算时 panic 或空密钥比较恒等
+const (
+	argon2MaxMemory     = 4 * 1024 * 1024 // KiB，即 4 GiB
+	argon2MaxIterations = 64
+	argon2MinSaltLength = 8
+	argon2MinKeyLength  = 16
+	argon2MaxKeyLength  = 1024
+)
+
+// Argon2idHasher argon2id 哈希实现，编码格式为 PHC 字符串：
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+type Argon2idHasher struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLength  uint32
+	keyLength   uint32
+}
+
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+// NewArgon2idHasher 创建 argon2id 哈希器，参数为 0 或盐、密钥长度超出合法范围时使用 OWASP 推荐的默认值
+func NewArgon2idHasher(memory, iterations uint32, parallelism uint8, saltLength, keyLength uint32) *Argon2idHasher {
+	if memory == 0 {
+		memory = 64 * 1024
+	}
+	if iterations == 0 {
+		iterations = 3
+	}
+	if parallelism == 0 {
+		parallelism = 2
+	}
+	if saltLength < argon2MinSaltLength {
+		saltLength = 16
+	}
+	if keyLength < argon2MinKeyLength || keyLength > argon2MaxKeyLength {
+		keyLength = 32
+	}
+	return &Argon2idHasher{
+		memory:      memory,
+		iterations:  iterations,
+		parallelism: parallelism,
+		saltLength:  saltLength,
+		keyLength:   keyLength,
+	}
+}
+
+func (a *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, a.saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("[hasher.Argon2id.Hash] generate salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, a.iterations, a.memory, a.parallelism, a.keyLength)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		a.memory,
+		a.iterations,
+		a.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a *Argon2idHasher) Verify(password, encoded string) (bool, error) {
+	p, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+	key := argon2.IDKey([]byte(password), p.salt, p.iterations, p.memory, p.parallelism, uint32(len(p.key)))
+	if len(key) != len(p.key) {
+		return false, fmt.Errorf("%w: argon2id key length mismatch", ErrUnknownHash)
+	}
+	return subtle.ConstantTimeCompare(key, p.key) == 1, nil
+}
+
+func (a *Argon2idHasher) NeedsRehash(encoded string) bool {
+	p, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+	return p.memory != a.memory ||
+		p.iterations != a.iterations ||
+		p.parallelism != a.parallelism ||
+		uint32(len(p.salt)) != a.saltLength ||
+		uint32(len(p.key)) != a.keyLength
+}
+
+func (a *Argon2idHasher) Match(encoded string) bool {
+	return strings.HasPrefix(encoded, argon2idPrefix)
+}
+
+func decodeArgon2id(encoded string) (*argon2Params, error) {
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, key
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, ErrUnknownHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, fmt.Errorf("%w: parse argon2id version: %v", ErrUnknownHash, err)
+	}
+	if version != argon2.Version {
+		return nil, fmt.Errorf("%w: incompatible argon2id version %d", ErrUnknownHash, version)
+	}
+	p := &argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism); err != nil {
+		return nil, fmt.Errorf("%w: parse argon2id params: %v", ErrUnknownHash, err)
+	}
+	if p.parallelism == 0 || p.iterations == 0 || p.iterations > argon2MaxIterations ||
+		p.memory < 8*uint32(p.parallelism) || p.memory > argon2MaxMemory {
+		return nil, fmt.Errorf("%w: argon2id params out of range", ErrUnknownHash)
+	}
+	var err error
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("%w: decode argon2id salt: %v", ErrUnknownHash, err)
+	}
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, fmt.Errorf("%w: decode argon2id key: %v", ErrUnknownHash, err)
+	}
+	if len(p.salt) < argon2MinSaltLength {
+		return nil, fmt.Errorf("%w: argon2id salt too short", ErrUnknownHash)
+	}
+	if len(p.key) < argon2MinKeyLength || len(p.key) > argon2MaxKeyLength {
+		return nil, fmt.Errorf("%w: argon2id key length out of range", ErrUnknownHash)
+	}
+	return p, nil
+}
@@ -0,0 +1,69 @@
+package hasher
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestArgon2idVerify(t *testing.T) {
+	a := NewArgon2idHasher(8*1024, 1, 1, 0, 0)
+	encoded, err := a.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	if ok, err := a.Verify("correct horse", encoded); err != nil || !ok {
+		t.Fatalf("verify correct password = %v, %v", ok, err)
+	}
+	if ok, err := a.Verify("wrong", encoded); err != nil || ok {
+		t.Fatalf("verify wrong password = %v, %v", ok, err)
+	}
+	if a.NeedsRehash(encoded) {
+		t.Fatal("freshly generated hash needs rehash")
+	}
+}
+
+func TestArgon2idVerifyMalformed(t *testing.T) {
+	const (
+		salt = "c29tZXNhbHRzb21lc2FsdA" // 16 字节
+		key  = "a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+	)
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{"wrong part count", "$argon2id$v=19$m=8192,t=1,p=1$" + salt},
+		{"wrong algorithm", "$argon2i$v=19$m=8192,t=1,p=1$" + salt + "$" + key},
+		{"bad version", "$argon2id$v=16$m=8192,t=1,p=1$" + salt + "$" + key},
+		{"unparsable version", "$argon2id$v=x$m=8192,t=1,p=1$" + salt + "$" + key},
+		{"unparsable params", "$argon2id$v=19$m=a,t=1,p=1$" + salt + "$" + key},
+		{"zero parallelism", "$argon2id$v=19$m=8192,t=1,p=0$" + salt + "$" + key},
+		{"parallelism overflow", "$argon2id$v=19$m=8192,t=1,p=256$" + salt + "$" + key},
+		{"zero iterations", "$argon2id$v=19$m=8192,t=0,p=1$" + salt + "$" + key},
+		{"too many iterations", "$argon2id$v=19$m=8192,t=65,p=1$" + salt + "$" + key},
+		{"memory below 8p", "$argon2id$v=19$m=15,t=1,p=2$" + salt + "$" + key},
+		{"memory too large", "$argon2id$v=19$m=4194305,t=1,p=1$" + salt + "$" + key},
+		{"bad salt encoding", "$argon2id$v=19$m=8192,t=1,p=1$!!!$" + key},
+		{"empty salt", "$argon2id$v=19$m=8192,t=1,p=1$$" + key},
+		{"short salt", "$argon2id$v=19$m=8192,t=1,p=1$c2FsdA$" + key},
+		{"bad key encoding", "$argon2id$v=19$m=8192,t=1,p=1$" + salt + "$!!!"},
+		{"empty key", "$argon2id$v=19$m=8192,t=1,p=1$" + salt + "$"},
+		{"short key", "$argon2id$v=19$m=8192,t=1,p=1$" + salt + "$a2V5"},
+		{"key too long", "$argon2id$v=19$m=8192,t=1,p=1$" + salt + "$" + strings.Repeat("QUFB", 342)},
+	}
+	a := NewArgon2idHasher(8*1024, 1, 1, 0, 0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := a.Verify("any password", tt.encoded)
+			if ok {
+				t.Fatal("malformed hash accepted a password")
+			}
+			if !errors.Is(err, ErrUnknownHash) {
+				t.Fatalf("err = %v, want ErrUnknownHash", err)
+			}
+			if !a.NeedsRehash(tt.encoded) {
+				t.Fatal("malformed hash does not need rehash")
+			}
+		})
+	}
+}
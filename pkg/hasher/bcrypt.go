@@ -0,0 +1,57 @@
+package hasher
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptHasher bcrypt 哈希实现
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher 创建 bcrypt 哈希器，cost 不合法时使用默认值
+func NewBcryptHasher(cost int) *BcryptHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &BcryptHasher{cost: cost}
+}
+
+func (b *BcryptHasher) Hash(password string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), b.cost)
+	if err != nil {
+		return "", fmt.Errorf("[hasher.Bcrypt.Hash] %w", err)
+	}
+	return string(bytes), nil
+}
+
+func (b *BcryptHasher) Verify(password, encoded string) (bool, error) {
+	// CompareHashAndPassword 内部使用常量时间比较
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		// 其余错误均来自损坏的编码哈希值
+		return false, fmt.Errorf("%w: %v", ErrUnknownHash, err)
+	}
+	return true, nil
+}
+
+func (b *BcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return true
+	}
+	return cost != b.cost
+}
+
+func (b *BcryptHasher) Match(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") ||
+		strings.HasPrefix(encoded, "$2b$") ||
+		strings.HasPrefix(encoded, "$2y$")
+}
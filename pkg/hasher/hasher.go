@@ -0,0 +1,74 @@
+package hasher
+
+import (
+	"errors"
+
+	"github.com/spf13/viper"
+)
+
+// ErrUnknownHash 无法识别或已损坏的哈希编码，例如历史遗留的明文密码
+var ErrUnknownHash = errors.New("[hasher] unknown hash encoding")
+
+// Hasher 密码哈希器接口
+type Hasher interface {
+	// Hash 生成密码的编码哈希值（包含算法、参数与盐）
+	Hash(password string) (string, error)
+	// Verify 以常量时间比较明文密码与编码哈希值，编码无法识别时返回 ErrUnknownHash
+	Verify(password, encoded string) (bool, error)
+	// NeedsRehash 判断编码哈希值的算法或参数是否与当前配置不一致
+	NeedsRehash(encoded string) bool
+}
+
+// algorithm 具体的哈希算法实现
+type algorithm interface {
+	Hasher
+	// Match 判断编码哈希值是否由该算法生成
+	Match(encoded string) bool
+}
+
+// hasher 使用主算法生成哈希，同时兼容校验其它已支持算法生成的历史哈希
+type hasher struct {
+	primary    algorithm
+	algorithms []algorithm
+}
+
+// NewHasher 根据配置创建密码哈希器，默认使用 argon2id
+func NewHasher(conf *viper.Viper) Hasher {
+	argon := NewArgon2idHasher(
+		conf.GetUint32("security.password.argon2.memory"),
+		conf.GetUint32("security.password.argon2.iterations"),
+		uint8(conf.GetUint("security.password.argon2.parallelism")),
+		conf.GetUint32("security.password.argon2.salt_length"),
+		conf.GetUint32("security.password.argon2.key_length"),
+	)
+	bc := NewBcryptHasher(conf.GetInt("security.password.bcrypt.cost"))
+
+	switch conf.GetString("security.password.algorithm") {
+	case "", "argon2id":
+		return &hasher{primary: argon, algorithms: []algorithm{argon, bc}}
+	case "bcrypt":
+		return &hasher{primary: bc, algorithms: []algorithm{bc, argon}}
+	default:
+		panic("unsupported password algorithm")
+	}
+}
+
+func (h *hasher) Hash(password string) (string, error) {
+	return h.primary.Hash(password)
+}
+
+func (h *hasher) Verify(password, encoded string) (bool, error) {
+	for _, a := range h.algorithms {
+		if a.Match(encoded) {
+			return a.Verify(password, encoded)
+		}
+	}
+	return false, ErrUnknownHash
+}
+
+func (h *hasher) NeedsRehash(encoded string) bool {
+	if !h.primary.Match(encoded) {
+		return true
+	}
+	return h.primary.NeedsRehash(encoded)
+}
@@ -12,6 +12,7 @@ import (
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	
+	"github.com/Wenrh2004/lark-lite-server/pkg/cache/client"
 	"github.com/Wenrh2004/lark-lite-server/pkg/log"
 	"github.com/Wenrh2004/lark-lite-server/pkg/zapgorm2"
 )
@@ -27,18 +28,20 @@ func NewDB(conf *viper.Viper, l *log.Logger) *gorm.DB {
 	dsn := conf.GetString("app.data.db.dsn")
 	
 	// GORM doc: https://gorm.io/docs/connecting_to_the_database.html
+	// TranslateError 将各驱动的唯一键冲突统一转换为 gorm.ErrDuplicatedKey
 	switch driver {
 	case "mysql":
 		db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{
-			Logger: logger,
+			Logger:         logger,
+			TranslateError: true,
 		})
 	case "postgres":
 		db, err = gorm.Open(postgres.New(postgres.Config{
 			DSN:                  dsn,
 			PreferSimpleProtocol: true, // disables implicit prepared statement usage
-		}), &gorm.Config{})
+		}), &gorm.Config{TranslateError: true})
 	case "sqlite":
-		db, err = gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+		db, err = gorm.Open(sqlite.Open(dsn), &gorm.Config{TranslateError: true})
 	default:
 		panic("unknown db driver")
 	}
@@ -76,3 +79,11 @@ func NewRedis(conf *viper.Viper) *redis.Client {
 	
 	return rdb
 }
+
+// NewCache 创建本地缓存与 Redis 两级缓存客户端，由同一服务内的各仓储共用，本地缓存在前
+func NewCache(conf *viper.Viper, rdb *redis.Client) []client.Cache {
+	return []client.Cache{
+		client.NewLocalCache(conf),
+		client.NewRedisWithClient(conf, rdb),
+	}
+}
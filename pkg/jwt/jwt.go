@@ -1,31 +1,79 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"strings"
 	"time"
-	
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/spf13/viper"
 )
 
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+	// TokenTypeMFA 密码校验通过但尚未完成二次验证的挑战令牌
+	TokenTypeMFA = "mfa"
+)
+
+// defaultMFATokenExpires 挑战令牌默认有效期
+const defaultMFATokenExpires = 5 * time.Minute
+
 type JWT struct {
 	key                   []byte
 	expiresByAccessToken  time.Duration
 	expiresByRefreshToken time.Duration
+	expiresByMFAToken     time.Duration
 }
 
 type CustomClaims struct {
 	UserId uint64
+	// SessionID 刷新令牌族 ID，同一次登录轮换出的所有令牌共享该 ID
+	SessionID string `json:"sid,omitempty"`
+	TokenType string `json:"typ,omitempty"`
+	// Roles 签发时用户拥有的全局角色，仅访问令牌携带
+	Roles []string `json:"roles,omitempty"`
+	// IssuedAtMilli 毫秒精度的签发时间，iat 只有秒级精度，用户级吊销按该字段比较
+	IssuedAtMilli int64 `json:"iat_ms,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// IssuedAtMillis 返回毫秒精度的签发时间，未携带 iat_ms 的旧令牌退化为 iat
+func (c *CustomClaims) IssuedAtMillis() int64 {
+	if c.IssuedAtMilli != 0 {
+		return c.IssuedAtMilli
+	}
+	if c.IssuedAt == nil {
+		return 0
+	}
+	return c.IssuedAt.UnixMilli()
+}
+
+// TokenPair 一次签发的访问令牌与刷新令牌
+type TokenPair struct {
+	SessionID        string
+	AccessToken      string
+	AccessID         string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshID        string
+	RefreshExpiresAt time.Time
+}
+
+// NewJwt 创建 JWT 工具，访问令牌与刷新令牌有效期配置单位为小时，挑战令牌为分钟
 func NewJwt(conf *viper.Viper) *JWT {
-	return &JWT{
+	j := &JWT{
 		key:                   []byte(conf.GetString("security.jwt.key")),
-		expiresByAccessToken:  time.Duration(conf.GetInt64("security.jwt.expiresByAccessToken")),
-		expiresByRefreshToken: time.Duration(conf.GetInt64("security.jwt.expiresByRefreshToken")),
+		expiresByAccessToken:  time.Duration(conf.GetInt64("security.jwt.expiresByAccessToken")) * time.Hour,
+		expiresByRefreshToken: time.Duration(conf.GetInt64("security.jwt.expiresByRefreshToken")) * time.Hour,
+		expiresByMFAToken:     time.Duration(conf.GetInt64("security.jwt.expiresByMFAToken")) * time.Minute,
+	}
+	if j.expiresByMFAToken <= 0 {
+		j.expiresByMFAToken = defaultMFATokenExpires
 	}
+	return j
 }
 
 func (j *JWT) GetAckExpires() int64 {
@@ -36,39 +84,78 @@ func (j *JWT) GetRefreshExpires() int64 {
 	return int64(j.expiresByRefreshToken.Seconds())
 }
 
-func (j *JWT) genToken(userId uint64, expiresAt time.Time) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, CustomClaims{
-		UserId: userId,
+// NewSessionID 生成新的刷新令牌族 ID
+func NewSessionID() (string, error) {
+	return newID()
+}
+
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (j *JWT) genToken(userId uint64, sessionID, tokenType string, roles []string, expiresAt time.Time) (token string, id string, err error) {
+	id, err = newID()
+	if err != nil {
+		return "", "", err
+	}
+	now := time.Now()
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, CustomClaims{
+		UserId:        userId,
+		SessionID:     sessionID,
+		TokenType:     tokenType,
+		Roles:         roles,
+		IssuedAtMilli: now.UnixMilli(),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "",
 			Subject:   "",
-			ID:        "",
+			ID:        id,
 			Audience:  []string{},
 		},
 	})
-	
+
 	// Sign and get the complete encoded token as a string using the key
-	tokenString, err := token.SignedString(j.key)
+	tokenString, err := t.SignedString(j.key)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	return tokenString, nil
+	return tokenString, id, nil
 }
 
-func (j *JWT) GenTokenPair(userId uint64) (accessToken, refreshToken string, err error) {
-	accessToken, err = j.genToken(userId, time.Now().Add(time.Hour*j.expiresByAccessToken))
+// GenTokenPair 在指定的刷新令牌族下签发新的令牌对，roles 写入访问令牌
+func (j *JWT) GenTokenPair(userId uint64, sessionID string, roles []string) (*TokenPair, error) {
+	now := time.Now()
+	pair := &TokenPair{
+		SessionID:        sessionID,
+		AccessExpiresAt:  now.Add(j.expiresByAccessToken),
+		RefreshExpiresAt: now.Add(j.expiresByRefreshToken),
+	}
+	var err error
+	pair.AccessToken, pair.AccessID, err = j.genToken(userId, sessionID, TokenTypeAccess, roles, pair.AccessExpiresAt)
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
-	refreshToken, err = j.genToken(userId, time.Now().Add(time.Hour*j.expiresByRefreshToken))
+	pair.RefreshToken, pair.RefreshID, err = j.genToken(userId, sessionID, TokenTypeRefresh, nil, pair.RefreshExpiresAt)
 	if err != nil {
-		return "", "", err
+		return nil, err
+	}
+	return pair, nil
+}
+
+// GenMFAToken 签发二次验证挑战令牌，只能用于换取令牌对
+func (j *JWT) GenMFAToken(userId uint64) (token string, id string, expiresAt time.Time, err error) {
+	expiresAt = time.Now().Add(j.expiresByMFAToken)
+	token, id, err = j.genToken(userId, "", TokenTypeMFA, nil, expiresAt)
+	if err != nil {
+		return "", "", time.Time{}, err
 	}
-	return accessToken, refreshToken, nil
-	
+	return token, id, expiresAt, nil
 }
 
 func (j *JWT) ParseToken(tokenString string) (*CustomClaims, error) {
@@ -78,13 +165,13 @@ func (j *JWT) ParseToken(tokenString string) (*CustomClaims, error) {
 	}
 	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
 		return j.key, nil
-	})
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 	if err != nil {
 		return nil, err
 	}
 	if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid {
 		return claims, nil
 	} else {
-		return nil, err
+		return nil, errors.New("token is invalid")
 	}
 }
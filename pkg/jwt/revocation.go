@@ -0,0 +1,99 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Revocation 令牌吊销列表，ParseToken 的调用方需在信任令牌前调用 IsRevoked
+type Revocation interface {
+	// Revoke 吊销单个令牌，记录保留到令牌过期为止
+	Revoke(ctx context.Context, claims *CustomClaims) error
+	// RevokeUser 吊销用户在 at 及之前签发的全部令牌，按毫秒精度比较
+	RevokeUser(ctx context.Context, userId uint64, at time.Time) error
+	// RevokeSession 吊销会话 sessionId 签发的全部令牌
+	RevokeSession(ctx context.Context, sessionId string) error
+	// IsRevoked 判断令牌是否已被吊销
+	IsRevoked(ctx context.Context, claims *CustomClaims) (bool, error)
+}
+
+type redisRevocation struct {
+	rdb *redis.Client
+	// ttl 用户级吊销记录的保留时长，不短于最长的令牌有效期
+	ttl time.Duration
+}
+
+func NewRevocation(rdb *redis.Client, j *JWT) Revocation {
+	return &redisRevocation{
+		rdb: rdb,
+		ttl: j.expiresByRefreshToken,
+	}
+}
+
+func revokedTokenKey(jti string) string {
+	return fmt.Sprintf("JWT:REVOKED:%s", jti)
+}
+
+func revokedUserKey(userId uint64) string {
+	return fmt.Sprintf("JWT:REVOKED:USER:%d", userId)
+}
+
+func revokedSessionKey(sessionId string) string {
+	return fmt.Sprintf("JWT:REVOKED:SESSION:%s", sessionId)
+}
+
+func (r *redisRevocation) Revoke(ctx context.Context, claims *CustomClaims) error {
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return errors.New("[jwt.Revocation.Revoke] token without jti or exp")
+	}
+	ttl := time.Until(claims.ExpiresAt.Time)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := r.rdb.Set(ctx, revokedTokenKey(claims.ID), 1, ttl).Err(); err != nil {
+		return fmt.Errorf("[jwt.Revocation.Revoke] %w", err)
+	}
+	return nil
+}
+
+func (r *redisRevocation) RevokeUser(ctx context.Context, userId uint64, at time.Time) error {
+	if err := r.rdb.Set(ctx, revokedUserKey(userId), at.UnixMilli(), r.ttl).Err(); err != nil {
+		return fmt.Errorf("[jwt.Revocation.RevokeUser] %w", err)
+	}
+	return nil
+}
+
+func (r *redisRevocation) RevokeSession(ctx context.Context, sessionId string) error {
+	if err := r.rdb.Set(ctx, revokedSessionKey(sessionId), 1, r.ttl).Err(); err != nil {
+		return fmt.Errorf("[jwt.Revocation.RevokeSession] %w", err)
+	}
+	return nil
+}
+
+func (r *redisRevocation) IsRevoked(ctx context.Context, claims *CustomClaims) (bool, error) {
+	keys := []string{revokedTokenKey(claims.ID), revokedUserKey(claims.UserId)}
+	if claims.SessionID != "" {
+		keys = append(keys, revokedSessionKey(claims.SessionID))
+	}
+	values, err := r.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return false, fmt.Errorf("[jwt.Revocation.IsRevoked] %w", err)
+	}
+	if values[0] != nil || len(values) > 2 && values[2] != nil {
+		return true, nil
+	}
+	issuedAt := claims.IssuedAtMillis()
+	if values[1] == nil || issuedAt == 0 {
+		return values[1] != nil, nil
+	}
+	before, err := strconv.ParseInt(values[1].(string), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("[jwt.Revocation.IsRevoked] parse revoked time: %w", err)
+	}
+	return issuedAt <= before, nil
+}
@@ -0,0 +1,20 @@
+package mail
+
+import (
+	netmail "net/mail"
+	"strings"
+)
+
+// maxAddressLength 邮箱地址的最大长度
+const maxAddressLength = 128
+
+// NormalizeAddress 去除邮箱首尾空白并转为小写
+func NormalizeAddress(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// ValidAddress 校验邮箱格式，只接受不带显示名称的纯地址
+func ValidAddress(email string) bool {
+	addr, err := netmail.ParseAddress(email)
+	return err == nil && addr.Address == email && len(email) <= maxAddressLength
+}
@@ -1,7 +1,10 @@
 package mail
 
 import (
+	"bytes"
 	"fmt"
+	"html/template"
+
 	"github.com/spf13/viper"
 	"gopkg.in/gomail.v2"
 )
@@ -91,6 +94,19 @@ func (s *EmailSender) Send(to []string, subject string, body string, isHTML bool
 // TemplateData 渲染邮件模板的数据
 type TemplateData map[string]interface{}
 
+// Render 使用 data 渲染 HTML 邮件模板
+func Render(tpl string, data TemplateData) (string, error) {
+	t, err := template.New("mail").Parse(tpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render email template: %w", err)
+	}
+	return buf.String(), nil
+}
+
 // 预定义的邮件模板
 var (
 	// VerificationTemplate 验证码邮件模板
@@ -145,5 +161,59 @@ var (
     </div>
 </body>
 </html>
+`
+	// NewDeviceTemplate 新设备登录提醒邮件模板
+	NewDeviceTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>新设备登录提醒</title>
+</head>
+<body>
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px; font-family: Arial, sans-serif;">
+        <div style="text-align: center; padding: 20px 0;">
+            <h2>新设备登录提醒</h2>
+        </div>
+        <div style="padding: 20px; background-color: #f7f7f7; border-radius: 5px;">
+            <p>尊敬的 {{.Nickname}}：</p>
+            <p>您的账号于 {{.Time}} 在新设备上登录：</p>
+            <p>设备：<strong>{{.Device}}</strong></p>
+            <p>IP 地址：<strong>{{.IP}}</strong></p>
+            <p>如非本人操作，请立即在设备管理中结束该会话并修改密码。</p>
+        </div>
+        <div style="text-align: center; color: #999; padding: 20px 0; font-size: 12px;">
+            <p>此邮件由系统自动发送，请勿回复。</p>
+        </div>
+    </div>
+</body>
+</html>
+`
+	// WorkspaceInvitationTemplate 工作空间邀请邮件模板
+	WorkspaceInvitationTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>工作空间邀请</title>
+</head>
+<body>
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px; font-family: Arial, sans-serif;">
+        <div style="text-align: center; padding: 20px 0;">
+            <h2>工作空间邀请</h2>
+        </div>
+        <div style="padding: 20px; background-color: #f7f7f7; border-radius: 5px;">
+            <p>您好：</p>
+            <p>您被邀请以 <strong>{{.Role}}</strong> 身份加入工作空间 <strong>{{.Workspace}}</strong>。</p>
+            <p><a href="{{.URL}}">点击此处接受邀请</a></p>
+            <p>邀请链接只能使用一次，有效期至 {{.ExpiresAt}}。</p>
+            <p>如果您不认识邀请人，请忽略此邮件。</p>
+        </div>
+        <div style="text-align: center; color: #999; padding: 20px 0; font-size: 12px;">
+            <p>此邮件由系统自动发送，请勿回复。</p>
+        </div>
+    </div>
+</body>
+</html>
 `
 )
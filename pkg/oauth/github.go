@@ -0,0 +1,102 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	githubAuthURL  = "https://github.com/login/oauth/authorize"
+	githubTokenURL = "https://github.com/login/oauth/access_token"
+	githubAPIURL   = "https://api.github.com"
+)
+
+// GitHubProvider GitHub OAuth App 登录
+type GitHubProvider struct {
+	name   string
+	config *oauth2.Config
+	apiURL string
+}
+
+// NewGitHubProvider 创建 GitHub 提供方，未配置的地址使用 GitHub 官方地址
+func NewGitHubProvider(name string, c *Config) *GitHubProvider {
+	endpoint := oauth2.Endpoint{
+		AuthURL:   githubAuthURL,
+		TokenURL:  githubTokenURL,
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+	if c.AuthURL != "" {
+		endpoint.AuthURL = c.AuthURL
+	}
+	if c.TokenURL != "" {
+		endpoint.TokenURL = c.TokenURL
+	}
+	apiURL := githubAPIURL
+	if c.APIURL != "" {
+		apiURL = trimURL(c.APIURL)
+	}
+	if len(c.Scopes) == 0 {
+		c.Scopes = []string{"read:user", "user:email"}
+	}
+	return &GitHubProvider{
+		name:   name,
+		config: oauth2Config(c, endpoint),
+		apiURL: apiURL,
+	}
+}
+
+func (g *GitHubProvider) Name() string {
+	return g.name
+}
+
+func (g *GitHubProvider) AuthCodeURL(ctx context.Context, state, verifier string) (string, error) {
+	return g.config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), nil
+}
+
+func (g *GitHubProvider) Exchange(ctx context.Context, code, verifier string) (*Identity, error) {
+	token, err := g.config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("[oauth.GitHub.Exchange] exchange code: %w", err)
+	}
+	client := g.config.Client(ctx, token)
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := getJSON(ctx, client, g.apiURL+"/user", &user); err != nil {
+		return nil, fmt.Errorf("[oauth.GitHub.Exchange] get user: %w", err)
+	}
+	if user.ID == 0 {
+		return nil, fmt.Errorf("[oauth.GitHub.Exchange] empty user id")
+	}
+	identity := &Identity{
+		Provider:  g.name,
+		Subject:   strconv.FormatInt(user.ID, 10),
+		Name:      user.Name,
+		AvatarURL: user.AvatarURL,
+	}
+	if identity.Name == "" {
+		identity.Name = user.Login
+	}
+	// /user 返回的公开邮箱未必经过验证，从 /user/emails 中读取已验证的主邮箱
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(ctx, client, g.apiURL+"/user/emails", &emails); err == nil {
+		for _, e := range emails {
+			if e.Primary && e.Verified {
+				identity.Email = e.Email
+				identity.EmailVerified = true
+				break
+			}
+		}
+	}
+	return identity, nil
+}
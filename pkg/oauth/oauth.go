@@ -0,0 +1,151 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"golang.org/x/oauth2"
+)
+
+const (
+	ProviderTypeGitHub = "github"
+	ProviderTypeOIDC   = "oidc"
+)
+
+var ErrUnknownProvider = errors.New("unknown oauth provider")
+
+// Identity 第三方身份提供方返回的用户身份
+type Identity struct {
+	Provider string
+	// Subject 用户在提供方的唯一标识
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	AvatarURL     string
+}
+
+// Provider 第三方登录提供方，使用授权码 + PKCE 流程
+type Provider interface {
+	Name() string
+	// AuthCodeURL 生成跳转到提供方的授权地址，verifier 为 PKCE code_verifier
+	AuthCodeURL(ctx context.Context, state, verifier string) (string, error)
+	// Exchange 使用授权码换取令牌并查询用户身份
+	Exchange(ctx context.Context, code, verifier string) (*Identity, error)
+}
+
+// Config 提供方配置，AuthURL、TokenURL、UserInfoURL 为空时使用提供方默认值或 OIDC 发现结果，
+// 配置为本地地址即可对接本地模拟的身份提供方
+type Config struct {
+	Type         string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	AuthURL      string
+	TokenURL     string
+	UserInfoURL  string
+	// Issuer OIDC 签发方地址，用于读取 /.well-known/openid-configuration
+	Issuer string
+	// APIURL GitHub API 地址
+	APIURL string
+}
+
+// Providers 按名称索引的提供方集合
+type Providers map[string]Provider
+
+// Get 获取指定名称的提供方
+func (p Providers) Get(name string) (Provider, error) {
+	provider, ok := p[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return provider, nil
+}
+
+// Names 返回已配置的提供方名称
+func (p Providers) Names() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewProviders 读取 security.oauth.providers 下的提供方配置
+func NewProviders(conf *viper.Viper) Providers {
+	providers := make(Providers)
+	for name := range conf.GetStringMap("security.oauth.providers") {
+		prefix := "security.oauth.providers." + name + "."
+		c := &Config{
+			Type:         conf.GetString(prefix + "type"),
+			ClientID:     conf.GetString(prefix + "client_id"),
+			ClientSecret: conf.GetString(prefix + "client_secret"),
+			RedirectURL:  conf.GetString(prefix + "redirect_url"),
+			Scopes:       conf.GetStringSlice(prefix + "scopes"),
+			AuthURL:      conf.GetString(prefix + "auth_url"),
+			TokenURL:     conf.GetString(prefix + "token_url"),
+			UserInfoURL:  conf.GetString(prefix + "userinfo_url"),
+			Issuer:       conf.GetString(prefix + "issuer"),
+			APIURL:       conf.GetString(prefix + "api_url"),
+		}
+		switch c.Type {
+		case ProviderTypeGitHub:
+			providers[name] = NewGitHubProvider(name, c)
+		case ProviderTypeOIDC:
+			providers[name] = NewOIDCProvider(name, c)
+		default:
+			panic(fmt.Sprintf("unsupported oauth provider type %q for %s", c.Type, name))
+		}
+	}
+	return providers
+}
+
+func oauth2Config(c *Config, endpoint oauth2.Endpoint) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Endpoint:     endpoint,
+		RedirectURL:  c.RedirectURL,
+		Scopes:       c.Scopes,
+	}
+}
+
+// getJSON 携带访问令牌请求 url 并将响应解析到 v
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func trimURL(url string) string {
+	return strings.TrimRight(url, "/")
+}
+
+// GenerateState 生成防 CSRF 的随机 state
+func GenerateState() string {
+	return oauth2.GenerateVerifier()
+}
+
+// GenerateVerifier 生成 PKCE code_verifier
+func GenerateVerifier() string {
+	return oauth2.GenerateVerifier()
+}
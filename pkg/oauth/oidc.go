@@ -0,0 +1,122 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// OIDCProvider 通用 OpenID Connect 登录，用户身份以 UserInfo 端点返回为准
+type OIDCProvider struct {
+	name   string
+	conf   *Config
+	mu     sync.Mutex
+	config *oauth2.Config
+	// userInfoURL 为空表示尚未完成发现
+	userInfoURL string
+}
+
+// NewOIDCProvider 创建 OIDC 提供方，端点未全部配置时在首次使用时通过 Issuer 发现
+func NewOIDCProvider(name string, c *Config) *OIDCProvider {
+	if len(c.Scopes) == 0 {
+		c.Scopes = []string{"openid", "profile", "email"}
+	}
+	p := &OIDCProvider{
+		name: name,
+		conf: c,
+	}
+	if c.AuthURL != "" && c.TokenURL != "" && c.UserInfoURL != "" {
+		p.config = oauth2Config(c, oauth2.Endpoint{AuthURL: c.AuthURL, TokenURL: c.TokenURL})
+		p.userInfoURL = c.UserInfoURL
+	}
+	return p
+}
+
+func (o *OIDCProvider) Name() string {
+	return o.name
+}
+
+// discover 读取 OIDC 发现文档，成功后缓存结果
+func (o *OIDCProvider) discover(ctx context.Context) (*oauth2.Config, string, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.config != nil {
+		return o.config, o.userInfoURL, nil
+	}
+	if o.conf.Issuer == "" {
+		return nil, "", fmt.Errorf("[oauth.OIDC.discover] %s: issuer or endpoints required", o.name)
+	}
+	var doc struct {
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	}
+	url := trimURL(o.conf.Issuer) + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, oauth2.NewClient(ctx, nil), url, &doc); err != nil {
+		return nil, "", fmt.Errorf("[oauth.OIDC.discover] %s: %w", o.name, err)
+	}
+	endpoint := oauth2.Endpoint{AuthURL: doc.AuthorizationEndpoint, TokenURL: doc.TokenEndpoint}
+	userInfoURL := doc.UserInfoEndpoint
+	if o.conf.AuthURL != "" {
+		endpoint.AuthURL = o.conf.AuthURL
+	}
+	if o.conf.TokenURL != "" {
+		endpoint.TokenURL = o.conf.TokenURL
+	}
+	if o.conf.UserInfoURL != "" {
+		userInfoURL = o.conf.UserInfoURL
+	}
+	if endpoint.AuthURL == "" || endpoint.TokenURL == "" || userInfoURL == "" {
+		return nil, "", fmt.Errorf("[oauth.OIDC.discover] %s: incomplete discovery document", o.name)
+	}
+	o.config = oauth2Config(o.conf, endpoint)
+	o.userInfoURL = userInfoURL
+	return o.config, o.userInfoURL, nil
+}
+
+func (o *OIDCProvider) AuthCodeURL(ctx context.Context, state, verifier string) (string, error) {
+	config, _, err := o.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), nil
+}
+
+func (o *OIDCProvider) Exchange(ctx context.Context, code, verifier string) (*Identity, error) {
+	config, userInfoURL, err := o.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("[oauth.OIDC.Exchange] exchange code: %w", err)
+	}
+	var info struct {
+		Subject       string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+		Username      string `json:"preferred_username"`
+		Picture       string `json:"picture"`
+	}
+	if err := getJSON(ctx, config.Client(ctx, token), userInfoURL, &info); err != nil {
+		return nil, fmt.Errorf("[oauth.OIDC.Exchange] get userinfo: %w", err)
+	}
+	if info.Subject == "" {
+		return nil, fmt.Errorf("[oauth.OIDC.Exchange] empty subject")
+	}
+	identity := &Identity{
+		Provider:      o.name,
+		Subject:       info.Subject,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+		Name:          info.Name,
+		AvatarURL:     info.Picture,
+	}
+	if identity.Name == "" {
+		identity.Name = info.Username
+	}
+	return identity, nil
+}
@@ -0,0 +1,86 @@
+package oauth_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/oauth"
+	"github.com/Wenrh2004/lark-lite-server/pkg/oauth/oauthtest"
+)
+
+func newOIDCProvider(t *testing.T) (*oauthtest.Server, *oauth.OIDCProvider) {
+	t.Helper()
+	idp := oauthtest.NewServer()
+	t.Cleanup(idp.Close)
+	idp.SetUser(oauthtest.User{Subject: "sub-1", Email: "alice@example.com", EmailVerified: true, Name: "Alice"})
+	return idp, oauth.NewOIDCProvider("idp", idp.Config("http://localhost/callback"))
+}
+
+func TestOIDCExchange(t *testing.T) {
+	idp, p := newOIDCProvider(t)
+	ctx := context.Background()
+	verifier := oauth.GenerateVerifier()
+	authURL, err := p.AuthCodeURL(ctx, "state-1", verifier)
+	if err != nil {
+		t.Fatalf("auth code url: %v", err)
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("parse auth url: %v", err)
+	}
+	if q := u.Query(); q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		t.Fatalf("auth url without PKCE challenge: %s", authURL)
+	}
+	code, state, err := idp.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	if state != "state-1" {
+		t.Fatalf("state = %q, want state-1", state)
+	}
+	ident, err := p.Exchange(ctx, code, verifier)
+	if err != nil {
+		t.Fatalf("exchange: %v", err)
+	}
+	want := oauth.Identity{Provider: "idp", Subject: "sub-1", Email: "alice@example.com", EmailVerified: true, Name: "Alice"}
+	if *ident != want {
+		t.Fatalf("identity = %+v, want %+v", *ident, want)
+	}
+}
+
+func TestOIDCExchangeRejectsWrongVerifier(t *testing.T) {
+	idp, p := newOIDCProvider(t)
+	ctx := context.Background()
+	authURL, err := p.AuthCodeURL(ctx, "state-1", oauth.GenerateVerifier())
+	if err != nil {
+		t.Fatalf("auth code url: %v", err)
+	}
+	code, _, err := idp.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	if _, err := p.Exchange(ctx, code, oauth.GenerateVerifier()); err == nil {
+		t.Fatal("exchange succeeded with a different PKCE verifier")
+	}
+}
+
+func TestOIDCExchangeRejectsReusedCode(t *testing.T) {
+	idp, p := newOIDCProvider(t)
+	ctx := context.Background()
+	verifier := oauth.GenerateVerifier()
+	authURL, err := p.AuthCodeURL(ctx, "state-1", verifier)
+	if err != nil {
+		t.Fatalf("auth code url: %v", err)
+	}
+	code, _, err := idp.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	if _, err := p.Exchange(ctx, code, verifier); err != nil {
+		t.Fatalf("exchange: %v", err)
+	}
+	if _, err := p.Exchange(ctx, code, verifier); err == nil {
+		t.Fatal("authorization code was accepted twice")
+	}
+}
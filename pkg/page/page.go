@@ -4,3 +4,17 @@ type Page struct {
 	Offset int `query:"offset" default:"0" vd:"$>=0"`
 	Limit  int `query:"limit" default:"50" vd:"$>=0"`
 }
+
+// Normalize 修正分页参数，Limit 为 0 时使用 defaultLimit，超过 maxLimit 时截断
+func (p Page) Normalize(defaultLimit, maxLimit int) Page {
+	if p.Offset < 0 {
+		p.Offset = 0
+	}
+	if p.Limit <= 0 {
+		p.Limit = defaultLimit
+	}
+	if p.Limit > maxLimit {
+		p.Limit = maxLimit
+	}
+	return p
+}
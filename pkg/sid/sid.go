@@ -15,6 +15,17 @@ func NewSid() *Sid {
 	}
 	return &Sid{sf}
 }
+
+// NewSidWithMachineID 使用指定的机器 ID 创建 ID 生成器，用于没有私有 IP 无法推断机器 ID 的环境
+func NewSidWithMachineID(id uint16) *Sid {
+	sf := sonyflake.NewSonyflake(sonyflake.Settings{
+		MachineID: func() (uint16, error) { return id, nil },
+	})
+	if sf == nil {
+		panic("sonyflake not created")
+	}
+	return &Sid{sf}
+}
 func (s Sid) GenString() (string, error) {
 	id, err := s.sf.NextID()
 	if err != nil {
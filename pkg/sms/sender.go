@@ -0,0 +1,100 @@
+package sms
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"text/template"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/Wenrh2004/lark-lite-server/pkg/log"
+)
+
+// Sender 短信发送器接口
+type Sender interface {
+	Send(phone string, content string) error
+}
+
+// NewSender 根据 app.sms.driver 创建短信发送器，未配置时使用日志发送器
+func NewSender(conf *viper.Viper, logger *log.Logger) Sender {
+	switch driver := conf.GetString("app.sms.driver"); driver {
+	case "", "log":
+		return NewLogSender(logger)
+	case "memory":
+		return NewMemorySender()
+	default:
+		panic(fmt.Sprintf("unsupported sms driver: %s", driver))
+	}
+}
+
+// LogSender 只将短信内容写入日志，用于本地开发
+type LogSender struct {
+	logger *log.Logger
+}
+
+// NewLogSender 创建日志短信发送器
+func NewLogSender(logger *log.Logger) *LogSender {
+	return &LogSender{logger: logger}
+}
+
+// Send 记录短信内容
+func (s *LogSender) Send(phone string, content string) error {
+	s.logger.Info("[sms.LogSender] send sms", zap.String("phone", phone), zap.String("content", content))
+	return nil
+}
+
+// Message 已发送的短信
+type Message struct {
+	Phone   string
+	Content string
+}
+
+// MemorySender 将短信保存在内存中，用于测试
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewMemorySender 创建内存短信发送器
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+// Send 保存短信
+func (s *MemorySender) Send(phone string, content string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, Message{Phone: phone, Content: content})
+	return nil
+}
+
+// Messages 返回已发送短信的副本
+func (s *MemorySender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// TemplateData 渲染短信模板的数据
+type TemplateData map[string]interface{}
+
+// Render 使用 data 渲染短信模板
+func Render(tpl string, data TemplateData) (string, error) {
+	t, err := template.New("sms").Parse(tpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse sms template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render sms template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// 预定义的短信模板
+var (
+	// VerificationTemplate 验证码短信模板
+	VerificationTemplate = `您的验证码是{{.Code}}，{{.ExpiresIn}}分钟内有效。如非本人操作，请忽略本短信。`
+)